@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/appengine-ltd/survive-it/internal/game"
+)
+
+// Discovery summary:
+//   - Catalog cross-references are plain strings, so a typo in a pack or a built-in only surfaced mid-run;
+//     this tool loads the shipped packs, -mods and the custom scenario library and runs game.CheckContent.
+//   - The custom scenario library is read the way the GUI writes it (survive-it-scenarios.json, "custom"
+//     records or the older "scenarios" list); blank biomes get the GUI's temperate_forest default.
+//   - Custom scenarios carry no climate profile, so only built-ins get the climate fauna check.
+//   - Exit status is 1 when a pack was rejected or any finding is an error, so CI can gate content changes.
+
+type customScenarioLibrary struct {
+	Custom []struct {
+		Scenario game.Scenario `json:"scenario"`
+	} `json:"custom,omitempty"`
+	Scenarios []game.Scenario `json:"scenarios,omitempty"`
+}
+
+func main() {
+	modsDir := flag.String("mods", "", "also load content packs from this directory")
+	scenariosPath := flag.String("scenarios", "survive-it-scenarios.json", "custom scenario library to check (skipped if missing)")
+	asJSON := flag.Bool("json", false, "print findings as JSON")
+	warnings := flag.Bool("warnings", true, "include warnings in the output")
+	flag.Parse()
+
+	packs, err := game.LoadContentPacks(game.ContentPackDirs(*modsDir)...)
+	if err != nil {
+		fatal(err)
+	}
+	game.SetContentPacks(packs)
+
+	custom, err := loadCustomScenarios(*scenariosPath)
+	if err != nil {
+		fatal(err)
+	}
+	scenarios := append(game.BuiltInScenarios(), custom...)
+	findings := game.CheckContent(scenarios)
+
+	failed := packs.Errors() > 0
+	for _, f := range findings {
+		if f.Level == game.ContentError {
+			failed = true
+		}
+	}
+	if !*warnings {
+		kept := findings[:0]
+		for _, f := range findings {
+			if f.Level == game.ContentError {
+				kept = append(kept, f)
+			}
+		}
+		findings = kept
+	}
+
+	if *asJSON {
+		err = writeJSON(os.Stdout, packs.Problems, findings)
+	} else {
+		writeText(os.Stdout, packs, len(scenarios), len(custom), findings)
+	}
+	if err != nil {
+		fatal(err)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func loadCustomScenarios(path string) ([]game.Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var lib customScenarioLibrary
+	if err := json.Unmarshal(data, &lib); err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	out := lib.Scenarios
+	if len(lib.Custom) > 0 {
+		out = out[:0]
+		for _, record := range lib.Custom {
+			out = append(out, record.Scenario)
+		}
+	}
+	for i := range out {
+		if strings.TrimSpace(out[i].Biome) == "" {
+			out[i].Biome = "temperate_forest"
+		}
+		if out[i].ID == "" {
+			out[i].ID = game.ScenarioID("custom:" + out[i].Name)
+		}
+	}
+	return out, nil
+}
+
+func writeText(w io.Writer, packs game.ContentPackSet, scenarios, custom int, findings []game.ContentFinding) {
+	fmt.Fprintf(w, "Checked %d content packs and %d scenarios (%d custom).\n", len(packs.Packs), scenarios, custom)
+	for _, problem := range packs.Problems {
+		fmt.Fprintf(w, "content pack %s\n", problem)
+	}
+	errs := 0
+	for _, f := range findings {
+		if f.Level == game.ContentError {
+			errs++
+		}
+		fmt.Fprintln(w, f)
+	}
+	fmt.Fprintf(w, "%d findings, %d errors.\n", len(findings), errs)
+}
+
+func writeJSON(w io.Writer, problems []game.ContentProblem, findings []game.ContentFinding) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		PackProblems []game.ContentProblem `json:"pack_problems"`
+		Findings     []game.ContentFinding `json:"findings"`
+	}{problems, findings})
+}
+
+func fatal(err error) {
+	fmt.Fprintf(os.Stderr, "error: %v\n", err)
+	os.Exit(1)
+}
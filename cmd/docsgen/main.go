@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -12,8 +13,10 @@ import (
 )
 
 // Discovery summary:
-// - Catalog docs are generated directly from in-code registry functions, so schema additions must be reflected here.
-// - Keeping docsgen synchronized with registry fields prevents documentation drift after content expansion.
+//   - Catalog docs are generated directly from in-code registry functions, so schema additions must be reflected here.
+//   - Keeping docsgen synchronized with registry fields prevents documentation drift after content expansion.
+//   - Content packs from assets/packs (and -mods, when given) are loaded first, so the catalogs include them and
+//     content-packs.md lists each pack, what it adds or overrides, and what the loader reported.
 type docFile struct {
 	Name    string
 	Title   string
@@ -21,10 +24,18 @@ type docFile struct {
 }
 
 func main() {
+	modsDir := flag.String("mods", "", "also load content packs from this directory")
+	flag.Parse()
+
 	root := filepath.Join("docs", "reference", "catalogs")
 	if err := os.MkdirAll(root, 0o755); err != nil {
 		fatal(err)
 	}
+	packs, err := game.LoadContentPacks(game.ContentPackDirs(*modsDir)...)
+	if err != nil {
+		fatal(err)
+	}
+	game.SetContentPacks(packs)
 
 	files := []docFile{
 		generateAnimalsDoc(),
@@ -34,8 +45,10 @@ func main() {
 		generateCraftablesDoc(),
 		generateTrapsDoc(),
 		generateSheltersDoc(),
+		generateFoodItemsDoc(),
 		generateScenariosDoc(),
 		generateKitDoc(),
+		generateContentPacksDoc(packs),
 	}
 	for _, f := range files {
 		path := filepath.Join(root, f.Name)
@@ -517,6 +530,64 @@ func formatResourceReqs(items []game.ResourceRequirement) string {
 	return strings.Join(parts, ", ")
 }
 
+func generateFoodItemsDoc() docFile {
+	items := game.FoodItemCatalog()
+
+	var b strings.Builder
+	b.WriteString("# Food Items\n\n")
+	b.WriteString("Source: `internal/game/food_inventory_actions.go` (`FoodItemCatalog`).\n\n")
+	b.WriteString(fmt.Sprintf("Total food items: **%d**.\n\n", len(items)))
+	b.WriteString("| ID | Name | Category | Cooked | Preserved | Shelf Life (days) | Decay/day | Nutrition /100g | Illness Risk |\n")
+	b.WriteString("| --- | --- | --- | --- | --- | --- | --- | --- | --- |\n")
+	for _, f := range items {
+		n := f.NutritionPer100
+		b.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s | %d | %s | %s | %s |\n",
+			escape(f.ID), escape(f.Name), escape(f.Category), yesNo(f.Cooked), yesNo(f.Preserved), f.ShelfLifeDays, formatFloat(f.DecayPerDay),
+			escape(fmt.Sprintf("%dkcal %dgP %dgF %dgS", n.CaloriesKcal, n.ProteinG, n.FatG, n.SugarG)), formatFloat(f.IllnessRisk)))
+	}
+	return docFile{Name: "food-items.md", Title: "Food Items", Content: b.String()}
+}
+
+func generateContentPacksDoc(set game.ContentPackSet) docFile {
+	var b strings.Builder
+	b.WriteString("# Content Packs\n\n")
+	b.WriteString("Source: `internal/game/content_packs.go` (`LoadContentPacks`), reading `assets/packs`")
+	b.WriteString(" plus any `-mods` directory passed to docsgen. The other catalog pages already include these entries.\n\n")
+	if len(set.Packs) == 0 {
+		b.WriteString("No content packs were loaded.\n")
+	}
+	for _, pack := range set.Packs {
+		b.WriteString(fmt.Sprintf("## %s (`%s`)\n\n", escape(pack.Name), escape(pack.ID)))
+		if pack.Version != "" {
+			b.WriteString(fmt.Sprintf("Version %s. ", escape(pack.Version)))
+		}
+		if pack.Description != "" {
+			b.WriteString(escape(pack.Description) + " ")
+		}
+		b.WriteString(fmt.Sprintf("File: `%s`, %d entries.\n\n", filepath.ToSlash(pack.Path), pack.EntryCount()))
+		b.WriteString("| Kind | ID | Effect |\n")
+		b.WriteString("| --- | --- | --- |\n")
+		for _, entry := range set.Entries {
+			if entry.PackID != pack.ID {
+				continue
+			}
+			effect := "adds"
+			if entry.Overrides {
+				effect = "overrides built-in"
+			}
+			b.WriteString(fmt.Sprintf("| %s | %s | %s |\n", entry.Kind, escape(entry.ID), effect))
+		}
+		b.WriteString("\n")
+	}
+	if len(set.Problems) > 0 {
+		b.WriteString("## Loader Report\n\n")
+		for _, problem := range set.Problems {
+			b.WriteString("- " + escape(problem.String()) + "\n")
+		}
+	}
+	return docFile{Name: "content-packs.md", Title: "Content Packs", Content: b.String()}
+}
+
 func formatKitReqs(items []game.KitItem) string {
 	if len(items) == 0 {
 		return ""
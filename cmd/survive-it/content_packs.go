@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/appengine-ltd/survive-it/internal/game"
+	"github.com/appengine-ltd/survive-it/internal/savegame"
+)
+
+// loadContentPacks activates the shipped packs and the player's mods before either client starts,
+// and reports anything the loader rejected or overrode.
+func loadContentPacks(stderr io.Writer) {
+	modsDir, err := savegame.ModsDir()
+	if err != nil {
+		modsDir = ""
+	}
+	set, err := game.LoadContentPacks(game.ContentPackDirs(modsDir)...)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return
+	}
+	for _, problem := range set.Problems {
+		fmt.Fprintf(stderr, "content pack %s\n", problem)
+	}
+	game.SetContentPacks(set)
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/appengine-ltd/survive-it/internal/game"
+	"github.com/appengine-ltd/survive-it/internal/headless"
+)
+
+type headlessFlags struct {
+	mode     string
+	scenario string
+	seed     int64
+	players  int
+	days     int
+	kit      string
+	script   string
+	echo     bool
+	journal  string
+	replay   string
+	report   string
+}
+
+func registerHeadlessFlags() *headlessFlags {
+	opts := &headlessFlags{}
+	flag.StringVar(&opts.mode, "mode", "alone", "headless game mode: alone, naked, xl")
+	flag.StringVar(&opts.scenario, "scenario", string(game.ScenarioRandomID), "headless scenario id")
+	flag.Int64Var(&opts.seed, "seed", 0, "headless run seed (0 picks one from the clock)")
+	flag.IntVar(&opts.players, "players", 1, "headless player count")
+	flag.IntVar(&opts.days, "days", 30, "headless run length in days (0 for open-ended)")
+	flag.StringVar(&opts.kit, "kit", "", "headless issued kit, comma separated (e.g. \"Canteen,Ferro Rod\")")
+	flag.StringVar(&opts.script, "script", "", "read headless commands from this file instead of stdin")
+	flag.BoolVar(&opts.echo, "echo", false, "echo each headless command before its output")
+	flag.StringVar(&opts.journal, "journal", "", "write the headless run's replay journal to this file on exit")
+	flag.StringVar(&opts.replay, "replay", "", "replay a journal file, verify its state hashes and exit")
+	flag.StringVar(&opts.report, "report", "", "write the headless run's statistics report on exit (base path; writes .json, -days.csv and -events.csv)")
+	return opts
+}
+
+func runHeadless(opts *headlessFlags, stdin io.Reader, stdout io.Writer) error {
+	if opts.replay != "" {
+		return replayJournal(opts.replay, stdout)
+	}
+	mode, err := headless.ParseMode(opts.mode)
+	if err != nil {
+		return err
+	}
+	kit, err := headless.ParseKit(opts.kit)
+	if err != nil {
+		return err
+	}
+
+	input := stdin
+	echo := opts.echo
+	if opts.script != "" {
+		file, err := os.Open(opts.script)
+		if err != nil {
+			return fmt.Errorf("open script: %w", err)
+		}
+		defer file.Close()
+		input = file
+		echo = true
+	}
+
+	runner, err := headless.NewRunner(headless.Config{
+		Mode:       mode,
+		ScenarioID: game.ScenarioID(opts.scenario),
+		Seed:       opts.seed,
+		Players:    opts.players,
+		Days:       opts.days,
+		Kit:        kit,
+		Echo:       echo,
+	}, stdout)
+	if err != nil {
+		return err
+	}
+	runner.Intro()
+	runErr := runner.Run(input)
+	if opts.journal != "" && runner.Journal() == nil {
+		fmt.Fprintln(stdout, "No journal written: the loaded save had no matching journal.")
+	} else if opts.journal != "" {
+		if err := game.SaveJournal(opts.journal, runner.Journal()); err != nil {
+			return fmt.Errorf("write journal: %w", err)
+		}
+		fmt.Fprintf(stdout, "Journal written to %s (%d steps)\n", opts.journal, len(runner.Journal().Entries))
+	}
+	if opts.report != "" {
+		paths, err := game.ExportRunReport(opts.report, runner.State().Report())
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "Report written to %s\n", strings.Join(paths, ", "))
+	}
+	return runErr
+}
+
+func replayJournal(path string, stdout io.Writer) error {
+	journal, err := game.LoadJournal(path)
+	if err != nil {
+		return err
+	}
+	for _, problem := range game.ContentPackMismatch(journal.ContentPacks) {
+		fmt.Fprintf(stdout, "Warning: %s; the replay may diverge.\n", problem)
+	}
+	state, report, err := game.ReplayJournal(journal)
+	if err != nil {
+		return err
+	}
+	if div := report.Divergence; div != nil {
+		what := string(div.Kind)
+		if div.Command != "" {
+			what += " \"" + div.Command + "\""
+		}
+		return fmt.Errorf("replay diverged at step %d (%s): expected hash %s, got %s", div.Step, what, div.Expected, div.Got)
+	}
+	fmt.Fprintf(stdout, "Replayed %d/%d steps | Day %d | final hash %s\n", report.StepsApplied, len(journal.Entries), state.Day, report.FinalHash)
+	return nil
+}
@@ -22,16 +22,28 @@ func main() {
 	var (
 		showVersion bool
 		noUpdate    bool
+		textMode    bool
 	)
 
 	flag.BoolVar(&showVersion, "version", false, "print version and exit")
 	flag.BoolVar(&noUpdate, "no-update", false, "disable update checks")
+	flag.BoolVar(&textMode, "headless", false, "run the text simulation without the 3D client")
+	opts := registerHeadlessFlags()
 	flag.Parse()
 
 	if showVersion {
 		fmt.Printf("Survive It %s (%s) %s\n", version, commit, date)
 		return
 	}
+	loadContentPacks(os.Stderr)
+
+	if textMode || opts.replay != "" {
+		if err := runHeadless(opts, os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
 
 	app := gui.NewApp(gui.AppConfig{
 		Version:   version,
@@ -24,14 +24,19 @@ func main() {
 
 	flag.BoolVar(&showVersion, "version", false, "print version and exit")
 	flag.BoolVar(&noUpdate, "no-update", false, "disable update checks")
+	opts := registerHeadlessFlags()
 	flag.Parse()
 
 	if showVersion {
 		fmt.Printf("Survive It %s (%s) %s\n", version, commit, date)
 		return
 	}
+	loadContentPacks(os.Stderr)
 
+	// This build has no Raylib client, so the text runner is the only mode.
 	_ = noUpdate
-	fmt.Fprintln(os.Stderr, "Survive It now requires the 3D client build (cgo/raylib enabled).")
-	os.Exit(1)
+	if err := runHeadless(opts, os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 }
@@ -5,6 +5,7 @@ go 1.24.0
 toolchain go1.25.7
 
 require (
+	github.com/BurntSushi/toml v1.6.0
 	github.com/agnivade/levenshtein v1.2.1
 	github.com/gen2brain/raylib-go/raylib v0.55.1
 )
@@ -0,0 +1,82 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Discovery summary:
+// - Model packs were downloadable and Config.AIEnabled persisted, but nothing in the game ran a model.
+// - Backend is the seam between game features and inference: the GUI talks to a Companion, which
+//   builds prompts and validates replies; Backend only turns a prompt into text.
+// - Local GGUF inference runs through a llama.cpp server process, so the game keeps building without cgo;
+//   StubBackend answers in-process for tests and for machines without a runtime.
+
+var (
+	ErrDisabled      = errors.New("AI is turned off")
+	ErrNoModel       = errors.New("no AI model downloaded")
+	ErrNoRuntime     = errors.New("no local AI runtime found")
+	ErrEmptyResponse = errors.New("AI returned an empty reply")
+)
+
+type Prompt struct {
+	System      string
+	User        string
+	MaxTokens   int
+	Temperature float64
+	Stop        []string
+}
+
+type Backend interface {
+	Name() string
+	Generate(ctx context.Context, prompt Prompt) (string, error)
+	Close() error
+}
+
+// Open starts the backend for the configured model pack, or explains why it can't.
+func Open(cfg Config) (Backend, error) {
+	if !cfg.AIEnabled {
+		return nil, ErrDisabled
+	}
+	modelID := NormalizeModelID(cfg.ModelID)
+	exists, err := ModelExists(modelID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNoModel
+	}
+	modelPath, err := ModelPathForID(modelID)
+	if err != nil {
+		return nil, err
+	}
+	backend, err := StartLlamaServer(modelPath)
+	if err != nil {
+		return nil, fmt.Errorf("start model %s: %w", modelID, err)
+	}
+	return backend, nil
+}
+
+// cleanReply trims chat-template leftovers and keeps replies to the first few lines.
+func cleanReply(text string, maxLines int) string {
+	text = strings.TrimSpace(text)
+	for _, marker := range []string{"<|im_end|>", "<|eot_id|>", "<|end|>", "</s>"} {
+		if idx := strings.Index(text, marker); idx >= 0 {
+			text = text[:idx]
+		}
+	}
+	lines := make([]string, 0, maxLines)
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		lines = append(lines, line)
+		if maxLines > 0 && len(lines) >= maxLines {
+			break
+		}
+	}
+	return strings.Join(lines, " ")
+}
@@ -0,0 +1,166 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/appengine-ltd/survive-it/internal/game"
+)
+
+// FallbackConfidence is the parser confidence below which an Unknown intent is offered to the model.
+const FallbackConfidence = 0.5
+
+const companionTimeout = 20 * time.Second
+
+const companionSystem = "You are a survival guide in a wilderness survival game. Be brief, practical and grounded in the game state you are given."
+
+// Companion turns game moments into prompts and keeps model replies inside what the game can use.
+type Companion struct {
+	backend Backend
+	timeout time.Duration
+}
+
+func NewCompanion(backend Backend) *Companion {
+	if backend == nil {
+		return nil
+	}
+	return &Companion{backend: backend, timeout: companionTimeout}
+}
+
+func (c *Companion) Backend() Backend {
+	if c == nil {
+		return nil
+	}
+	return c.backend
+}
+
+func (c *Companion) Close() error {
+	if c == nil {
+		return nil
+	}
+	return c.backend.Close()
+}
+
+func (c *Companion) generate(ctx context.Context, prompt Prompt, maxLines int) (string, error) {
+	if c == nil {
+		return "", ErrDisabled
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	prompt.System = companionSystem
+	text, err := c.backend.Generate(ctx, prompt)
+	if err != nil {
+		return "", err
+	}
+	text = cleanReply(text, maxLines)
+	if text == "" {
+		return "", ErrEmptyResponse
+	}
+	return text, nil
+}
+
+// Narrate retells an event from the message log in one or two atmospheric sentences.
+func (c *Companion) Narrate(ctx context.Context, event string, summary string) (string, error) {
+	return c.generate(ctx, Prompt{
+		User:        fmt.Sprintf("Game state:\n%s\n\nEvent: %s\n\nNarrate this event in at most two short sentences. Do not invent numbers.", summary, event),
+		MaxTokens:   80,
+		Temperature: 0.8,
+	}, 2)
+}
+
+// Advise answers a "what should I do?" style question from the run summary.
+func (c *Companion) Advise(ctx context.Context, question string, summary string) (string, error) {
+	return c.generate(ctx, Prompt{
+		User:        fmt.Sprintf("Game state:\n%s\n\nPlayer asks: %s\n\nGive the single most important next step and why, in at most three sentences.", summary, question),
+		MaxTokens:   140,
+		Temperature: 0.4,
+	}, 3)
+}
+
+// InterpretCommand maps free text the parser could not place onto one game command. It returns "" when the
+// model's reply doesn't start with one of the known verbs, so a hallucinated command never reaches the game.
+func (c *Companion) InterpretCommand(ctx context.Context, raw string, verbs []string, summary string) (string, error) {
+	reply, err := c.generate(ctx, Prompt{
+		User: fmt.Sprintf("Game state:\n%s\n\nKnown command verbs: %s\n\nPlayer typed: %q\n\nReply with exactly one game command using a known verb, or NONE.",
+			summary, strings.Join(verbs, ", "), raw),
+		MaxTokens:   24,
+		Temperature: 0,
+		Stop:        []string{"\n"},
+	}, 1)
+	if err != nil {
+		return "", err
+	}
+	return validateCommand(reply, verbs), nil
+}
+
+func validateCommand(reply string, verbs []string) string {
+	command := strings.ToLower(strings.TrimSpace(reply))
+	command = strings.Trim(command, "`\"'. ")
+	command = strings.TrimPrefix(command, "command:")
+	command = strings.TrimSpace(command)
+	fields := strings.Fields(command)
+	if len(fields) == 0 || fields[0] == "none" {
+		return ""
+	}
+	for _, verb := range verbs {
+		if fields[0] == verb {
+			return strings.Join(fields, " ")
+		}
+	}
+	return ""
+}
+
+// IsAdviceRequest spots questions meant for the companion rather than the command parser.
+func IsAdviceRequest(raw string) bool {
+	text := strings.ToLower(strings.TrimSpace(raw))
+	if strings.HasPrefix(text, "advice") || strings.HasPrefix(text, "hint") {
+		return true
+	}
+	for _, phrase := range []string{"what should i", "what do i do", "what now", "what next", "any advice", "help me survive", "what should we"} {
+		if strings.Contains(text, phrase) {
+			return true
+		}
+	}
+	return false
+}
+
+// SummarizeRun condenses the run into the few lines a small model needs for context.
+func SummarizeRun(s *game.RunState) string {
+	if s == nil {
+		return "No run in progress."
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Mode: %s. Location: %s. Day %d, %02d:00. Weather: %s, %dC.\n",
+		s.Config.Mode, s.Scenario.Name, s.Day, int(s.ClockHours), game.WeatherLabel(s.Weather.Type), s.Weather.TemperatureC)
+	for _, p := range s.Players {
+		fmt.Fprintf(&sb, "%s: energy %d, hydration %d, morale %d, hunger %d, thirst %d, fatigue %d, doing: %s.\n",
+			p.Name, p.Energy, p.Hydration, p.Morale, p.Hunger, p.Thirst, p.Fatigue, p.CurrentTask)
+	}
+	fire := "out"
+	if s.Fire.Lit {
+		fire = fmt.Sprintf("lit (%.1fkg fuel)", s.Fire.FuelKg)
+	}
+	shelter := "none"
+	if s.Shelter.Type != "" {
+		shelter = fmt.Sprintf("%s (%d%% durability)", s.Shelter.Type, s.Shelter.Durability)
+	}
+	woodKg := 0.0
+	for _, stock := range s.WoodStock {
+		woodKg += stock.Kg
+	}
+	fmt.Fprintf(&sb, "Fire: %s. Shelter: %s. Camp wood: %.1fkg.\n", fire, shelter, woodKg)
+	if len(s.CampInventory) > 0 {
+		names := make([]string, 0, len(s.CampInventory))
+		for i, item := range s.CampInventory {
+			if i == 8 {
+				names = append(names, "...")
+				break
+			}
+			names = append(names, item.Name)
+		}
+		fmt.Fprintf(&sb, "Camp items: %s.\n", strings.Join(names, ", "))
+	}
+	return strings.TrimSpace(sb.String())
+}
@@ -0,0 +1,142 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appengine-ltd/survive-it/internal/game"
+)
+
+func testRunSummary(t *testing.T) string {
+	t.Helper()
+	run, err := game.NewRunState(game.RunConfig{
+		Mode:        game.ModeNakedAndAfraid,
+		ScenarioID:  game.ScenarioVancouverIslandID,
+		PlayerCount: 2,
+		RunLength:   game.RunLength{Days: 21},
+		Seed:        808,
+	})
+	if err != nil {
+		t.Fatalf("new run: %v", err)
+	}
+	return SummarizeRun(&run)
+}
+
+func TestCompanionAdviseSendsRunSummary(t *testing.T) {
+	stub := &StubBackend{Reply: "Get a fire going before dark.\nIt keeps you warm.\nThen find water.\nExtra line."}
+	companion := NewCompanion(stub)
+	summary := testRunSummary(t)
+
+	reply, err := companion.Advise(context.Background(), "what should I do?", summary)
+	if err != nil {
+		t.Fatalf("advise: %v", err)
+	}
+	if strings.Contains(reply, "Extra line") {
+		t.Fatalf("expected advice trimmed to three lines, got %q", reply)
+	}
+	prompts := stub.Prompts()
+	if len(prompts) != 1 || !strings.Contains(prompts[0].User, "Vancouver Island") || prompts[0].System == "" {
+		t.Fatalf("expected the run summary in the prompt, got %+v", prompts)
+	}
+}
+
+func TestCompanionInterpretCommandRejectsUnknownVerbs(t *testing.T) {
+	verbs := []string{"fire", "forage", "wood"}
+	cases := map[string]string{
+		"`wood gather 2`":   "wood gather 2",
+		"Forage berries.":   "forage berries",
+		"NONE":              "",
+		"teleport home now": "",
+	}
+	for reply, want := range cases {
+		companion := NewCompanion(&StubBackend{Reply: reply})
+		got, err := companion.InterpretCommand(context.Background(), "grab some sticks", verbs, "")
+		if err != nil {
+			t.Fatalf("interpret %q: %v", reply, err)
+		}
+		if got != want {
+			t.Fatalf("reply %q: expected %q, got %q", reply, want, got)
+		}
+	}
+}
+
+func TestIsAdviceRequest(t *testing.T) {
+	for _, raw := range []string{"What should I do?", "advice", "ok what now"} {
+		if !IsAdviceRequest(raw) {
+			t.Fatalf("expected %q to be an advice request", raw)
+		}
+	}
+	for _, raw := range []string{"help", "forage berries", "wood gather 2"} {
+		if IsAdviceRequest(raw) {
+			t.Fatalf("expected %q to go to the parser", raw)
+		}
+	}
+}
+
+func TestLlamaServerClientGenerate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" {
+			http.NotFound(w, r)
+			return
+		}
+		var req llamaChatRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		last := req.Messages[len(req.Messages)-1]
+		if last.Role != "user" || !strings.Contains(last.Content, "hello") || req.MaxTokens != 16 {
+			http.Error(w, "unexpected prompt", http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"The river is close."}}]}`))
+	}))
+	defer server.Close()
+
+	backend := NewLlamaServerClient(server.URL)
+	companion := NewCompanion(backend)
+	reply, err := companion.generate(context.Background(), Prompt{User: "hello", MaxTokens: 16}, 1)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if reply != "The river is close." {
+		t.Fatalf("unexpected reply %q", reply)
+	}
+	if err := backend.Close(); err != nil {
+		t.Fatalf("close client-only backend: %v", err)
+	}
+}
+
+func TestStartLlamaServerFailsFastWhenTheServerExits(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as the server")
+	}
+	script := filepath.Join(t.TempDir(), "llama-server")
+	if err := os.WriteFile(script, []byte("#!/bin/sh\necho 'error: failed to load model' >&2\nexit 3\n"), 0o700); err != nil {
+		t.Fatalf("write script: %v", err)
+	}
+	t.Setenv(LlamaServerEnv, script)
+
+	start := time.Now()
+	_, err := StartLlamaServer("missing.gguf")
+	if err == nil || !strings.Contains(err.Error(), "exit status 3") || !strings.Contains(err.Error(), "failed to load model") {
+		t.Fatalf("expected the exit status and stderr in the error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("expected startup to fail fast, took %s", elapsed)
+	}
+}
+
+func TestOpenRequiresEnabledConfig(t *testing.T) {
+	if _, err := Open(Config{AIEnabled: false}); err != ErrDisabled {
+		t.Fatalf("expected ErrDisabled, got %v", err)
+	}
+}
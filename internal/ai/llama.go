@@ -0,0 +1,240 @@
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LlamaServerEnv points at a llama.cpp server binary when it is not on PATH or beside the app.
+const LlamaServerEnv = "SURVIVE_IT_LLAMA_SERVER"
+
+const llamaStartupTimeout = 90 * time.Second
+
+// LlamaServerBackend sends prompts to a llama.cpp server's /v1/chat/completions endpoint. When the game started
+// the server it also owns the process and stops it on Close.
+type LlamaServerBackend struct {
+	BaseURL string
+	Client  *http.Client
+
+	cmd    *exec.Cmd
+	exited chan error
+	stderr *tailBuffer
+}
+
+// llamaStderrTail is how much of the server's stderr is kept for startup errors.
+const llamaStderrTail = 2048
+
+// tailBuffer keeps the last few bytes written to it.
+type tailBuffer struct {
+	mu  sync.Mutex
+	buf []byte
+}
+
+func (t *tailBuffer) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.buf = append(t.buf, p...)
+	if len(t.buf) > llamaStderrTail {
+		t.buf = t.buf[len(t.buf)-llamaStderrTail:]
+	}
+	return len(p), nil
+}
+
+func (t *tailBuffer) String() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return strings.TrimSpace(string(t.buf))
+}
+
+// NewLlamaServerClient talks to an already running server, e.g. "http://127.0.0.1:8080".
+func NewLlamaServerClient(baseURL string) *LlamaServerBackend {
+	return &LlamaServerBackend{
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		Client:  &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+func llamaServerBinary() (string, error) {
+	if path := strings.TrimSpace(os.Getenv(LlamaServerEnv)); path != "" {
+		return path, nil
+	}
+	if path, err := exec.LookPath("llama-server"); err == nil {
+		return path, nil
+	}
+	if exe, err := os.Executable(); err == nil {
+		candidate := filepath.Join(filepath.Dir(exe), "llama-server")
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return candidate, nil
+		}
+	}
+	return "", ErrNoRuntime
+}
+
+func freeLocalPort() (int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
+
+// StartLlamaServer launches llama-server for modelPath on a free local port and waits until it is healthy.
+func StartLlamaServer(modelPath string) (*LlamaServerBackend, error) {
+	binary, err := llamaServerBinary()
+	if err != nil {
+		return nil, err
+	}
+	port, err := freeLocalPort()
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.Command(binary, "-m", modelPath, "--host", "127.0.0.1", "--port", strconv.Itoa(port), "-c", "2048")
+	stderr := &tailBuffer{}
+	cmd.Stderr = stderr
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	backend := NewLlamaServerClient(fmt.Sprintf("http://127.0.0.1:%d", port))
+	backend.cmd = cmd
+	backend.stderr = stderr
+	backend.exited = make(chan error, 1)
+	go func() { backend.exited <- cmd.Wait() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), llamaStartupTimeout)
+	defer cancel()
+	if err := backend.waitHealthy(ctx); err != nil {
+		_ = backend.Close()
+		return nil, err
+	}
+	return backend, nil
+}
+
+func (b *LlamaServerBackend) waitHealthy(ctx context.Context) error {
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.BaseURL+"/health", nil)
+		if err != nil {
+			return err
+		}
+		resp, err := b.Client.Do(req)
+		if err == nil {
+			_ = resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("model server did not become ready: %w", ctx.Err())
+		case err := <-b.exited:
+			// Put the result back so Close does not wait on a process that is already gone.
+			b.exited <- err
+			return b.exitError(err)
+		case <-time.After(250 * time.Millisecond):
+		}
+	}
+}
+
+// exitError describes a server that quit during startup, with the end of what it wrote to stderr.
+func (b *LlamaServerBackend) exitError(err error) error {
+	status := "exited"
+	if err != nil {
+		status = err.Error()
+	}
+	if tail := b.stderr.String(); tail != "" {
+		return fmt.Errorf("model server %s during startup: %s", status, tail)
+	}
+	return fmt.Errorf("model server %s during startup", status)
+}
+
+func (b *LlamaServerBackend) Name() string {
+	return "llama.cpp"
+}
+
+type llamaChatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// llamaChatRequest is the OpenAI-style chat body; llama-server formats it with the model's own chat template.
+type llamaChatRequest struct {
+	Messages    []llamaChatMessage `json:"messages"`
+	MaxTokens   int                `json:"max_tokens"`
+	Temperature float64            `json:"temperature"`
+	Stop        []string           `json:"stop,omitempty"`
+	CachePrompt bool               `json:"cache_prompt"`
+}
+
+type llamaChatResponse struct {
+	Choices []struct {
+		Message llamaChatMessage `json:"message"`
+	} `json:"choices"`
+}
+
+func (b *LlamaServerBackend) Generate(ctx context.Context, prompt Prompt) (string, error) {
+	maxTokens := prompt.MaxTokens
+	if maxTokens <= 0 {
+		maxTokens = 128
+	}
+	messages := make([]llamaChatMessage, 0, 2)
+	if prompt.System != "" {
+		messages = append(messages, llamaChatMessage{Role: "system", Content: prompt.System})
+	}
+	messages = append(messages, llamaChatMessage{Role: "user", Content: prompt.User})
+	body, err := json.Marshal(llamaChatRequest{
+		Messages:    messages,
+		MaxTokens:   maxTokens,
+		Temperature: prompt.Temperature,
+		Stop:        prompt.Stop,
+		CachePrompt: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.BaseURL+"/v1/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := b.Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("model server returned %s", resp.Status)
+	}
+	var out llamaChatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", fmt.Errorf("decode model reply: %w", err)
+	}
+	if len(out.Choices) == 0 || strings.TrimSpace(out.Choices[0].Message.Content) == "" {
+		return "", ErrEmptyResponse
+	}
+	return out.Choices[0].Message.Content, nil
+}
+
+func (b *LlamaServerBackend) Close() error {
+	if b.cmd == nil || b.cmd.Process == nil {
+		return nil
+	}
+	err := b.cmd.Process.Kill()
+	<-b.exited
+	b.cmd = nil
+	if errors.Is(err, os.ErrProcessDone) {
+		return nil
+	}
+	return err
+}
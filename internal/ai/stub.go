@@ -0,0 +1,47 @@
+package ai
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// StubBackend answers prompts in-process. Respond decides the reply; without it the stub returns Reply.
+// Prompts are recorded so tests can check what the game asked.
+type StubBackend struct {
+	Reply   string
+	Respond func(Prompt) (string, error)
+
+	mu      sync.Mutex
+	prompts []Prompt
+}
+
+func (b *StubBackend) Name() string {
+	return "stub"
+}
+
+func (b *StubBackend) Generate(ctx context.Context, prompt Prompt) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	b.mu.Lock()
+	b.prompts = append(b.prompts, prompt)
+	b.mu.Unlock()
+	if b.Respond != nil {
+		return b.Respond(prompt)
+	}
+	if strings.TrimSpace(b.Reply) == "" {
+		return "", ErrEmptyResponse
+	}
+	return b.Reply, nil
+}
+
+func (b *StubBackend) Close() error {
+	return nil
+}
+
+func (b *StubBackend) Prompts() []Prompt {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]Prompt(nil), b.prompts...)
+}
@@ -1,19 +1,41 @@
 package game
 
+import (
+	"fmt"
+	"math"
+)
+
 func (s *RunState) AdvanceDay() {
 	s.EnsurePlayerRuntimeStats()
+	skippedHours := 0.0
+	if !s.advancingClock {
+		s.expireEncounter()
+		// Skipping to the next day gives delegated work all the time it needs.
+		s.progressDelegatedTasks(delegationMaxHours)
+		skippedHours = (1 - s.MetabolismProgress) * 24
+	}
+	// The skipped hours end at the current clock on the next day; the part before midnight uses today's weather.
+	skipStart := s.ClockHours + 24 - skippedHours
+	s.applyThermalSpan(math.Max(0, skipStart), 24)
 	s.consumePendingDayMetabolism()
+	s.closeDayStats()
 	s.Day++
 	s.EnsureWeather()
+	s.applyThermalSpan(math.Max(0, skipStart-24), s.ClockHours)
+	s.statsDay()
 	season, ok := s.CurrentSeason()
 	if !ok {
 		season = SeasonAutumn
 	}
-	weatherImpact := weatherImpactForDay(s.Scenario.Biome, season, s.Weather.Type, s.Weather.StreakDays, s.Weather.TemperatureC)
+	// Heat and cold reach players through their hourly core temperature (thermal.go).
+	weatherImpact := weatherConditionsImpact(s.Scenario.Biome, season, s.Weather.Type, s.Weather.StreakDays)
 	campImpact := s.campImpactForDay()
 
 	for i := range s.Players {
 		p := &s.Players[i]
+		if !p.Active() {
+			continue
+		}
 
 		playerWeatherImpact := adjustWeatherImpactForPlayer(weatherImpact, *p, s.Weather.Type)
 		playerWeatherImpact = s.applyCraftedWeatherModifiersForPlayer(playerWeatherImpact, *p, s.Weather.Type, s.Weather.TemperatureC)
@@ -23,30 +45,48 @@ func (s *RunState) AdvanceDay() {
 		p.Energy += campImpact.Energy
 		p.Hydration += campImpact.Hydration
 		p.Morale += campImpact.Morale
-		applyDailyAilmentPenalties(p)
+		for _, note := range applyDailyAilmentPenalties(p) {
+			s.reports = append(s.reports, fmt.Sprintf("P%d %s: %s.", p.ID, p.Name, note))
+		}
 		applyDailyDeficiencyEffects(p)
+		s.applyColdExposure(p)
+		s.decayUnusedSkills(p)
 
 		clampPlayer(p)
 		refreshEffectBars(p)
 	}
 	s.progressCampState()
+	s.rollFoodRaid()
+	s.applyWeatherGearWear()
 	s.advanceFoodDegradation()
 	s.decayCellStates()
+	s.updatePlayerOutcomes(skippedHours)
+	s.syncWeatherHour()
 }
 
-func applyDailyAilmentPenalties(playerState *PlayerState) {
+// applyDailyAilmentPenalties charges each ailment's daily cost and heals it a day.
+// Dirty wounds can turn infected; infection costs more and stalls healing until treated.
+func applyDailyAilmentPenalties(playerState *PlayerState) []string {
 	if playerState == nil || len(playerState.Ailments) == 0 {
-		return
+		return nil
 	}
 
+	var notes []string
 	active := make([]Ailment, 0, len(playerState.Ailments))
 	for _, ailment := range playerState.Ailments {
 		if ailment.DaysRemaining <= 0 {
 			continue
 		}
-		playerState.Energy -= ailment.EnergyPenalty
-		playerState.Hydration -= ailment.HydrationPenalty
-		playerState.Morale -= ailment.MoralePenalty
+		playerState.Energy -= ailment.EnergyPenalty + ailment.Infection*2
+		playerState.Hydration -= ailment.HydrationPenalty + ailment.Infection
+		playerState.Morale -= ailment.MoralePenalty + ailment.Infection*2
+		if note := progressInfection(&ailment); note != "" {
+			notes = append(notes, note)
+		}
+		if ailment.Infection > 0 {
+			active = append(active, ailment)
+			continue
+		}
 		ailment.DaysRemaining--
 		if ailment.DaysRemaining > 0 {
 			active = append(active, ailment)
@@ -54,6 +94,7 @@ func applyDailyAilmentPenalties(playerState *PlayerState) {
 	}
 
 	playerState.Ailments = active
+	return notes
 }
 
 func clamp(number, min, max int) int {
@@ -87,6 +128,10 @@ const (
 	RunOutcomeOngoing   RunOutcomeStatus = "ongoing"
 	RunOutcomeCompleted RunOutcomeStatus = "completed"
 	RunOutcomeCritical  RunOutcomeStatus = "critical"
+	// RunOutcomeEnded means no player is left in the run.
+	RunOutcomeEnded RunOutcomeStatus = "ended"
+	// RunOutcomeRescued means a patrol saw a signal and picked the party up.
+	RunOutcomeRescued RunOutcomeStatus = "rescued"
 )
 
 type RunOutcome struct {
@@ -96,6 +141,23 @@ type RunOutcome struct {
 }
 
 func (s *RunState) EvaluateRun() RunOutcome {
+	// A patrol picking the party up ends the run before anything else
+	if s.Rescue != nil {
+		return RunOutcome{
+			Status:  RunOutcomeRescued,
+			Message: fmt.Sprintf("Rescued on day %d: %s.", s.Rescue.Day, s.Rescue.cause()),
+		}
+	}
+
+	// 0) Everyone has tapped out, been extracted or died
+	if len(s.Players) > 0 && s.activePlayerCount() == 0 {
+		message := "Every player is out of the run."
+		if len(s.Players) == 1 {
+			message = fmt.Sprintf("%s is out of the run (%s).", s.Players[0].Name, StatusLabel(s.Players[0].Status))
+		}
+		return RunOutcome{Status: RunOutcomeEnded, Message: message}
+	}
+
 	// 1) Completion by day limit (fixed-length runs)
 	if !s.Config.RunLength.OpenEnded && s.Config.RunLength.Days > 0 {
 		if s.Day > s.Config.RunLength.Days {
@@ -106,11 +168,10 @@ func (s *RunState) EvaluateRun() RunOutcome {
 		}
 	}
 
-	// 2) Critical condition (v1: no death yet)
-	// Collect all critical players
+	// 2) Critical condition; updatePlayerOutcomes extracts anyone who stays critical too long
 	criticalIDs := make([]int, 0)
 	for _, p := range s.Players {
-		if p.Energy == 0 || p.Hydration == 0 || p.Hunger >= 100 || p.Thirst >= 100 || p.Fatigue >= 100 {
+		if p.Active() && len(criticalReasons(p)) > 0 {
 			criticalIDs = append(criticalIDs, p.ID)
 		}
 	}
@@ -38,14 +38,21 @@ type DiseaseID string
 type AilmentType string
 
 const (
-	AilmentVomiting      AilmentType = "vomiting"
-	AilmentParasites     AilmentType = "parasites"
-	AilmentFoodPoison    AilmentType = "food_poisoning"
-	AilmentGIInfection   AilmentType = "gi_infection"
-	AilmentDehydration   AilmentType = "dehydration"
-	AilmentMalnutrition  AilmentType = "malnutrition"
-	AilmentRespInfection AilmentType = "resp_infection"
-	AilmentEnvenomation  AilmentType = "envenomation"
+	AilmentVomiting       AilmentType = "vomiting"
+	AilmentParasites      AilmentType = "parasites"
+	AilmentFoodPoison     AilmentType = "food_poisoning"
+	AilmentGIInfection    AilmentType = "gi_infection"
+	AilmentDehydration    AilmentType = "dehydration"
+	AilmentMalnutrition   AilmentType = "malnutrition"
+	AilmentRespInfection  AilmentType = "resp_infection"
+	AilmentEnvenomation   AilmentType = "envenomation"
+	AilmentInjury         AilmentType = "injury"
+	AilmentSprain         AilmentType = "sprain"
+	AilmentCut            AilmentType = "cut"
+	AilmentBurn           AilmentType = "burn"
+	AilmentFrostbite      AilmentType = "frostbite"
+	AilmentHypothermia    AilmentType = "hypothermia"
+	AilmentHeatExhaustion AilmentType = "heat_exhaustion"
 )
 
 type Ailment struct {
@@ -55,6 +62,11 @@ type Ailment struct {
 	EnergyPenalty    int         `json:"energy_penalty"`
 	HydrationPenalty int         `json:"hydration_penalty"`
 	MoralePenalty    int         `json:"morale_penalty"`
+	Stage            int         `json:"stage,omitempty"`
+	Wound            bool        `json:"wound,omitempty"`
+	Cleaned          bool        `json:"cleaned,omitempty"`
+	Infection        int         `json:"infection,omitempty"`
+	DaysDirty        int         `json:"days_dirty,omitempty"`
 }
 
 type AilmentTemplate struct {
@@ -93,7 +105,7 @@ type CatchResult struct {
 	EdibleGrams int
 }
 
-func AnimalCatalog() []AnimalSpec {
+func builtinAnimalCatalog() []AnimalSpec {
 	catalog := []AnimalSpec{
 		{
 			ID:               "deer",
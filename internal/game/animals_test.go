@@ -190,8 +190,10 @@ func TestAdvanceDayAppliesAilmentPenalties(t *testing.T) {
 	}
 
 	run.AdvanceDay()
-	if len(run.Players[0].Ailments) != 0 {
-		t.Fatalf("expected ailment to expire after second day")
+	for _, a := range run.Players[0].Ailments {
+		if a.Type == AilmentVomiting {
+			t.Fatalf("expected ailment to expire after second day")
+		}
 	}
 }
 
@@ -0,0 +1,426 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Discovery summary:
+//   - RunState keeps one ShelterState and one FireState that the rest of the game reads as the camp's shelter and fire.
+//   - Camp now has a site on the topology grid and a list of placed structures, each with its own durability.
+//   - Fire pits, drying racks, smoke houses, food caches, bear hangs, cache pits, raised beds and rain catchers are built through
+//     CraftItem from their CraftableSpec.
+//   - campCapacityKg adds up the ground cache, the shelter and whatever storage structures stand at the site.
+//   - Moving camp lets the fire die and leaves the old shelter, structures and stores where they stand; stores have to be
+//     carried over by hand.
+//   - Walking back to an old site picks its shelter and stores up again if they haven't collapsed.
+const (
+	groundCacheKg          = 8.0
+	fireBurnWithPit        = 0.85
+	dryingRackHoursFactor  = 0.75
+	rainCatcherLitresPerMM = 0.5
+	rainCatcherMaxLitres   = 8.0
+	abandonedShelterDecay  = 2
+	campMoveHours          = 0.5
+)
+
+// CampStructureKind is what a placed structure does for the camp.
+type CampStructureKind string
+
+const (
+	CampStructureShelter     CampStructureKind = "shelter"
+	CampStructureFirePit     CampStructureKind = "fire_pit"
+	CampStructureDryingRack  CampStructureKind = "drying_rack"
+	CampStructureSmokeHouse  CampStructureKind = "smoke_house"
+	CampStructureFoodCache   CampStructureKind = "food_cache"
+	CampStructureBearHang    CampStructureKind = "bear_hang"
+	CampStructureCachePit    CampStructureKind = "cache_pit"
+	CampStructureRaisedBed   CampStructureKind = "raised_bed"
+	CampStructureRainCatcher CampStructureKind = "rain_catcher"
+	CampStructureStores      CampStructureKind = "stores"
+)
+
+// CampStructure is one structure standing on a map cell. Left-behind shelters keep their full ShelterState and
+// left-behind stores keep everything that was in them.
+type CampStructure struct {
+	ID         string            `json:"id"`
+	Kind       CampStructureKind `json:"kind"`
+	X          int               `json:"x"`
+	Y          int               `json:"y"`
+	Durability int               `json:"durability"`
+	BuiltDay   int               `json:"built_day"`
+	Quality    CraftQuality      `json:"quality,omitempty"`
+	Shelter    *ShelterState     `json:"shelter,omitempty"`
+	Stores     *CampStores       `json:"stores,omitempty"`
+}
+
+// CampStores is the wood, resources and items a camp held when it was moved away from.
+type CampStores struct {
+	Wood      []WoodStock     `json:"wood,omitempty"`
+	Resources []ResourceStock `json:"resources,omitempty"`
+	Items     []InventoryItem `json:"items,omitempty"`
+	WeightKg  float64         `json:"weight_kg,omitempty"`
+}
+
+// CampState is where camp is and every structure the party has put up, at camp or left behind.
+type CampState struct {
+	Placed     bool            `json:"placed,omitempty"`
+	X          int             `json:"x,omitempty"`
+	Y          int             `json:"y,omitempty"`
+	Structures []CampStructure `json:"structures,omitempty"`
+}
+
+type campStructureSpec struct {
+	Kind             CampStructureKind
+	StorageKg        float64
+	DurabilityPerDay int
+	Effect           string
+}
+
+// campStructureSpecs maps the craftables that are built in place onto what they do at camp.
+var campStructureSpecs = map[string]campStructureSpec{
+	"fire_pit":            {Kind: CampStructureFirePit, DurabilityPerDay: 1, Effect: "fire burns less fuel"},
+	"drying_rack":         {Kind: CampStructureDryingRack, StorageKg: 4, DurabilityPerDay: 3, Effect: "food dries faster"},
+	"drying_box":          {Kind: CampStructureDryingRack, StorageKg: 6, DurabilityPerDay: 2, Effect: "food dries faster"},
+	"smoke_rack":          {Kind: CampStructureSmokeHouse, StorageKg: 3, DurabilityPerDay: 3, Effect: "smoking yields more"},
+	"smoking_rack":        {Kind: CampStructureSmokeHouse, StorageKg: 6, DurabilityPerDay: 2, Effect: "smoking yields more"},
+	"elevated_food_cache": {Kind: CampStructureFoodCache, StorageKg: 20, DurabilityPerDay: 2, Effect: "food kept off the ground"},
+	"bear_hang":           {Kind: CampStructureBearHang, StorageKg: 6, DurabilityPerDay: 2, Effect: "food hung out of reach"},
+	"cache_pit":           {Kind: CampStructureCachePit, StorageKg: 10, DurabilityPerDay: 1, Effect: "food buried out of smell"},
+	"raised_bed":          {Kind: CampStructureRaisedBed, DurabilityPerDay: 2, Effect: "better sleep"},
+	"rain_catcher":        {Kind: CampStructureRainCatcher, DurabilityPerDay: 3, Effect: "catches rain water"},
+}
+
+func campStructureSpecFor(id string) (campStructureSpec, bool) {
+	spec, ok := campStructureSpecs[strings.ToLower(strings.TrimSpace(id))]
+	return spec, ok
+}
+
+func (c CampStructure) label() string {
+	if c.Shelter != nil {
+		if spec, ok := shelterByID(c.Shelter.Type); ok {
+			return spec.Name
+		}
+		return string(c.Shelter.Type)
+	}
+	if c.Stores != nil {
+		return fmt.Sprintf("Stores (%.1fkg)", c.Stores.WeightKg)
+	}
+	if spec, ok := craftableByID(c.ID); ok {
+		return spec.Name
+	}
+	return c.ID
+}
+
+// campSite is where camp stands. Until something is built, camp is wherever the party is.
+func (s *RunState) campSite() (int, int, bool) {
+	if s.Camp.Placed {
+		return s.Camp.X, s.Camp.Y, true
+	}
+	if s.Shelter.Type != "" && (s.Shelter.SiteX != 0 || s.Shelter.SiteY != 0) {
+		return s.Shelter.SiteX, s.Shelter.SiteY, true
+	}
+	x, y := s.CurrentMapPosition()
+	return x, y, false
+}
+
+// placeCamp fixes camp at the party's cell if it hasn't been set up yet.
+func (s *RunState) placeCamp() {
+	if s.Camp.Placed {
+		return
+	}
+	s.Camp.X, s.Camp.Y, _ = s.campSite()
+	s.Camp.Placed = true
+}
+
+// requireAtCamp refuses camp work away from an established camp.
+func (s *RunState) requireAtCamp(player *PlayerState) error {
+	x, y, placed := s.campSite()
+	if !placed || s.playerAtCamp(player) {
+		return nil
+	}
+	return fmt.Errorf("camp is at (%d,%d); go back or use camp move to set up here", x, y)
+}
+
+// requireCampStores refuses to touch the shared camp stores from anywhere but the camp cell.
+func (s *RunState) requireCampStores(player *PlayerState) error {
+	if s.playerAtCamp(player) {
+		return nil
+	}
+	x, y, _ := s.campSite()
+	return fmt.Errorf("P%d is away from camp; the camp stores are at (%d,%d)", player.ID, x, y)
+}
+
+// campHas reports whether an intact structure of the kind stands at camp.
+func (s *RunState) campHas(kind CampStructureKind) bool {
+	x, y, _ := s.campSite()
+	for _, structure := range s.Camp.Structures {
+		if structure.Kind == kind && structure.X == x && structure.Y == y && structure.Durability > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// campHasHere is campHas for effects a player only gets while standing at camp.
+func (s *RunState) campHasHere(player *PlayerState, kind CampStructureKind) bool {
+	return s.playerAtCamp(player) && s.campHas(kind)
+}
+
+func (s *RunState) campStorageKg() float64 {
+	x, y, _ := s.campSite()
+	total := 0.0
+	for _, structure := range s.Camp.Structures {
+		if structure.X != x || structure.Y != y || structure.Durability <= 0 {
+			continue
+		}
+		if spec, ok := campStructureSpecFor(structure.ID); ok {
+			total += spec.StorageKg
+		}
+	}
+	return total
+}
+
+// placeCampStructure stands a freshly built structure at camp.
+func (s *RunState) placeCampStructure(id string, quality CraftQuality) CampStructure {
+	spec, _ := campStructureSpecFor(id)
+	s.placeCamp()
+	structure := CampStructure{ID: id, Kind: spec.Kind, X: s.Camp.X, Y: s.Camp.Y, Durability: 100, BuiltDay: s.Day, Quality: quality}
+	s.Camp.Structures = append(s.Camp.Structures, structure)
+	return structure
+}
+
+// decayCampStructures wears every structure down by a day, faster in the wet and in storms, and drops the ones that collapse.
+func (s *RunState) decayCampStructures() {
+	kept := s.Camp.Structures[:0]
+	for _, structure := range s.Camp.Structures {
+		loss := abandonedShelterDecay
+		if spec, ok := campStructureSpecFor(structure.ID); ok {
+			loss = max(1, int(math.Round(float64(spec.DurabilityPerDay)*qualityWearFactor(structure.Quality))))
+		} else if structure.Shelter != nil {
+			if spec, ok := shelterByID(structure.Shelter.Type); ok {
+				loss = max(1, spec.DurabilityPerDay) + abandonedShelterDecay
+			}
+		}
+		if isRainyWeather(s.Weather.Type) {
+			loss++
+		}
+		if isSevereWeather(s.Weather.Type) {
+			loss += 2
+		}
+		structure.Durability = clamp(structure.Durability-loss, 0, 100)
+		if structure.Shelter != nil {
+			structure.Shelter.Durability = structure.Durability
+		}
+		if structure.Durability == 0 && structure.Stores != nil {
+			s.reports = append(s.reports, fmt.Sprintf("The stores left at (%d,%d) have been lost to weather and animals.", structure.X, structure.Y))
+			continue
+		}
+		if structure.Durability == 0 {
+			s.reports = append(s.reports, fmt.Sprintf("The %s at (%d,%d) has fallen apart.", strings.ToLower(structure.label()), structure.X, structure.Y))
+			continue
+		}
+		kept = append(kept, structure)
+	}
+	s.Camp.Structures = kept
+}
+
+// collectRainWater fills camp stores from the rain catcher on a wet day.
+func (s *RunState) collectRainWater() {
+	if !s.campHas(CampStructureRainCatcher) {
+		return
+	}
+	mm := 0.0
+	for _, hour := range s.Weather.Hourly {
+		mm += hour.PrecipMM
+	}
+	if len(s.Weather.Hourly) == 0 {
+		mm = basePrecipMM(s.Weather.Type) * 6
+	}
+	litres := math.Floor(math.Min(rainCatcherMaxLitres, mm*rainCatcherLitresPerMM)*10) / 10
+	litres = math.Min(litres, math.Floor(s.campFreeKg()*10)/10)
+	if litres < 0.1 {
+		return
+	}
+	// Rain off a clean catcher is as safe as filtered water.
+	item := InventoryItem{ID: waterItemID(WaterFiltered), Name: waterItemName(WaterFiltered), Unit: "L", Qty: litres, WeightKg: 1, Category: "water"}
+	if err := s.addCampInventoryItem(item); err == nil {
+		s.reports = append(s.reports, fmt.Sprintf("The rain catcher filled %.1fL of clean water at camp.", litres))
+	}
+}
+
+// RelocateCamp moves camp to the player's current cell.
+func (s *RunState) RelocateCamp(playerID int) (string, float64, error) {
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return "", 0, fmt.Errorf("player %d not found", playerID)
+	}
+	x, y := player.Travel.PosX, player.Travel.PosY
+	oldX, oldY, placed := s.campSite()
+	if !placed || s.playerAtCamp(player) {
+		if !s.Camp.Placed {
+			s.Camp.X, s.Camp.Y, s.Camp.Placed = x, y, true
+		}
+		return fmt.Sprintf("Camp is set at (%d,%d).", s.Camp.X, s.Camp.Y), 0, nil
+	}
+
+	left := []string{}
+	if s.Shelter.Type != "" && s.Shelter.Durability > 0 {
+		old := s.Shelter
+		s.Camp.Structures = append(s.Camp.Structures, CampStructure{ID: string(old.Type), Kind: CampStructureShelter, X: old.SiteX, Y: old.SiteY, Durability: old.Durability, BuiltDay: old.BuiltDay, Shelter: &old})
+	}
+	s.Shelter = ShelterState{}
+	if stores, ok := s.packCampStores(); ok {
+		s.Camp.Structures = append(s.Camp.Structures, CampStructure{ID: string(CampStructureStores), Kind: CampStructureStores, X: oldX, Y: oldY, Durability: 100, BuiltDay: s.Day, Stores: &stores})
+	}
+	for _, structure := range s.Camp.Structures {
+		if structure.X == oldX && structure.Y == oldY {
+			left = append(left, strings.ToLower(structure.label()))
+		}
+	}
+	fireNote := ""
+	if s.Fire.Lit {
+		s.ExtinguishFire()
+		fireNote = " The old fire is left to die."
+	}
+	for i := range s.Players {
+		s.Players[i].MicroLocation = LocationOutside
+	}
+	s.Camp.X, s.Camp.Y, s.Camp.Placed = x, y, true
+
+	reclaimed := ""
+	kept := s.Camp.Structures[:0]
+	for _, structure := range s.Camp.Structures {
+		if structure.Shelter != nil && structure.X == x && structure.Y == y && s.Shelter.Type == "" {
+			s.Shelter = *structure.Shelter
+			reclaimed += fmt.Sprintf(" The %s here is still standing (%d%%).", strings.ToLower(structure.label()), structure.Durability)
+			continue
+		}
+		if structure.Stores != nil && structure.X == x && structure.Y == y {
+			s.unpackCampStores(*structure.Stores)
+			reclaimed += fmt.Sprintf(" The stores left here are still here (%.1fkg).", structure.Stores.WeightKg)
+			continue
+		}
+		kept = append(kept, structure)
+	}
+	s.Camp.Structures = kept
+
+	hours := campMoveHours
+	_ = s.AdvanceActionClock(hours)
+	player.Energy = clamp(player.Energy-int(math.Ceil(hours*2)), 0, 100)
+	player.Hydration = clamp(player.Hydration-int(math.Ceil(hours)), 0, 100)
+	refreshEffectBars(player)
+
+	msg := fmt.Sprintf("P%d moves camp to (%d,%d) (%.1fh).", playerID, x, y, hours)
+	if len(left) > 0 {
+		msg += " Left standing: " + strings.Join(left, ", ") + "."
+	}
+	return msg + fireNote + reclaimed, hours, nil
+}
+
+// packCampStores empties the camp stores so they can be left standing at the old site.
+func (s *RunState) packCampStores() (CampStores, bool) {
+	weight := s.campUsedKg()
+	if weight <= 0 {
+		return CampStores{}, false
+	}
+	stores := CampStores{Wood: s.WoodStock, Resources: s.ResourceStock, Items: s.CampInventory, WeightKg: weight}
+	s.WoodStock, s.ResourceStock, s.CampInventory = nil, nil, nil
+	return stores, true
+}
+
+// unpackCampStores puts stores left at a site back into the camp stores. They fitted there before, so no capacity check.
+func (s *RunState) unpackCampStores(stores CampStores) {
+	s.WoodStock = append(s.WoodStock, stores.Wood...)
+	s.ResourceStock = append(s.ResourceStock, stores.Resources...)
+	s.CampInventory = append(s.CampInventory, stores.Items...)
+}
+
+// CampStatus lists what stands at camp and what has been left elsewhere.
+func (s *RunState) CampStatus() string {
+	x, y, placed := s.campSite()
+	if !placed {
+		return fmt.Sprintf("No camp yet; building a shelter or camp structure sets it up here. Ground cache %.1f/%.1fkg.", s.campUsedKg(), s.campCapacityKg())
+	}
+	here := []string{}
+	if s.Shelter.Type != "" && s.Shelter.Durability > 0 {
+		name := string(s.Shelter.Type)
+		if spec, ok := shelterByID(s.Shelter.Type); ok {
+			name = spec.Name
+		}
+		here = append(here, fmt.Sprintf("%s %d%%", name, s.Shelter.Durability))
+	}
+	elsewhere := []string{}
+	for _, structure := range s.Camp.Structures {
+		if structure.X == x && structure.Y == y {
+			entry := fmt.Sprintf("%s %d%%", structure.label(), structure.Durability)
+			if spec, ok := campStructureSpecFor(structure.ID); ok {
+				entry += " (" + spec.Effect + ")"
+			}
+			here = append(here, entry)
+			continue
+		}
+		elsewhere = append(elsewhere, fmt.Sprintf("%s at (%d,%d) %d%%", structure.label(), structure.X, structure.Y, structure.Durability))
+	}
+	line := fmt.Sprintf("Camp at (%d,%d)", x, y)
+	if !s.atCamp() {
+		line += ", away"
+	}
+	line += fmt.Sprintf(": storage %.1f/%.1fkg.", s.campUsedKg(), s.campCapacityKg())
+	if len(here) == 0 {
+		line += " Nothing built yet."
+	} else {
+		line += " " + strings.Join(here, ", ") + "."
+	}
+	if len(elsewhere) > 0 {
+		line += " Left behind: " + strings.Join(elsewhere, ", ") + "."
+	}
+	return line
+}
+
+// CampSite is a map cell with camp structures on it, for drawing.
+type CampSite struct {
+	X      int
+	Y      int
+	Active bool
+}
+
+// CampSites lists camp and every cell with structures left on it.
+func (s *RunState) CampSites() []CampSite {
+	x, y, placed := s.campSite()
+	sites := []CampSite{}
+	if placed {
+		sites = append(sites, CampSite{X: x, Y: y, Active: true})
+	}
+	for _, structure := range s.Camp.Structures {
+		seen := false
+		for _, site := range sites {
+			if site.X == structure.X && site.Y == structure.Y {
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			sites = append(sites, CampSite{X: structure.X, Y: structure.Y})
+		}
+	}
+	return sites
+}
+
+func (s *RunState) executeCampCommand(fields []string) RunCommandResult {
+	if len(fields) == 0 || fields[0] == "status" {
+		return RunCommandResult{Handled: true, Message: s.CampStatus()}
+	}
+	switch fields[0] {
+	case "move", "relocate", "set", "here":
+		playerID, _ := extractPlayerID(fields[1:])
+		msg, hours, err := s.RelocateCamp(playerID)
+		if err != nil {
+			return RunCommandResult{Handled: true, Message: err.Error()}
+		}
+		return RunCommandResult{Handled: true, Message: msg, HoursAdvanced: hours}
+	default:
+		return RunCommandResult{Handled: true, Message: "Usage: camp [status] | camp move [p#]", Err: errCommandUsage}
+	}
+}
@@ -0,0 +1,112 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCampStructuresStandAtCampAndAddStorage(t *testing.T) {
+	run := newRunForCommands(t)
+	before := run.campCapacityKg()
+	if res := run.ExecuteRunCommand("craft make fire_pit"); !strings.Contains(res.Message, "built Fire Pit at camp") {
+		t.Fatalf("expected the fire pit to be built in place, got %q", res.Message)
+	}
+	if inventoryTotalQtyByID(run.CampInventory, "fire_pit") != 0 || !run.campHas(CampStructureFirePit) {
+		t.Fatalf("expected the pit on the ground, not in stores: %+v", run.Camp)
+	}
+
+	run.placeCampStructure("elevated_food_cache", CraftQualityGood)
+	if run.campCapacityKg() != before+20 {
+		t.Fatalf("expected the food cache to add its storage: %.1f -> %.1f", before, run.campCapacityKg())
+	}
+	if status := run.ExecuteRunCommand("camp").Message; !strings.Contains(status, "Fire Pit 100%") || !strings.Contains(status, "Elevated Food Cache") {
+		t.Fatalf("expected camp status to list the structures, got %q", status)
+	}
+}
+
+func TestMovingCampLeavesStructuresBehind(t *testing.T) {
+	run := newRunForCommands(t)
+	if _, err := run.BuildShelter(1, string(ShelterLeanTo)); err != nil {
+		t.Fatalf("build shelter: %v", err)
+	}
+	run.placeCampStructure("elevated_food_cache", CraftQualityGood)
+	run.Fire = FireState{Lit: true, Intensity: 40, HeatC: 50, FuelKg: 2}
+	if err := run.addCampInventoryItem(InventoryItem{ID: "salt", Name: "Salt", Unit: "kg", Qty: 2, WeightKg: 1, Category: "food"}); err != nil {
+		t.Fatalf("stock camp: %v", err)
+	}
+	homeX, homeY := run.CurrentMapPosition()
+	withShelter := run.campCapacityKg()
+
+	run.Players[0].Travel.PosX++
+	if _, err := run.CraftItem(1, "fire_pit"); err == nil || !strings.Contains(err.Error(), "camp move") {
+		t.Fatalf("expected building away from camp to be refused, got %v", err)
+	}
+	if err := run.StartFire(1, WoodTypeHardwood, 1); err == nil {
+		t.Fatalf("expected no fire away from camp")
+	}
+
+	res := run.ExecuteRunCommand("camp move")
+	if !strings.Contains(res.Message, "Left standing") || res.HoursAdvanced <= 0 {
+		t.Fatalf("expected the old structures to stay behind, got %+v", res)
+	}
+	if run.Shelter.Type != "" || run.Fire.Lit || run.campCapacityKg() >= withShelter || !run.atCamp() {
+		t.Fatalf("expected a bare new camp: shelter %+v fire %+v cap %.1f", run.Shelter, run.Fire, run.campCapacityKg())
+	}
+	if len(run.CampSites()) != 2 {
+		t.Fatalf("expected the old site on the map, got %+v", run.CampSites())
+	}
+	if inventoryTotalQtyByID(run.CampInventory, "salt") != 0 || !strings.Contains(run.CampStatus(), "Stores (2.0kg)") {
+		t.Fatalf("expected the stores to stay at the old site, got %q", run.CampStatus())
+	}
+
+	run.Players[0].Travel.PosX, run.Players[0].Travel.PosY = homeX, homeY
+	res = run.ExecuteRunCommand("camp move")
+	if run.Shelter.Type != ShelterLeanTo || !strings.Contains(res.Message, "still standing") || !run.campHas(CampStructureFoodCache) {
+		t.Fatalf("expected the old camp to be taken back, got %q shelter %+v", res.Message, run.Shelter)
+	}
+	if inventoryTotalQtyByID(run.CampInventory, "salt") != 2 {
+		t.Fatalf("expected the stores to be picked up again, got %+v", run.CampInventory)
+	}
+}
+
+func TestCampStoresAreOnlyReachableAtCamp(t *testing.T) {
+	run := newRunForCommands(t)
+	run.placeCamp()
+	if err := run.addCampInventoryItem(InventoryItem{ID: "salt", Name: "Salt", Unit: "kg", Qty: 1, WeightKg: 1, Category: "food"}); err != nil {
+		t.Fatalf("stock camp: %v", err)
+	}
+	run.Players[0].Travel.PosX++
+	if _, err := run.TakeCampItem(1, "salt", 0.5); err == nil || !strings.Contains(err.Error(), "away from camp") {
+		t.Fatalf("expected camp stores to be out of reach, got %v", err)
+	}
+	if qty := run.getInventoryQty(1, "salt"); qty != 0 {
+		t.Fatalf("expected camp salt not to count away from camp, got %.1f", qty)
+	}
+	if run.atCamp() {
+		t.Fatalf("expected nobody at camp")
+	}
+
+	run.Players[0].Travel.PosX--
+	if _, err := run.TakeCampItem(1, "salt", 0.5); err != nil {
+		t.Fatalf("expected to take from camp at camp: %v", err)
+	}
+}
+
+func TestCampStructuresWearOutAndTheRainCatcherFills(t *testing.T) {
+	run := newRunForCommands(t)
+	run.placeCampStructure("rain_catcher", CraftQualityFair)
+	run.placeCampStructure("fire_pit", CraftQualityFair)
+	run.Camp.Structures[1].Durability = 2
+	run.Weather = WeatherState{Day: run.Day, Type: WeatherHeavyRain, TemperatureC: 12}
+
+	run.progressCampState()
+	if got := inventoryTotalQtyByID(run.CampInventory, waterItemID(WaterFiltered)); got <= 0 {
+		t.Fatalf("expected the rain catcher to collect water, got %.1fL", got)
+	}
+	if run.campHas(CampStructureFirePit) || len(run.Camp.Structures) != 1 || run.Camp.Structures[0].Durability >= 100 {
+		t.Fatalf("expected the worn pit to collapse and the catcher to wear: %+v", run.Camp.Structures)
+	}
+	if !strings.Contains(strings.Join(run.DrainReports(), " "), "fire pit") {
+		t.Fatalf("expected the collapse to be reported")
+	}
+}
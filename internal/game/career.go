@@ -0,0 +1,281 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Discovery summary:
+//   - The GUI's playerProfile kept a config, a run count and total days, and nothing about what happened in each run.
+//   - Actions that matter for a career (hunts, forages, trap catches, fires, shelters, crafting, travel, predators, lessons)
+//     now record an event on the acting player's Tally as they happen; the tally saves with the run.
+//   - At the end of a run the tally, the player's outcome and the run's scenario become one CareerRun on the profile.
+//   - Achievements are checked against each new CareerRun and the career so far; per-mode bests are kept alongside.
+const careerRunHistory = 200
+
+// RunEventKind is a kind of thing a player did that counts toward their career.
+type RunEventKind string
+
+const (
+	EventHuntKcal      RunEventKind = "hunt_kcal"
+	EventFishKcal      RunEventKind = "fish_kcal"
+	EventForageKcal    RunEventKind = "forage_kcal"
+	EventTrapCatch     RunEventKind = "trap_catch"
+	EventTrapKg        RunEventKind = "trap_kg"
+	EventFireLit       RunEventKind = "fire_lit"
+	EventShelterBuilt  RunEventKind = "shelter_built"
+	EventItemCrafted   RunEventKind = "item_crafted"
+	EventKmTravelled   RunEventKind = "km_travelled"
+	EventPredatorFaced RunEventKind = "predator_faced"
+	EventPredatorWon   RunEventKind = "predator_won"
+	EventLessonGiven   RunEventKind = "lesson_given"
+)
+
+// recordEvent adds to the player's running tally for the run and to the run's statistics (run_stats.go).
+func (s *RunState) recordEvent(player *PlayerState, kind RunEventKind, value float64) {
+	if player == nil || value == 0 {
+		return
+	}
+	if player.Tally == nil {
+		player.Tally = map[RunEventKind]float64{}
+	}
+	player.Tally[kind] += value
+	s.logRunEvent(player.ID, kind, value, "")
+}
+
+// CareerRun is one finished run from one player's point of view.
+type CareerRun struct {
+	Mode         GameMode                 `json:"mode"`
+	ScenarioID   ScenarioID               `json:"scenario_id"`
+	ScenarioName string                   `json:"scenario_name"`
+	Seed         int64                    `json:"seed"`
+	DaysSurvived int                      `json:"days_survived"`
+	RunStatus    RunOutcomeStatus         `json:"run_status"`
+	Status       ContestantStatus         `json:"status"`
+	EndCause     string                   `json:"end_cause"`
+	Tally        map[RunEventKind]float64 `json:"tally,omitempty"`
+}
+
+// KcalHunted is everything the player hunted and fished, as edible calories.
+func (r CareerRun) KcalHunted() float64 {
+	return r.Tally[EventHuntKcal] + r.Tally[EventFishKcal]
+}
+
+// KcalForaged is everything the player foraged, in calories.
+func (r CareerRun) KcalForaged() float64 {
+	return r.Tally[EventForageKcal]
+}
+
+// CareerBest is a profile's best marks in one game mode.
+type CareerBest struct {
+	Runs            int     `json:"runs"`
+	MostDays        int     `json:"most_days"`
+	MostKcalHunted  float64 `json:"most_kcal_hunted"`
+	MostKcalForaged float64 `json:"most_kcal_foraged"`
+	MostKm          float64 `json:"most_km"`
+	Completed       int     `json:"completed"`
+}
+
+// UnlockedAchievement is an achievement earned and the run it was earned in (1-based).
+type UnlockedAchievement struct {
+	ID  string `json:"id"`
+	Run int    `json:"run"`
+}
+
+// CareerRecord is a profile's run history, per-mode bests and achievements.
+type CareerRecord struct {
+	RunsPlayed   int                      `json:"runs_played"`
+	Runs         []CareerRun              `json:"runs,omitempty"`
+	Best         map[GameMode]CareerBest  `json:"best,omitempty"`
+	Totals       map[RunEventKind]float64 `json:"totals,omitempty"`
+	Achievements []UnlockedAchievement    `json:"achievements,omitempty"`
+}
+
+// Achievement is a career milestone with its unlock condition.
+type Achievement struct {
+	ID          string
+	Name        string
+	Description string
+	unlocked    func(run CareerRun, career CareerRecord) bool
+}
+
+func tallyAtLeast(kind RunEventKind, value float64) func(CareerRun, CareerRecord) bool {
+	return func(run CareerRun, _ CareerRecord) bool { return run.Tally[kind] >= value }
+}
+
+func daysAtLeast(days int) func(CareerRun, CareerRecord) bool {
+	return func(run CareerRun, _ CareerRecord) bool { return run.DaysSurvived >= days }
+}
+
+var achievements = []Achievement{
+	{ID: "first_flame", Name: "First Flame", Description: "Light a fire.", unlocked: tallyAtLeast(EventFireLit, 1)},
+	{ID: "fire_keeper", Name: "Fire Keeper", Description: "Light 10 fires in one run.", unlocked: tallyAtLeast(EventFireLit, 10)},
+	{ID: "roof_overhead", Name: "Roof Overhead", Description: "Build a shelter.", unlocked: tallyAtLeast(EventShelterBuilt, 1)},
+	{ID: "maker", Name: "Maker", Description: "Craft 15 items in one run.", unlocked: tallyAtLeast(EventItemCrafted, 15)},
+	{ID: "provider", Name: "Provider", Description: "Hunt or fish 5,000 kcal in one run.", unlocked: func(run CareerRun, _ CareerRecord) bool { return run.KcalHunted() >= 5000 }},
+	{ID: "gatherer", Name: "Gatherer", Description: "Forage 3,000 kcal in one run.", unlocked: tallyAtLeast(EventForageKcal, 3000)},
+	{ID: "trapline", Name: "Trapline", Description: "Take 5 trap catches in one run.", unlocked: tallyAtLeast(EventTrapCatch, 5)},
+	{ID: "wanderer", Name: "Wanderer", Description: "Travel 50 km in one run.", unlocked: tallyAtLeast(EventKmTravelled, 50)},
+	{ID: "stood_ground", Name: "Stood Ground", Description: "See off a predator.", unlocked: tallyAtLeast(EventPredatorWon, 1)},
+	{ID: "mentor", Name: "Mentor", Description: "Teach a teammate a skill.", unlocked: tallyAtLeast(EventLessonGiven, 1)},
+	{ID: "first_week", Name: "First Week", Description: "Survive 7 days.", unlocked: daysAtLeast(7)},
+	{ID: "one_month", Name: "One Month", Description: "Survive 30 days.", unlocked: daysAtLeast(30)},
+	{ID: "the_long_haul", Name: "The Long Haul", Description: "Survive 100 days.", unlocked: daysAtLeast(100)},
+	{ID: "went_the_distance", Name: "Went the Distance", Description: "Finish a fixed-length run still in it.", unlocked: func(run CareerRun, _ CareerRecord) bool {
+		return run.RunStatus == RunOutcomeCompleted && run.Status == ContestantActive
+	}},
+	{ID: "rescued", Name: "Flagged Down", Description: "Be picked up by a patrol.", unlocked: func(run CareerRun, _ CareerRecord) bool { return run.Status == ContestantRescued }},
+	{ID: "veteran", Name: "Veteran", Description: "Play 10 runs.", unlocked: func(_ CareerRun, career CareerRecord) bool { return career.RunsPlayed >= 10 }},
+	{ID: "globetrotter", Name: "Globetrotter", Description: "Play 5 different scenarios.", unlocked: func(_ CareerRun, career CareerRecord) bool {
+		seen := map[ScenarioID]bool{}
+		for _, run := range career.Runs {
+			seen[run.ScenarioID] = true
+		}
+		return len(seen) >= 5
+	}},
+}
+
+// Achievements lists every achievement in display order.
+func Achievements() []Achievement {
+	return append([]Achievement(nil), achievements...)
+}
+
+func achievementByID(id string) (Achievement, bool) {
+	for _, a := range achievements {
+		if a.ID == id {
+			return a, true
+		}
+	}
+	return Achievement{}, false
+}
+
+// CareerRunFor records how the run went for one player.
+func (s *RunState) CareerRunFor(playerID int) (CareerRun, error) {
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return CareerRun{}, fmt.Errorf("player %d not found", playerID)
+	}
+	outcome := s.EvaluateRun()
+	run := CareerRun{
+		Mode:         s.Config.Mode,
+		ScenarioID:   s.Scenario.ID,
+		ScenarioName: s.Scenario.Name,
+		Seed:         s.Config.Seed,
+		DaysSurvived: max(0, s.Day-1),
+		RunStatus:    outcome.Status,
+		Status:       ContestantActive,
+		Tally:        map[RunEventKind]float64{},
+	}
+	for kind, value := range player.Tally {
+		run.Tally[kind] = value
+	}
+	for _, o := range s.Outcomes {
+		if o.PlayerID != playerID {
+			continue
+		}
+		run.Status = o.Status
+		run.DaysSurvived = max(0, o.Day-1)
+		run.EndCause = StatusLabel(o.Status)
+		if o.Cause != "" {
+			run.EndCause += ": " + o.Cause
+		}
+	}
+	if run.EndCause == "" {
+		switch outcome.Status {
+		case RunOutcomeCompleted:
+			run.EndCause = "completed the run"
+		case RunOutcomeRescued:
+			run.EndCause = "rescued"
+		default:
+			run.EndCause = "left mid-run"
+		}
+	}
+	return run, nil
+}
+
+// AddRun files a finished run and returns the achievements it unlocked.
+func (c *CareerRecord) AddRun(run CareerRun) []Achievement {
+	c.RunsPlayed++
+	c.Runs = append(c.Runs, run)
+	if len(c.Runs) > careerRunHistory {
+		c.Runs = append([]CareerRun(nil), c.Runs[len(c.Runs)-careerRunHistory:]...)
+	}
+	if c.Totals == nil {
+		c.Totals = map[RunEventKind]float64{}
+	}
+	for kind, value := range run.Tally {
+		c.Totals[kind] += value
+	}
+	if c.Best == nil {
+		c.Best = map[GameMode]CareerBest{}
+	}
+	best := c.Best[run.Mode]
+	best.Runs++
+	best.MostDays = max(best.MostDays, run.DaysSurvived)
+	best.MostKcalHunted = math.Max(best.MostKcalHunted, run.KcalHunted())
+	best.MostKcalForaged = math.Max(best.MostKcalForaged, run.KcalForaged())
+	best.MostKm = math.Max(best.MostKm, run.Tally[EventKmTravelled])
+	if run.RunStatus == RunOutcomeCompleted && run.Status == ContestantActive {
+		best.Completed++
+	}
+	c.Best[run.Mode] = best
+
+	unlocked := []Achievement{}
+	for _, a := range achievements {
+		if c.HasAchievement(a.ID) || !a.unlocked(run, *c) {
+			continue
+		}
+		c.Achievements = append(c.Achievements, UnlockedAchievement{ID: a.ID, Run: c.RunsPlayed})
+		unlocked = append(unlocked, a)
+	}
+	return unlocked
+}
+
+// HasAchievement reports whether the career has earned the achievement.
+func (c CareerRecord) HasAchievement(id string) bool {
+	for _, a := range c.Achievements {
+		if a.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// TotalDays is every day survived across the recorded runs.
+func (c CareerRecord) TotalDays() int {
+	total := 0
+	for _, run := range c.Runs {
+		total += run.DaysSurvived
+	}
+	return total
+}
+
+// Lines renders the career for the career screen.
+func (c CareerRecord) Lines() []string {
+	lines := []string{fmt.Sprintf("Runs %d | Days %d | Hunted %.0f kcal | Foraged %.0f kcal | Trap catches %.0f | Km %.1f",
+		c.RunsPlayed, c.TotalDays(), c.Totals[EventHuntKcal]+c.Totals[EventFishKcal], c.Totals[EventForageKcal], c.Totals[EventTrapCatch], c.Totals[EventKmTravelled])}
+	modes := make([]GameMode, 0, len(c.Best))
+	for mode := range c.Best {
+		modes = append(modes, mode)
+	}
+	sort.Slice(modes, func(i, j int) bool { return modes[i] < modes[j] })
+	for _, mode := range modes {
+		best := c.Best[mode]
+		lines = append(lines, fmt.Sprintf("Best %s: %d days, %.0f kcal hunted, %.0f kcal foraged, %.1f km (%d runs, %d finished)",
+			mode, best.MostDays, best.MostKcalHunted, best.MostKcalForaged, best.MostKm, best.Runs, best.Completed))
+	}
+	names := []string{}
+	for _, unlocked := range c.Achievements {
+		if a, ok := achievementByID(unlocked.ID); ok {
+			names = append(names, a.Name)
+		}
+	}
+	lines = append(lines, fmt.Sprintf("Achievements %d/%d: %s", len(names), len(achievements), strings.Join(names, ", ")))
+	for i := len(c.Runs) - 1; i >= 0 && i >= len(c.Runs)-5; i-- {
+		run := c.Runs[i]
+		lines = append(lines, fmt.Sprintf("%s (%s): %d days, %s", run.ScenarioName, run.Mode, run.DaysSurvived, run.EndCause))
+	}
+	return lines
+}
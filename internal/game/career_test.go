@@ -0,0 +1,57 @@
+package game
+
+import "testing"
+
+func TestActionsRecordCareerEvents(t *testing.T) {
+	run := newRunForCommands(t)
+	if _, err := run.ForageAndConsume(1, PlantCategoryAny, 0); err != nil {
+		t.Fatalf("forage: %v", err)
+	}
+	if _, err := run.TravelMove(1, "north", 1); err != nil {
+		t.Fatalf("travel: %v", err)
+	}
+	tally := run.Players[0].Tally
+	if tally[EventForageKcal] <= 0 || tally[EventKmTravelled] <= 0 {
+		t.Fatalf("expected forage and travel in the tally, got %v", tally)
+	}
+
+	run.Day = 9
+	career, err := run.CareerRunFor(1)
+	if err != nil {
+		t.Fatalf("career run: %v", err)
+	}
+	if career.DaysSurvived != 8 || career.EndCause != "left mid-run" || career.KcalForaged() != tally[EventForageKcal] {
+		t.Fatalf("unexpected career run: %+v", career)
+	}
+}
+
+func TestCareerKeepsBestsAndUnlocksAchievementsOnce(t *testing.T) {
+	var career CareerRecord
+	first := CareerRun{Mode: ModeAlone, ScenarioID: ScenarioVancouverIslandID, DaysSurvived: 10, RunStatus: RunOutcomeCompleted, Status: ContestantActive,
+		Tally: map[RunEventKind]float64{EventFireLit: 2, EventHuntKcal: 6000}}
+	unlocked := career.AddRun(first)
+	ids := map[string]bool{}
+	for _, a := range unlocked {
+		ids[a.ID] = true
+	}
+	for _, id := range []string{"first_flame", "provider", "first_week", "went_the_distance"} {
+		if !ids[id] {
+			t.Fatalf("expected %s to unlock, got %v", id, ids)
+		}
+	}
+	if ids["one_month"] || ids["roof_overhead"] {
+		t.Fatalf("unexpected unlocks: %v", ids)
+	}
+
+	second := CareerRun{Mode: ModeAlone, DaysSurvived: 4, RunStatus: RunOutcomeEnded, Status: ContestantTappedOut, Tally: map[RunEventKind]float64{EventFireLit: 1, EventForageKcal: 900}}
+	if again := career.AddRun(second); len(again) != 0 {
+		t.Fatalf("expected no repeat unlocks, got %v", again)
+	}
+	best := career.Best[ModeAlone]
+	if best.Runs != 2 || best.MostDays != 10 || best.MostKcalHunted != 6000 || best.MostKcalForaged != 900 || best.Completed != 1 {
+		t.Fatalf("unexpected bests: %+v", best)
+	}
+	if career.Totals[EventFireLit] != 3 || career.TotalDays() != 14 {
+		t.Fatalf("unexpected totals: %v", career.Totals)
+	}
+}
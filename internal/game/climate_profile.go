@@ -87,6 +87,15 @@ func (s *RunState) CurrentBiomeQuery() string {
 	return s.BiomeQueryAt(x, y)
 }
 
+// PlayerBiomeQuery is the biome of the cell one player is standing on.
+func (s *RunState) PlayerBiomeQuery(playerID int) string {
+	if s == nil {
+		return ""
+	}
+	x, y := s.PlayerMapPosition(playerID)
+	return s.BiomeQueryAt(x, y)
+}
+
 func (s *RunState) BiomeQueryAt(x, y int) string {
 	if s == nil {
 		return ""
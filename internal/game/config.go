@@ -32,6 +32,11 @@ func (r RunLength) IsValid() bool {
 }
 
 func (c RunConfig) Validate() error {
+	return c.validateWith(AllScenarios())
+}
+
+// validateWith checks the config against the given scenarios rather than the registered ones.
+func (c RunConfig) validateWith(scenarios []Scenario) error {
 	switch c.Mode {
 	case ModeNakedAndAfraid:
 	case ModeNakedAndAfraidXL:
@@ -47,7 +52,7 @@ func (c RunConfig) Validate() error {
 	found := c.ScenarioID == ScenarioRandomID
 
 	if !found {
-		for _, scenario := range AllScenarios() {
+		for _, scenario := range scenarios {
 			if scenario.ID == c.ScenarioID {
 				found = true
 				break
@@ -0,0 +1,407 @@
+package game
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Discovery summary:
+//   - Catalogs point at each other by plain string ID (trap and craftable requirements, tree bark, shelter stages,
+//     encounter tables) and climate profiles filter by tag; nothing checked those strings until a run tripped on one.
+//   - Pack loading already rejects unknown IDs in pack entries; CheckContent runs over the merged catalogs so
+//     built-ins, packs and scenarios are checked together, which is what cmd/contentcheck reports.
+//   - Reachability follows the game's own lookups: CraftablesForBiome and ResourcesForBiome on the scenario biome,
+//     plus bark stripped from TreesForBiome, with craftable prerequisites resolved recursively.
+//   - Food and fauna checks use each season of the scenario's season sets on the topology biomes its climate
+//     allows, at the season's base temperature, through the same climate filters foraging and hunting use.
+
+const (
+	ContentScenario  ContentKind = "scenario"
+	ContentEncounter ContentKind = "encounter"
+	ContentClimate   ContentKind = "climate"
+)
+
+// ContentCheck names the check that produced a finding.
+type ContentCheck string
+
+const (
+	CheckDanglingReference ContentCheck = "dangling_reference"
+	CheckUnreachable       ContentCheck = "unreachable_craftable"
+	CheckNoFood            ContentCheck = "no_food"
+	CheckNoFauna           ContentCheck = "no_fauna"
+)
+
+// ContentFinding is one problem CheckContent found.
+type ContentFinding struct {
+	Level   ContentProblemLevel `json:"level"`
+	Check   ContentCheck        `json:"check"`
+	Kind    ContentKind         `json:"kind"`
+	ID      string              `json:"id"`
+	Message string              `json:"message"`
+}
+
+func (f ContentFinding) String() string {
+	return fmt.Sprintf("%s: %s %s %q: %s", f.Level, f.Check, f.Kind, f.ID, f.Message)
+}
+
+// encounterTopoBiomes are the topology biomes that carry encounter tables.
+var encounterTopoBiomes = []uint8{
+	TopoBiomeForest,
+	TopoBiomeGrassland,
+	TopoBiomeJungle,
+	TopoBiomeWetland,
+	TopoBiomeSwamp,
+	TopoBiomeDesert,
+	TopoBiomeMountain,
+	TopoBiomeTundra,
+	TopoBiomeBoreal,
+}
+
+type contentChecker struct {
+	findings []ContentFinding
+}
+
+func (c *contentChecker) report(level ContentProblemLevel, check ContentCheck, kind ContentKind, id string, format string, args ...any) {
+	c.findings = append(c.findings, ContentFinding{Level: level, Check: check, Kind: kind, ID: id, Message: fmt.Sprintf(format, args...)})
+}
+
+// CheckContent checks the active catalogs (built-ins plus content packs) and the given scenarios for dangling
+// references, craftables no scenario can make, seasons with nothing to eat and climates that filter out all fauna.
+func CheckContent(scenarios []Scenario) []ContentFinding {
+	var c contentChecker
+	c.checkReferences(scenarios)
+	c.checkReachability(scenarios)
+	for _, scenario := range scenarios {
+		c.checkFood(scenario)
+		c.checkFauna(scenario)
+	}
+	sort.SliceStable(c.findings, func(i, j int) bool {
+		if c.findings[i].Check != c.findings[j].Check {
+			return c.findings[i].Check < c.findings[j].Check
+		}
+		return c.findings[i].Kind < c.findings[j].Kind
+	})
+	return c.findings
+}
+
+// catalogIDs is the ID vocabulary of the merged catalogs.
+func catalogIDs() contentIDs {
+	known := contentIDs{resources: map[string]bool{}, craftables: map[string]bool{}, kit: map[KitItem]bool{}, biomeTags: map[string]bool{}}
+	for _, r := range ResourceCatalog() {
+		known.resources[r.ID] = true
+	}
+	for _, cr := range CraftableCatalog() {
+		known.craftables[cr.ID] = true
+	}
+	for _, item := range AllKitItems() {
+		known.kit[item] = true
+	}
+	return known
+}
+
+func (c *contentChecker) refs(kind ContentKind, id string, craftables []string, resources []ResourceRequirement, kit []KitItem, known contentIDs) {
+	for _, need := range craftables {
+		if !known.craftables[need] {
+			c.report(ContentError, CheckDanglingReference, kind, id, "requires unknown craftable %q", need)
+		}
+	}
+	for _, need := range resources {
+		if !known.resources[need.ID] {
+			c.report(ContentError, CheckDanglingReference, kind, id, "requires unknown resource %q", need.ID)
+		}
+	}
+	for _, item := range kit {
+		if !known.kit[item] {
+			c.report(ContentError, CheckDanglingReference, kind, id, "requires unknown kit item %q", item)
+		}
+	}
+}
+
+func (c *contentChecker) checkReferences(scenarios []Scenario) {
+	known := catalogIDs()
+	for _, cr := range CraftableCatalog() {
+		c.refs(ContentCraftable, cr.ID, cr.RequiresItems, cr.RequiresResources, nil, known)
+	}
+	for _, t := range TrapCatalog() {
+		c.refs(ContentTrap, t.ID, t.RequiresCrafted, t.RequiresResources, t.RequiresKit, known)
+	}
+	for _, t := range TreeCatalog() {
+		if t.BarkResource != "" && !known.resources[t.BarkResource] {
+			c.report(ContentError, CheckDanglingReference, ContentTree, t.ID, "bark resource %q is not in the resource catalog", t.BarkResource)
+		}
+	}
+	for _, s := range ShelterCatalog() {
+		c.refs(ContentShelter, string(s.ID), s.UpgradeComponents, nil, nil, known)
+		for _, stage := range s.Stages {
+			c.refs(ContentShelter, string(s.ID)+"/"+stage.ID, stage.RequiresItems, stage.RequiresResources, nil, known)
+		}
+	}
+
+	animals := map[string]bool{}
+	for _, a := range AnimalCatalog() {
+		animals[a.ID] = true
+	}
+	for _, biome := range encounterTopoBiomes {
+		for _, channel := range []string{"mammal", "bird", "fish"} {
+			for _, sp := range biomeEncounterList(biome, channel) {
+				if !animals[normalizeTag(sp.AnimalID)] {
+					c.report(ContentError, CheckDanglingReference, ContentEncounter, sp.AnimalID, "%s table for %s biome names an animal missing from the animal catalog", channel, topoBiomeLabel(biome))
+				}
+			}
+		}
+	}
+
+	vocabulary := climateTagVocabulary()
+	for _, scenario := range scenarios {
+		id := string(scenario.ID)
+		if scenario.DefaultSeasonSetID != "" && !slices.ContainsFunc(scenario.SeasonSets, func(set SeasonSet) bool { return set.ID == scenario.DefaultSeasonSetID }) {
+			c.report(ContentError, CheckDanglingReference, ContentScenario, id, "default season set %q is not one of its season sets", scenario.DefaultSeasonSetID)
+		}
+		climate := scenario.Climate
+		if climate == nil {
+			continue
+		}
+		tagLists := map[string][]string{"DisallowTags": climate.DisallowTags}
+		for season, rule := range climate.SeasonRules {
+			tagLists[string(season)+" AllowedFloraTags"] = rule.AllowedFloraTags
+			tagLists[string(season)+" AllowedFaunaTags"] = rule.AllowedFaunaTags
+			tagLists[string(season)+" DisallowedFloraTags"] = rule.DisallowedFloraTags
+			tagLists[string(season)+" DisallowedFaunaTags"] = rule.DisallowedFaunaTags
+		}
+		fields := make([]string, 0, len(tagLists))
+		for field := range tagLists {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			for _, tag := range tagLists[field] {
+				if !vocabulary[normalizeTag(tag)] {
+					c.report(ContentWarning, CheckDanglingReference, ContentClimate, id, "%s tag %q matches no plant, animal or biome", field, tag)
+				}
+			}
+		}
+	}
+}
+
+// climateTagVocabulary is every tag a climate profile's filters can match.
+func climateTagVocabulary() map[string]bool {
+	vocabulary := map[string]bool{}
+	add := func(tags []string) {
+		for _, tag := range tags {
+			vocabulary[normalizeTag(tag)] = true
+		}
+	}
+	for _, plant := range PlantCatalog() {
+		add(plantClimateTags(plant))
+	}
+	for _, biome := range encounterTopoBiomes {
+		add(topoBiomeTags(biome))
+		for _, channel := range []string{"mammal", "bird", "fish", "insect"} {
+			for _, sp := range biomeEncounterList(biome, channel) {
+				add(encounterSpeciesClimateTags(sp.AnimalID, channel))
+				if channel == "insect" {
+					add(insectNameClimateTags(sp.Name))
+				}
+			}
+		}
+	}
+	for _, animal := range AnimalCatalog() {
+		for _, channel := range []string{"mammal", "bird", "fish"} {
+			add(encounterSpeciesClimateTags(animal.ID, channel))
+		}
+	}
+	return vocabulary
+}
+
+// biomeReach works out what can be crafted in one scenario biome.
+type biomeReach struct {
+	offered   map[string]CraftableSpec
+	resources map[string]bool
+	memo      map[string]string
+}
+
+const reachVisiting = "\x00visiting"
+
+func newBiomeReach(biome string) *biomeReach {
+	r := &biomeReach{offered: map[string]CraftableSpec{}, resources: map[string]bool{}, memo: map[string]string{}}
+	for _, cr := range CraftablesForBiome(biome) {
+		r.offered[cr.ID] = cr
+	}
+	for _, res := range ResourcesForBiome(biome) {
+		r.resources[res.ID] = true
+	}
+	for _, tree := range TreesForBiome(biome) {
+		r.resources["inner_bark_fiber"] = true
+		if tree.BarkResource != "" {
+			r.resources[tree.BarkResource] = true
+		}
+	}
+	return r
+}
+
+// blocker returns why a craftable cannot be made in the biome, or "" when it can.
+func (r *biomeReach) blocker(id string) string {
+	if why, ok := r.memo[id]; ok {
+		if why == reachVisiting {
+			return fmt.Sprintf("%s depends on itself", id)
+		}
+		return why
+	}
+	r.memo[id] = reachVisiting
+	why := ""
+	cr, ok := r.offered[id]
+	if !ok {
+		why = fmt.Sprintf("%s is not offered", id)
+	}
+	for _, need := range cr.RequiresResources {
+		if why == "" && !r.resources[need.ID] {
+			why = fmt.Sprintf("resource %s cannot be gathered", need.ID)
+		}
+	}
+	for _, need := range cr.RequiresItems {
+		if why != "" {
+			break
+		}
+		if sub := r.blocker(need); sub != "" {
+			why = fmt.Sprintf("needs %s (%s)", need, sub)
+		}
+	}
+	r.memo[id] = why
+	return why
+}
+
+func (c *contentChecker) checkReachability(scenarios []Scenario) {
+	var biomes []string
+	for _, scenario := range scenarios {
+		biome := normalizeBiome(scenario.Biome)
+		if biome != "" && !slices.Contains(biomes, biome) {
+			biomes = append(biomes, biome)
+		}
+	}
+	reach := make([]*biomeReach, len(biomes))
+	for i, biome := range biomes {
+		reach[i] = newBiomeReach(biome)
+	}
+	for _, cr := range CraftableCatalog() {
+		reachable, reason := false, ""
+		for i, r := range reach {
+			why := r.blocker(cr.ID)
+			if why == "" {
+				reachable = true
+				break
+			}
+			if _, offered := r.offered[cr.ID]; offered && reason == "" {
+				reason = fmt.Sprintf("in %s: %s", biomes[i], why)
+			}
+		}
+		if reachable {
+			continue
+		}
+		if reason == "" {
+			reason = fmt.Sprintf("no scenario biome matches its biome tags %v", cr.BiomeTags)
+		}
+		c.report(ContentWarning, CheckUnreachable, ContentCraftable, cr.ID, "cannot be crafted in any scenario: %s", reason)
+	}
+}
+
+// scenarioSeasons lists each season a scenario's season sets pass through, in order.
+func scenarioSeasons(scenario Scenario) []SeasonID {
+	var seasons []SeasonID
+	for _, set := range scenario.SeasonSets {
+		for _, phase := range set.Phases {
+			if phase.Season != "" && !slices.Contains(seasons, phase.Season) {
+				seasons = append(seasons, phase.Season)
+			}
+		}
+	}
+	if len(seasons) == 0 {
+		seasons = []SeasonID{SeasonAutumn}
+	}
+	return seasons
+}
+
+// scenarioTopoBiomes lists the topology biomes a scenario's climate lets the map use.
+func scenarioTopoBiomes(scenario Scenario) []uint8 {
+	var out []uint8
+	for _, biome := range encounterTopoBiomes {
+		if climateAllowsTopoBiome(scenario.Climate, biome) {
+			out = append(out, biome)
+		}
+	}
+	return out
+}
+
+func seasonBaseTempC(climate *ClimateProfile, season SeasonID) int {
+	if climate == nil {
+		return 0
+	}
+	rule, _ := climateSeasonRule(climate, season)
+	return climate.BaseTempC + rule.TempBiasC
+}
+
+// seasonalFoodPlants are the non-toxic plants with calories that match a biome and season outright, without
+// the out-of-season fallback foraging uses when nothing matches.
+func seasonalFoodPlants(biome string, season SeasonID) []PlantSpec {
+	norm := normalizeBiome(biome)
+	var out []PlantSpec
+	for _, plant := range PlantCatalog() {
+		if plant.Toxicity > 0 || plant.NutritionPer100g.CaloriesKcal <= 0 || !plantSeasonMatches(plant, season) {
+			continue
+		}
+		if slices.ContainsFunc(plant.BiomeTags, func(tag string) bool { return strings.Contains(norm, normalizeBiome(tag)) }) {
+			out = append(out, plant)
+		}
+	}
+	return out
+}
+
+func (c *contentChecker) checkFood(scenario Scenario) {
+	for _, season := range scenarioSeasons(scenario) {
+		tempC := seasonBaseTempC(scenario.Climate, season)
+		var starving, noForage []string
+		for _, biome := range scenarioTopoBiomes(scenario) {
+			query := topoBiomeQuery(biome)
+			plants := filterPlantsForClimate(seasonalFoodPlants(query, season), scenario.Climate, season, tempC)
+			prey := 0
+			for _, domain := range []AnimalDomain{AnimalDomainLand, AnimalDomainWater, AnimalDomainAir} {
+				prey += len(filterAnimalsForClimate(AnimalsForBiome(query, domain), domain, scenario.Climate, season, tempC))
+			}
+			switch {
+			case len(plants) == 0 && prey == 0:
+				starving = append(starving, topoBiomeLabel(biome))
+			case len(plants) == 0:
+				noForage = append(noForage, topoBiomeLabel(biome))
+			}
+		}
+		if len(starving) > 0 {
+			c.report(ContentWarning, CheckNoFood, ContentScenario, string(scenario.ID), "no plants or game in %s during %s", strings.Join(starving, ", "), season)
+		}
+		if len(noForage) > 0 {
+			c.report(ContentWarning, CheckNoFood, ContentScenario, string(scenario.ID), "nothing in season to forage in %s during %s; foraging falls back to out-of-season plants", strings.Join(noForage, ", "), season)
+		}
+	}
+}
+
+func (c *contentChecker) checkFauna(scenario Scenario) {
+	if scenario.Climate == nil {
+		return
+	}
+	for _, season := range scenarioSeasons(scenario) {
+		tempC := seasonBaseTempC(scenario.Climate, season)
+		var empty []string
+		for _, biome := range scenarioTopoBiomes(scenario) {
+			left := 0
+			for _, channel := range []string{"mammal", "bird", "fish"} {
+				left += len(filterEncounterSpeciesForClimate(biomeEncounterList(biome, channel), channel, scenario.Climate, season, tempC, biome))
+			}
+			if left == 0 {
+				empty = append(empty, topoBiomeLabel(biome))
+			}
+		}
+		if len(empty) > 0 {
+			c.report(ContentError, CheckNoFauna, ContentClimate, string(scenario.ID), "climate %q filters out every mammal, bird and fish in %s during %s", scenario.Climate.Name, strings.Join(empty, ", "), season)
+		}
+	}
+}
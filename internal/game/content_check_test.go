@@ -0,0 +1,54 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckContentBuiltinsHaveNoErrors(t *testing.T) {
+	for _, f := range CheckContent(BuiltInScenarios()) {
+		if f.Level == ContentError {
+			t.Fatalf("unexpected error in built-in content: %s", f)
+		}
+	}
+}
+
+func TestCheckContentReportsBrokenReferencesAndEmptyClimates(t *testing.T) {
+	set := ContentPackSet{Packs: []ContentPack{{ID: "broken",
+		Trees: []TreeSpec{{ID: "ghost_gum", Name: "Ghost Gum", BiomeTags: []string{"forest"}, WoodType: WoodTypeHardwood, GatherMinKg: 1, GatherMaxKg: 2, BarkResource: "ghost_bark"}},
+		Craftables: []CraftableSpec{
+			{ID: "moon_rope", Name: "Moon Rope", BiomeTags: []string{"moonbase"}},
+			{ID: "bark_bundle", Name: "Bark Bundle", BiomeTags: []string{"forest"}, RequiresItems: []string{"ghost_twine"}},
+		},
+	}}}
+	set.merge()
+	SetContentPacks(set)
+	t.Cleanup(func() { SetContentPacks(ContentPackSet{}) })
+
+	barren := Scenario{ID: "barren", Name: "Barren", Biome: "temperate_forest", DefaultSeasonSetID: "missing",
+		SeasonSets: []SeasonSet{{ID: "only", Phases: []SeasonPhase{{Season: SeasonAutumn}}}},
+		Climate: &ClimateProfile{Name: "Dead Woods", AllowedBiomes: []uint8{TopoBiomeForest},
+			SeasonRules: map[SeasonID]SeasonRule{SeasonAutumn: {AllowedFaunaTags: []string{"unicorn"}}}},
+	}
+	var got []string
+	for _, f := range CheckContent([]Scenario{barren}) {
+		got = append(got, f.String())
+	}
+	all := strings.Join(got, "\n")
+	for _, want := range []string{
+		`error: dangling_reference tree "ghost_gum": bark resource "ghost_bark"`,
+		`error: dangling_reference craftable "bark_bundle": requires unknown craftable "ghost_twine"`,
+		`error: dangling_reference scenario "barren": default season set "missing"`,
+		`warning: dangling_reference climate "barren": autumn AllowedFaunaTags tag "unicorn"`,
+		`warning: unreachable_craftable craftable "moon_rope": cannot be crafted in any scenario: no scenario biome matches`,
+		`warning: unreachable_craftable craftable "bark_bundle": cannot be crafted in any scenario: in temperate_forest: needs ghost_twine`,
+		`error: no_fauna climate "barren": climate "Dead Woods" filters out every mammal, bird and fish in forested during autumn`,
+	} {
+		if !strings.Contains(all, want) {
+			t.Fatalf("expected %q among findings:\n%s", want, all)
+		}
+	}
+	if strings.Contains(all, `craftable "natural_twine"`) {
+		t.Fatalf("expected natural twine to be craftable in a temperate forest:\n%s", all)
+	}
+}
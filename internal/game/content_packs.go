@@ -0,0 +1,703 @@
+package game
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Discovery summary:
+//   - Every catalog (animals, plants, resources, trees, craftables, traps, shelters, food items) was a Go slice or map,
+//     so new content meant a code change; the built-in lists now sit behind builtin*Catalog and the public
+//     *Catalog functions add whatever content packs are active.
+//   - Packs are JSON or TOML files decoded straight into the spec structs; keys are the struct field names
+//     (matched case-insensitively) and unknown keys reject the file.
+//   - Entries are checked against the built-ins and every other loaded pack (IDs they point at, biome tags,
+//     nutrition, ranges); bad entries are dropped and reported, the rest of the pack still loads.
+//   - A pack entry with a built-in ID overrides it; new IDs are added. When two packs define the same ID the
+//     later one wins (directories in order, files by name) and the clash is reported.
+//   - Like SetExternalScenarios, the active set is package state: the client, headless runner and docsgen load
+//     packs once at start-up. Journals and saves record each active pack's ID and content hash, and loading or
+//     replaying under a different set warns but carries on with the packs at hand.
+
+// ContentKind names a catalog a pack can add to.
+type ContentKind string
+
+const (
+	ContentAnimal    ContentKind = "animal"
+	ContentPlant     ContentKind = "plant"
+	ContentResource  ContentKind = "resource"
+	ContentTree      ContentKind = "tree"
+	ContentCraftable ContentKind = "craftable"
+	ContentTrap      ContentKind = "trap"
+	ContentShelter   ContentKind = "shelter"
+	ContentFood      ContentKind = "food"
+)
+
+// ContentPack is one pack file.
+type ContentPack struct {
+	ID          string          `json:"id" toml:"id"`
+	Name        string          `json:"name" toml:"name"`
+	Version     string          `json:"version,omitempty" toml:"version"`
+	Description string          `json:"description,omitempty" toml:"description"`
+	Animals     []AnimalSpec    `json:"animals,omitempty" toml:"animals"`
+	Plants      []PlantSpec     `json:"plants,omitempty" toml:"plants"`
+	Resources   []ResourceSpec  `json:"resources,omitempty" toml:"resources"`
+	Trees       []TreeSpec      `json:"trees,omitempty" toml:"trees"`
+	Craftables  []CraftableSpec `json:"craftables,omitempty" toml:"craftables"`
+	Traps       []TrapSpec      `json:"traps,omitempty" toml:"traps"`
+	Shelters    []ShelterSpec   `json:"shelters,omitempty" toml:"shelters"`
+	Foods       []FoodItemSpec  `json:"foods,omitempty" toml:"foods"`
+
+	Path string `json:"-" toml:"-"`
+	Hash string `json:"-" toml:"-"`
+}
+
+// ContentPackRef identifies a pack by ID and the hash of its file, for recording which packs a run was played with.
+type ContentPackRef struct {
+	ID      string `json:"id"`
+	Version string `json:"version,omitempty"`
+	Hash    string `json:"hash"`
+}
+
+// EntryCount is how many catalog entries the pack carries.
+func (p ContentPack) EntryCount() int {
+	return len(p.Animals) + len(p.Plants) + len(p.Resources) + len(p.Trees) + len(p.Craftables) + len(p.Traps) + len(p.Shelters) + len(p.Foods)
+}
+
+// ContentProblemLevel says how serious a loading problem is.
+type ContentProblemLevel string
+
+const (
+	// ContentError: the file or entry was rejected.
+	ContentError ContentProblemLevel = "error"
+	// ContentWarning: the entry loaded but looks wrong.
+	ContentWarning ContentProblemLevel = "warning"
+	// ContentConflict: two packs define the same ID; the later one won.
+	ContentConflict ContentProblemLevel = "conflict"
+	// ContentOverride: a pack replaced a built-in entry.
+	ContentOverride ContentProblemLevel = "override"
+)
+
+// ContentProblem is one thing worth reporting about the loaded packs.
+type ContentProblem struct {
+	Level   ContentProblemLevel `json:"level"`
+	Path    string              `json:"path"`
+	PackID  string              `json:"pack_id,omitempty"`
+	Kind    ContentKind         `json:"kind,omitempty"`
+	EntryID string              `json:"entry_id,omitempty"`
+	Message string              `json:"message"`
+}
+
+func (p ContentProblem) String() string {
+	where := p.Path
+	if p.PackID != "" {
+		where = p.PackID
+	}
+	if p.Kind != "" {
+		where += fmt.Sprintf(" %s %q", p.Kind, p.EntryID)
+	}
+	return fmt.Sprintf("%s: %s: %s", p.Level, where, p.Message)
+}
+
+// ContentEntry records which pack supplied a catalog entry in the merged result.
+type ContentEntry struct {
+	Kind      ContentKind `json:"kind"`
+	ID        string      `json:"id"`
+	PackID    string      `json:"pack_id"`
+	Overrides bool        `json:"overrides,omitempty"`
+}
+
+// ContentPackSet is the result of loading packs: the packs (with bad entries dropped), what was reported, and the merged entries.
+type ContentPackSet struct {
+	Packs    []ContentPack
+	Problems []ContentProblem
+	Entries  []ContentEntry
+
+	animals    []AnimalSpec
+	plants     []PlantSpec
+	resources  []ResourceSpec
+	trees      []TreeSpec
+	craftables []CraftableSpec
+	traps      []TrapSpec
+	shelters   []ShelterSpec
+	foods      map[string]FoodItemSpec
+}
+
+// Errors reports whether any file or entry was rejected.
+func (s ContentPackSet) Errors() int {
+	n := 0
+	for _, p := range s.Problems {
+		if p.Level == ContentError {
+			n++
+		}
+	}
+	return n
+}
+
+var activeContent ContentPackSet
+
+// SetContentPacks makes the set's entries part of every catalog.
+func SetContentPacks(set ContentPackSet) {
+	activeContent = set
+}
+
+// ActiveContentPacks is the set passed to SetContentPacks.
+func ActiveContentPacks() ContentPackSet {
+	return activeContent
+}
+
+// ActiveContentPackRefs lists the active packs in load order, for journals and saves to record.
+func ActiveContentPackRefs() []ContentPackRef {
+	if len(activeContent.Packs) == 0 {
+		return nil
+	}
+	refs := make([]ContentPackRef, 0, len(activeContent.Packs))
+	for _, pack := range activeContent.Packs {
+		refs = append(refs, ContentPackRef{ID: pack.ID, Version: pack.Version, Hash: pack.Hash})
+	}
+	return refs
+}
+
+// ContentPackMismatch compares the packs a run was recorded with against the active ones and describes
+// every difference; nil means the same packs are loaded.
+func ContentPackMismatch(recorded []ContentPackRef) []string {
+	active := map[string]ContentPackRef{}
+	for _, ref := range ActiveContentPackRefs() {
+		active[ref.ID] = ref
+	}
+	var problems []string
+	seen := map[string]bool{}
+	for _, ref := range recorded {
+		seen[ref.ID] = true
+		now, ok := active[ref.ID]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("content pack %q was active when this run was recorded but isn't loaded", ref.ID))
+		case now.Hash != ref.Hash:
+			problems = append(problems, fmt.Sprintf("content pack %q has changed since this run was recorded", ref.ID))
+		}
+	}
+	for _, ref := range ActiveContentPackRefs() {
+		if !seen[ref.ID] {
+			problems = append(problems, fmt.Sprintf("content pack %q is loaded but wasn't active when this run was recorded", ref.ID))
+		}
+	}
+	return problems
+}
+
+// BuiltinContentPackDir holds the packs that ship with the game.
+var BuiltinContentPackDir = filepath.Join("assets", "packs")
+
+// ContentPackDirs is where packs are read from: the shipped packs, then the user's mods directory (when given).
+func ContentPackDirs(modsDir string) []string {
+	dirs := []string{BuiltinContentPackDir}
+	if strings.TrimSpace(modsDir) != "" {
+		dirs = append(dirs, modsDir)
+	}
+	return dirs
+}
+
+// LoadContentPacks reads every .json and .toml pack in dirs, in order, validates and merges them.
+// Missing directories are skipped; problems with individual files are reported in the set, not returned.
+func LoadContentPacks(dirs ...string) (ContentPackSet, error) {
+	var set ContentPackSet
+	seen := map[string]string{}
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return ContentPackSet{}, fmt.Errorf("read content packs: %w", err)
+		}
+		for _, entry := range entries {
+			ext := strings.ToLower(filepath.Ext(entry.Name()))
+			if entry.IsDir() || (ext != ".json" && ext != ".toml") {
+				continue
+			}
+			path := filepath.Join(dir, entry.Name())
+			pack, err := ReadContentPack(path)
+			if err != nil {
+				set.Problems = append(set.Problems, ContentProblem{Level: ContentError, Path: path, Message: err.Error()})
+				continue
+			}
+			if first, dup := seen[pack.ID]; dup {
+				set.Problems = append(set.Problems, ContentProblem{Level: ContentError, Path: path, PackID: pack.ID, Message: "pack id already loaded from " + first})
+				continue
+			}
+			seen[pack.ID] = path
+			set.Packs = append(set.Packs, pack)
+		}
+	}
+	set.validate()
+	set.merge()
+	return set, nil
+}
+
+// ReadContentPack decodes one pack file; unknown keys are an error so typos don't silently drop fields.
+func ReadContentPack(path string) (ContentPack, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ContentPack{}, err
+	}
+	var pack ContentPack
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".toml":
+		meta, err := toml.Decode(string(data), &pack)
+		if err != nil {
+			return ContentPack{}, fmt.Errorf("parse pack: %w", err)
+		}
+		if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+			keys := make([]string, 0, len(undecoded))
+			for _, key := range undecoded {
+				keys = append(keys, key.String())
+			}
+			return ContentPack{}, fmt.Errorf("unknown keys: %s", strings.Join(keys, ", "))
+		}
+	default:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&pack); err != nil {
+			return ContentPack{}, fmt.Errorf("parse pack: %w", err)
+		}
+	}
+	pack.Path = path
+	h := fnv.New64a()
+	_, _ = h.Write(data)
+	pack.Hash = fmt.Sprintf("%016x", h.Sum64())
+	if strings.TrimSpace(pack.ID) == "" {
+		pack.ID = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	if strings.TrimSpace(pack.Name) == "" {
+		pack.Name = pack.ID
+	}
+	return pack, nil
+}
+
+// contentIDs is every ID a pack entry may point at: built-ins plus everything in the loaded packs.
+type contentIDs struct {
+	resources  map[string]bool
+	craftables map[string]bool
+	kit        map[KitItem]bool
+	biomeTags  map[string]bool
+}
+
+func (s *ContentPackSet) knownIDs() contentIDs {
+	known := contentIDs{resources: map[string]bool{}, craftables: map[string]bool{}, kit: map[KitItem]bool{}, biomeTags: map[string]bool{}}
+	addTags := func(tags []string) {
+		for _, tag := range tags {
+			known.biomeTags[strings.ToLower(tag)] = true
+		}
+	}
+	for _, r := range builtinResourceCatalog() {
+		known.resources[r.ID] = true
+		addTags(r.BiomeTags)
+	}
+	for _, c := range builtinCraftableCatalog() {
+		known.craftables[c.ID] = true
+		addTags(c.BiomeTags)
+	}
+	for _, a := range builtinAnimalCatalog() {
+		addTags(a.BiomeTags)
+	}
+	for _, p := range builtinPlantCatalog() {
+		addTags(p.BiomeTags)
+	}
+	for _, t := range builtinTreeCatalog() {
+		addTags(t.BiomeTags)
+	}
+	for _, item := range AllKitItems() {
+		known.kit[item] = true
+	}
+	for _, pack := range s.Packs {
+		for _, r := range pack.Resources {
+			known.resources[r.ID] = true
+		}
+		for _, c := range pack.Craftables {
+			known.craftables[c.ID] = true
+		}
+	}
+	return known
+}
+
+// entryCheck collects what is wrong with one entry.
+type entryCheck struct {
+	errs  []string
+	warns []string
+}
+
+func (c *entryCheck) fail(format string, args ...any) {
+	c.errs = append(c.errs, fmt.Sprintf(format, args...))
+}
+
+func (c *entryCheck) warn(format string, args ...any) {
+	c.warns = append(c.warns, fmt.Sprintf(format, args...))
+}
+
+func (c *entryCheck) named(id, name string) {
+	if strings.TrimSpace(id) == "" {
+		c.fail("missing ID")
+	}
+	if strings.TrimSpace(name) == "" {
+		c.fail("missing Name")
+	}
+}
+
+func (c *entryCheck) biomes(tags []string, known contentIDs) {
+	if len(tags) == 0 {
+		c.fail("missing BiomeTags")
+		return
+	}
+	for _, tag := range tags {
+		if !known.biomeTags[strings.ToLower(tag)] {
+			c.warn("biome tag %q is not used by any built-in content and may never match a scenario", tag)
+		}
+	}
+}
+
+func (c *entryCheck) nutrition(n NutritionPer100g) {
+	if n.CaloriesKcal < 0 || n.ProteinG < 0 || n.FatG < 0 || n.SugarG < 0 {
+		c.fail("nutrition values cannot be negative")
+		return
+	}
+	if n.ProteinG+n.FatG+n.SugarG > 100 {
+		c.fail("nutrition has more than 100g of protein, fat and sugar per 100g")
+	}
+	if n.CaloriesKcal > 900 {
+		c.fail("nutrition has more than 900 kcal per 100g")
+	}
+	if implied := 4*n.ProteinG + 9*n.FatG + 4*n.SugarG; float64(implied) > float64(n.CaloriesKcal)*1.5+20 {
+		c.fail("nutrition lists %d kcal but its protein, fat and sugar come to %d kcal", n.CaloriesKcal, implied)
+	}
+}
+
+func (c *entryCheck) rangeOf(what string, lo, hi float64) {
+	if lo <= 0 || hi < lo {
+		c.fail("%s range %.3g-%.3g must be positive with min <= max", what, lo, hi)
+	}
+}
+
+func (c *entryCheck) chance(what string, v float64) {
+	if v < 0 || v > 1 {
+		c.fail("%s %.3g must be between 0 and 1", what, v)
+	}
+}
+
+func (c *entryCheck) resources(reqs []ResourceRequirement, known contentIDs) {
+	for _, req := range reqs {
+		if !known.resources[req.ID] {
+			c.fail("unknown resource %q", req.ID)
+		}
+		if req.Qty <= 0 {
+			c.fail("resource %q needs a positive Qty", req.ID)
+		}
+	}
+}
+
+func (c *entryCheck) craftables(ids []string, known contentIDs) {
+	for _, id := range ids {
+		if !known.craftables[id] {
+			c.fail("unknown craftable %q", id)
+		}
+	}
+}
+
+func checkAnimal(a AnimalSpec, known contentIDs) entryCheck {
+	var c entryCheck
+	c.named(a.ID, a.Name)
+	c.biomes(a.BiomeTags, known)
+	if !slices.Contains([]AnimalDomain{AnimalDomainLand, AnimalDomainWater, AnimalDomainAir}, a.Domain) {
+		c.fail("unknown Domain %q (land, water or air)", a.Domain)
+	}
+	c.rangeOf("WeightKg", a.WeightMinKg, a.WeightMaxKg)
+	if a.EdibleYieldRatio <= 0 || a.EdibleYieldRatio > 1 {
+		c.fail("EdibleYieldRatio %.3g must be above 0 and at most 1", a.EdibleYieldRatio)
+	}
+	c.nutrition(a.NutritionPer100g)
+	for _, risk := range a.DiseaseRisks {
+		c.chance("disease BaseChance", risk.BaseChance)
+		c.chance("disease VomitChance", risk.VomitChance)
+	}
+	return c
+}
+
+func checkPlant(p PlantSpec, known contentIDs) entryCheck {
+	var c entryCheck
+	c.named(p.ID, p.Name)
+	c.biomes(p.BiomeTags, known)
+	categories := []PlantCategory{PlantCategoryRoots, PlantCategoryBerries, PlantCategoryFruits, PlantCategoryVegetable, PlantCategoryNutsSeeds, PlantCategoryMedicinal, PlantCategoryToxic, PlantCategoryUtility}
+	if !slices.Contains(categories, p.Category) {
+		c.fail("unknown Category %q", p.Category)
+	}
+	for _, season := range p.SeasonTags {
+		if !slices.Contains([]SeasonID{SeasonAutumn, SeasonWinter, SeasonWet, SeasonDry}, season) {
+			c.fail("unknown season %q", season)
+		}
+	}
+	c.rangeOf("YieldG", float64(p.YieldMinG), float64(p.YieldMaxG))
+	c.nutrition(p.NutritionPer100g)
+	if p.Toxicity < 0 || p.Medicinal < 0 {
+		c.fail("Toxicity and Medicinal cannot be negative")
+	}
+	return c
+}
+
+func checkResource(r ResourceSpec, known contentIDs) entryCheck {
+	var c entryCheck
+	c.named(r.ID, r.Name)
+	c.biomes(r.BiomeTags, known)
+	if strings.TrimSpace(r.Unit) == "" {
+		c.fail("missing Unit")
+	}
+	c.rangeOf("Gather", r.GatherMin, r.GatherMax)
+	c.chance("Dryness", r.Dryness)
+	return c
+}
+
+func checkTree(t TreeSpec, known contentIDs) entryCheck {
+	var c entryCheck
+	c.named(t.ID, t.Name)
+	c.biomes(t.BiomeTags, known)
+	if !slices.Contains([]WoodType{WoodTypeHardwood, WoodTypeSoftwood, WoodTypeResinous, WoodTypeBamboo, WoodTypeDriftwood}, t.WoodType) {
+		c.fail("unknown WoodType %q", t.WoodType)
+	}
+	c.rangeOf("GatherKg", t.GatherMinKg, t.GatherMaxKg)
+	if t.BarkResource != "" && !known.resources[t.BarkResource] {
+		c.fail("unknown resource %q", t.BarkResource)
+	}
+	return c
+}
+
+func checkCraftable(cr CraftableSpec, known contentIDs) entryCheck {
+	var c entryCheck
+	c.named(cr.ID, cr.Name)
+	c.biomes(cr.BiomeTags, known)
+	c.craftables(cr.RequiresItems, known)
+	c.resources(cr.RequiresResources, known)
+	if cr.WoodKg < 0 || cr.WeightKg < 0 || cr.BaseHours < 0 {
+		c.fail("WoodKg, WeightKg and BaseHours cannot be negative")
+	}
+	return c
+}
+
+func checkTrap(t TrapSpec, known contentIDs) entryCheck {
+	var c entryCheck
+	c.named(t.ID, t.Name)
+	c.biomes(t.BiomeTags, known)
+	if len(t.Targets) == 0 {
+		c.fail("missing Targets")
+	}
+	if t.BaseChance <= 0 || t.BaseChance > 1 {
+		c.fail("BaseChance %.3g must be above 0 and at most 1", t.BaseChance)
+	}
+	c.rangeOf("YieldKg", t.YieldMinKg, t.YieldMaxKg)
+	c.craftables(t.RequiresCrafted, known)
+	c.resources(t.RequiresResources, known)
+	for _, item := range t.RequiresKit {
+		if !known.kit[item] {
+			c.fail("unknown kit item %q", item)
+		}
+	}
+	return c
+}
+
+func checkShelter(s ShelterSpec, known contentIDs) entryCheck {
+	var c entryCheck
+	c.named(string(s.ID), s.Name)
+	c.biomes(s.BiomeTags, known)
+	c.craftables(s.UpgradeComponents, known)
+	for _, stage := range s.Stages {
+		c.craftables(stage.RequiresItems, known)
+		c.resources(stage.RequiresResources, known)
+	}
+	if s.StorageCapacityKg < 0 {
+		c.fail("StorageCapacityKg cannot be negative")
+	}
+	return c
+}
+
+func checkFood(f FoodItemSpec, _ contentIDs) entryCheck {
+	var c entryCheck
+	c.named(f.ID, f.Name)
+	if strings.TrimSpace(f.Category) == "" {
+		c.fail("missing Category")
+	}
+	if f.ShelfLifeDays <= 0 {
+		c.fail("ShelfLifeDays must be positive")
+	}
+	c.chance("DecayPerDay", f.DecayPerDay)
+	c.chance("IllnessRisk", f.IllnessRisk)
+	c.nutrition(f.NutritionPer100)
+	return c
+}
+
+// keepValid drops entries that fail their check and reports every problem found.
+func keepValid[T any](set *ContentPackSet, pack ContentPack, kind ContentKind, entries []T, id func(T) string, check func(T, contentIDs) entryCheck, known contentIDs) []T {
+	kept := entries[:0:0]
+	for _, entry := range entries {
+		result := check(entry, known)
+		for _, msg := range result.errs {
+			set.Problems = append(set.Problems, ContentProblem{Level: ContentError, Path: pack.Path, PackID: pack.ID, Kind: kind, EntryID: id(entry), Message: msg + "; entry skipped"})
+		}
+		for _, msg := range result.warns {
+			set.Problems = append(set.Problems, ContentProblem{Level: ContentWarning, Path: pack.Path, PackID: pack.ID, Kind: kind, EntryID: id(entry), Message: msg})
+		}
+		if len(result.errs) == 0 {
+			kept = append(kept, entry)
+		}
+	}
+	return kept
+}
+
+func (s *ContentPackSet) validate() {
+	known := s.knownIDs()
+	for i := range s.Packs {
+		p := &s.Packs[i]
+		p.Animals = keepValid(s, *p, ContentAnimal, p.Animals, func(a AnimalSpec) string { return a.ID }, checkAnimal, known)
+		p.Plants = keepValid(s, *p, ContentPlant, p.Plants, func(v PlantSpec) string { return v.ID }, checkPlant, known)
+		p.Resources = keepValid(s, *p, ContentResource, p.Resources, func(v ResourceSpec) string { return v.ID }, checkResource, known)
+		p.Trees = keepValid(s, *p, ContentTree, p.Trees, func(v TreeSpec) string { return v.ID }, checkTree, known)
+		p.Craftables = keepValid(s, *p, ContentCraftable, p.Craftables, func(v CraftableSpec) string { return v.ID }, checkCraftable, known)
+		p.Traps = keepValid(s, *p, ContentTrap, p.Traps, func(v TrapSpec) string { return v.ID }, checkTrap, known)
+		p.Shelters = keepValid(s, *p, ContentShelter, p.Shelters, func(v ShelterSpec) string { return string(v.ID) }, checkShelter, known)
+		p.Foods = keepValid(s, *p, ContentFood, p.Foods, func(v FoodItemSpec) string { return v.ID }, checkFood, known)
+	}
+}
+
+// mergeKind folds one kind of entry from every pack in order: the last definition of an ID wins,
+// clashes between packs are reported as conflicts and replaced built-ins as overrides.
+func mergeKind[T any](s *ContentPackSet, kind ContentKind, builtin []T, entries func(ContentPack) []T, id func(T) string) []T {
+	builtinIDs := map[string]bool{}
+	for _, entry := range builtin {
+		builtinIDs[id(entry)] = true
+	}
+	var merged []T
+	slot := map[string]int{}
+	recorded := map[string]int{}
+	for _, pack := range s.Packs {
+		for _, entry := range entries(pack) {
+			entryID := id(entry)
+			if at, dup := slot[entryID]; dup {
+				prev := &s.Entries[recorded[entryID]]
+				s.Problems = append(s.Problems, ContentProblem{Level: ContentConflict, Path: pack.Path, PackID: pack.ID, Kind: kind, EntryID: entryID,
+					Message: fmt.Sprintf("also defined by pack %s; %s wins", prev.PackID, pack.ID)})
+				prev.PackID = pack.ID
+				merged[at] = entry
+				continue
+			}
+			if builtinIDs[entryID] {
+				s.Problems = append(s.Problems, ContentProblem{Level: ContentOverride, Path: pack.Path, PackID: pack.ID, Kind: kind, EntryID: entryID, Message: "replaces the built-in entry"})
+			}
+			slot[entryID] = len(merged)
+			recorded[entryID] = len(s.Entries)
+			s.Entries = append(s.Entries, ContentEntry{Kind: kind, ID: entryID, PackID: pack.ID, Overrides: builtinIDs[entryID]})
+			merged = append(merged, entry)
+		}
+	}
+	return merged
+}
+
+func (s *ContentPackSet) merge() {
+	s.animals = mergeKind(s, ContentAnimal, builtinAnimalCatalog(), func(p ContentPack) []AnimalSpec { return p.Animals }, func(v AnimalSpec) string { return v.ID })
+	s.plants = mergeKind(s, ContentPlant, builtinPlantCatalog(), func(p ContentPack) []PlantSpec { return p.Plants }, func(v PlantSpec) string { return v.ID })
+	s.resources = mergeKind(s, ContentResource, builtinResourceCatalog(), func(p ContentPack) []ResourceSpec { return p.Resources }, func(v ResourceSpec) string { return v.ID })
+	s.trees = mergeKind(s, ContentTree, builtinTreeCatalog(), func(p ContentPack) []TreeSpec { return p.Trees }, func(v TreeSpec) string { return v.ID })
+	s.craftables = mergeKind(s, ContentCraftable, builtinCraftableCatalog(), func(p ContentPack) []CraftableSpec { return p.Craftables }, func(v CraftableSpec) string { return v.ID })
+	s.traps = mergeKind(s, ContentTrap, builtinTrapCatalog(), func(p ContentPack) []TrapSpec { return p.Traps }, func(v TrapSpec) string { return v.ID })
+	s.shelters = mergeKind(s, ContentShelter, builtinShelterCatalog(), func(p ContentPack) []ShelterSpec { return p.Shelters }, func(v ShelterSpec) string { return string(v.ID) })
+	foods := mergeKind(s, ContentFood, builtinFoodItems(), func(p ContentPack) []FoodItemSpec { return p.Foods }, func(v FoodItemSpec) string { return v.ID })
+	if len(foods) > 0 {
+		s.foods = map[string]FoodItemSpec{}
+		for _, f := range foods {
+			s.foods[f.ID] = f
+		}
+	}
+}
+
+// withPackEntries replaces built-ins that share an ID with a pack entry and appends the rest.
+func withPackEntries[T any](base []T, extra []T, id func(T) string) []T {
+	if len(extra) == 0 {
+		return base
+	}
+	index := make(map[string]int, len(base))
+	for i, entry := range base {
+		index[id(entry)] = i
+	}
+	for _, entry := range extra {
+		if at, ok := index[id(entry)]; ok {
+			base[at] = entry
+			continue
+		}
+		base = append(base, entry)
+	}
+	return base
+}
+
+func AnimalCatalog() []AnimalSpec {
+	return withPackEntries(builtinAnimalCatalog(), activeContent.animals, func(v AnimalSpec) string { return v.ID })
+}
+
+func PlantCatalog() []PlantSpec {
+	return withPackEntries(builtinPlantCatalog(), activeContent.plants, func(v PlantSpec) string { return v.ID })
+}
+
+func ResourceCatalog() []ResourceSpec {
+	return withPackEntries(builtinResourceCatalog(), activeContent.resources, func(v ResourceSpec) string { return v.ID })
+}
+
+func TreeCatalog() []TreeSpec {
+	return withPackEntries(builtinTreeCatalog(), activeContent.trees, func(v TreeSpec) string { return v.ID })
+}
+
+func CraftableCatalog() []CraftableSpec {
+	return withPackEntries(builtinCraftableCatalog(), activeContent.craftables, func(v CraftableSpec) string { return v.ID })
+}
+
+func TrapCatalog() []TrapSpec {
+	return withPackEntries(builtinTrapCatalog(), activeContent.traps, func(v TrapSpec) string { return v.ID })
+}
+
+func ShelterCatalog() []ShelterSpec {
+	return withPackEntries(builtinShelterCatalog(), activeContent.shelters, func(v ShelterSpec) string { return string(v.ID) })
+}
+
+// FoodItemCatalog lists every food item by ID, pack entries included.
+func FoodItemCatalog() []FoodItemSpec {
+	items := make([]FoodItemSpec, 0, len(foodItemCatalog)+len(activeContent.foods))
+	for id, spec := range foodItemCatalog {
+		if _, replaced := activeContent.foods[id]; !replaced {
+			items = append(items, spec)
+		}
+	}
+	for _, spec := range activeContent.foods {
+		items = append(items, spec)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+	return items
+}
+
+func builtinFoodItems() []FoodItemSpec {
+	items := make([]FoodItemSpec, 0, len(foodItemCatalog))
+	for _, spec := range foodItemCatalog {
+		items = append(items, spec)
+	}
+	return items
+}
+
+// lookupFoodItem finds a food item, preferring a pack's definition.
+func lookupFoodItem(id string) (FoodItemSpec, bool) {
+	if spec, ok := activeContent.foods[id]; ok {
+		return spec, true
+	}
+	spec, ok := foodItemCatalog[id]
+	return spec, ok
+}
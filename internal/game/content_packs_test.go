@@ -0,0 +1,162 @@
+package game
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writePack(t *testing.T, dir, name, body string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(body), 0o600); err != nil {
+		t.Fatalf("write pack: %v", err)
+	}
+}
+
+func TestContentPacksMergeOverrideAndReportConflicts(t *testing.T) {
+	shipped, mods := t.TempDir(), t.TempDir()
+	writePack(t, shipped, "coast.toml", `
+id = "coast"
+name = "Coastal Additions"
+
+[[animals]]
+ID = "harbour_seal"
+Name = "Harbour Seal"
+Domain = "water"
+BiomeTags = ["coast", "island"]
+WeightMinKg = 50
+WeightMaxKg = 120
+EdibleYieldRatio = 0.5
+NutritionPer100g = { CaloriesKcal = 200, ProteinG = 28, FatG = 9 }
+
+[[plants]]
+ID = "blueberry"
+Name = "Big Blueberry"
+Category = "berries"
+BiomeTags = ["forest"]
+YieldMinG = 200
+YieldMaxG = 900
+NutritionPer100g = { CaloriesKcal = 57, ProteinG = 1, SugarG = 10 }
+`)
+	writePack(t, mods, "seals.json", `{"id": "seals", "animals": [
+		{"ID": "harbour_seal", "Name": "Fat Seal", "Domain": "water", "BiomeTags": ["coast"], "WeightMinKg": 60, "WeightMaxKg": 140,
+		 "EdibleYieldRatio": 0.55, "NutritionPer100g": {"CaloriesKcal": 260, "ProteinG": 25, "FatG": 16}}
+	], "foods": [
+		{"ID": "seal_blubber", "Name": "Seal Blubber", "Category": "fat", "Perishable": true, "ShelfLifeDays": 3, "DecayPerDay": 0.3,
+		 "NutritionPer100": {"CaloriesKcal": 760, "ProteinG": 2, "FatG": 82}, "IllnessRisk": 0.05}
+	]}`)
+
+	set, err := LoadContentPacks(shipped, mods, filepath.Join(mods, "missing"))
+	if err != nil {
+		t.Fatalf("load packs: %v", err)
+	}
+	if len(set.Packs) != 2 || set.Errors() != 0 {
+		t.Fatalf("expected two clean packs, got %d packs and %v", len(set.Packs), set.Problems)
+	}
+	var levels []string
+	for _, p := range set.Problems {
+		levels = append(levels, string(p.Level)+":"+p.EntryID)
+	}
+	if got := strings.Join(levels, " "); !strings.Contains(got, "override:blueberry") || !strings.Contains(got, "conflict:harbour_seal") {
+		t.Fatalf("expected an override and a conflict, got %s", got)
+	}
+
+	SetContentPacks(set)
+	t.Cleanup(func() { SetContentPacks(ContentPackSet{}) })
+	seals, blueberries := 0, 0
+	for _, a := range AnimalCatalog() {
+		if a.ID == "harbour_seal" {
+			seals++
+			if a.Name != "Fat Seal" {
+				t.Fatalf("expected the later pack to win, got %q", a.Name)
+			}
+		}
+	}
+	for _, p := range PlantCatalog() {
+		if p.ID == "blueberry" {
+			blueberries++
+			if p.YieldMaxG != 900 {
+				t.Fatalf("expected the pack to override blueberry, got %+v", p)
+			}
+		}
+	}
+	if seals != 1 || blueberries != 1 {
+		t.Fatalf("expected one entry per ID, got %d seals and %d blueberries", seals, blueberries)
+	}
+	if spec, ok := lookupFoodItem("seal_blubber"); !ok || spec.NutritionPer100.FatG != 82 {
+		t.Fatalf("expected the pack food item to be found")
+	}
+}
+
+func TestContentPackValidationDropsBadEntries(t *testing.T) {
+	dir := t.TempDir()
+	writePack(t, dir, "bad.json", `{"id": "bad",
+		"animals": [{"ID": "ghost_deer", "Name": "Ghost Deer", "Domain": "land", "WeightMinKg": 40, "WeightMaxKg": 90, "EdibleYieldRatio": 0.4,
+			"NutritionPer100g": {"CaloriesKcal": 150, "ProteinG": 30}}],
+		"plants": [{"ID": "lard_berry", "Name": "Lard Berry", "Category": "berries", "BiomeTags": ["forest"], "YieldMinG": 10, "YieldMaxG": 50,
+			"NutritionPer100g": {"CaloriesKcal": 40, "FatG": 60}}],
+		"traps": [{"ID": "magic_snare", "Name": "Magic Snare", "BiomeTags": ["forest", "moonbase"], "Targets": ["small_game"], "BaseChance": 0.3,
+			"YieldMinKg": 0.2, "YieldMaxKg": 1, "RequiresCrafted": ["unobtainium_wire"]}],
+		"resources": [{"ID": "moss", "Name": "Moss", "BiomeTags": ["forest"], "Unit": "bundle", "GatherMin": 1, "GatherMax": 3, "Dryness": 0.3}]
+	}`)
+	writePack(t, dir, "typo.toml", "id = \"typo\"\n[[animals]]\nID = \"x\"\nWieght = 3\n")
+
+	set, err := LoadContentPacks(dir)
+	if err != nil {
+		t.Fatalf("load packs: %v", err)
+	}
+	var messages []string
+	for _, p := range set.Problems {
+		messages = append(messages, p.String())
+	}
+	all := strings.Join(messages, "\n")
+	for _, want := range []string{`"ghost_deer": missing BiomeTags`, `"lard_berry": nutrition lists 40 kcal`, `unknown craftable "unobtainium_wire"`, `biome tag "moonbase"`, "unknown keys"} {
+		if !strings.Contains(all, want) {
+			t.Fatalf("expected %q among problems:\n%s", want, all)
+		}
+	}
+	if len(set.Packs) != 1 || set.Packs[0].EntryCount() != 1 || set.Packs[0].Resources[0].ID != "moss" {
+		t.Fatalf("expected only the valid resource to survive, got %+v", set.Packs)
+	}
+}
+
+func TestJournalsRecordTheActivePacksAndFlagChanges(t *testing.T) {
+	dir := t.TempDir()
+	writePack(t, dir, "moss.json", `{"id": "moss", "resources": [{"ID": "moss", "Name": "Moss", "BiomeTags": ["forest"], "Unit": "bundle", "GatherMin": 1, "GatherMax": 3, "Dryness": 0.3}]}`)
+	set, err := LoadContentPacks(dir)
+	if err != nil {
+		t.Fatalf("load packs: %v", err)
+	}
+	SetContentPacks(set)
+	t.Cleanup(func() { SetContentPacks(ContentPackSet{}) })
+
+	run := newRunForCommands(t)
+	journal := NewRunJournal(&run)
+	if len(journal.ContentPacks) != 1 || journal.ContentPacks[0].ID != "moss" || journal.ContentPacks[0].Hash == "" {
+		t.Fatalf("expected the journal to record the moss pack, got %+v", journal.ContentPacks)
+	}
+	if problems := ContentPackMismatch(journal.ContentPacks); len(problems) != 0 {
+		t.Fatalf("expected no warnings with the same packs, got %v", problems)
+	}
+
+	writePack(t, dir, "moss.json", `{"id": "moss", "resources": [{"ID": "moss", "Name": "Moss", "BiomeTags": ["forest"], "Unit": "bundle", "GatherMin": 2, "GatherMax": 4, "Dryness": 0.3}]}`)
+	changed, _ := LoadContentPacks(dir)
+	SetContentPacks(changed)
+	if problems := ContentPackMismatch(journal.ContentPacks); len(problems) != 1 || !strings.Contains(problems[0], "has changed") {
+		t.Fatalf("expected an edited pack to be flagged, got %v", problems)
+	}
+	SetContentPacks(ContentPackSet{})
+	if problems := ContentPackMismatch(journal.ContentPacks); len(problems) != 1 || !strings.Contains(problems[0], "isn't loaded") {
+		t.Fatalf("expected a missing pack to be flagged, got %v", problems)
+	}
+}
+
+func TestContentPackDirsReadShippedPacksBeforeMods(t *testing.T) {
+	if dirs := ContentPackDirs(""); len(dirs) != 1 || dirs[0] != BuiltinContentPackDir {
+		t.Fatalf("expected only the shipped packs without a mods dir, got %v", dirs)
+	}
+	if dirs := ContentPackDirs("mods"); len(dirs) != 2 || dirs[0] != BuiltinContentPackDir || dirs[1] != "mods" {
+		t.Fatalf("expected the shipped packs ahead of the mods dir, got %v", dirs)
+	}
+}
@@ -1,10 +1,18 @@
 package game
 
 import (
+	"fmt"
+	"math"
 	"math/rand"
+	"sort"
+	"strings"
 	"time"
 )
 
+// Discovery summary:
+// - Alone rivals carry a hidden PlayerState, so metabolism, weather, ailments and critical hours decide their fate.
+// - Each rival keeps a camp on a land cell of WorldTopology; shelter, fire and traps live there, not on RunState.
+// - Every roll comes from the seeded RNG handed in by ProcessContestantSimulation, so journals replay exits exactly.
 type ContestantStatus string
 
 const (
@@ -12,6 +20,14 @@ const (
 	ContestantTappedOut          ContestantStatus = "tapped_out"
 	ContestantMedicallyExtracted ContestantStatus = "medically_extracted"
 	ContestantDeceased           ContestantStatus = "deceased"
+	ContestantRescued            ContestantStatus = "rescued"
+)
+
+const (
+	contestantStartHour       = 7
+	contestantMaxTraps        = 3
+	contestantIsolationMorale = 3
+	contestantMealGrams       = 350
 )
 
 type ContestantState struct {
@@ -25,6 +41,25 @@ type ContestantState struct {
 	Morale        int              `json:"morale"`
 	Health        int              `json:"health"`
 	RiskTolerance int              `json:"risk_tolerance"` // 1-10, affects how low stats go before tap out
+	ExitDay       int              `json:"exit_day,omitempty"`
+	Cause         string           `json:"cause,omitempty"`
+
+	// Body and Camp are the hidden simulation; only the producer's report is shown to the player.
+	Body PlayerState    `json:"body"`
+	Camp ContestantCamp `json:"camp"`
+}
+
+// ContestantCamp is a rival's site on the world map and what they have built there.
+type ContestantCamp struct {
+	X                 int         `json:"x"`
+	Y                 int         `json:"y"`
+	WaterSource       string      `json:"water_source,omitempty"`
+	Shelter           ShelterType `json:"shelter,omitempty"`
+	ShelterHours      float64     `json:"shelter_hours,omitempty"`
+	ShelterDurability int         `json:"shelter_durability,omitempty"`
+	FireHours         float64     `json:"fire_hours,omitempty"`
+	Traps             int         `json:"traps,omitempty"`
+	TrapsCheckedDay   int         `json:"traps_checked_day,omitempty"`
 }
 
 func initialContestants(count int, seed int64) []ContestantState {
@@ -48,107 +83,553 @@ func initialContestants(count int, seed int64) []ContestantState {
 			RiskTolerance: 3 + r.Intn(6), // 3 to 8
 		}
 	}
+	for i := range out {
+		out[i].Body = newContestantBody(out[i], seed)
+	}
 	return out
 }
 
-func (s *ContestantState) processMacroTick(delta time.Duration, weather WeatherState, r *rand.Rand) (bool, string) {
-	if s.Status != ContestantActive {
-		return false, ""
+// newContestantBody builds the hidden survivor behind a rival. Alone casts experienced people, so skills start mid-range.
+func newContestantBody(c ContestantState, seed int64) PlayerState {
+	r := rand.New(rand.NewSource(seedFromLabel(seed, fmt.Sprintf("contestant-body:%d", c.ID))))
+	skill := func() int { return 25 + r.Intn(41) }
+	trait := func() int { return r.Intn(4) - 1 }
+	cfg := PlayerConfig{
+		Name:           c.Name,
+		Endurance:      trait(),
+		Bushcraft:      trait(),
+		Mental:         trait(),
+		MentalStrength: trait(),
+		Hunting:        skill(),
+		Fishing:        skill(),
+		Foraging:       skill(),
+		Trapping:       skill(),
+		Firecraft:      skill(),
+		Sheltercraft:   skill(),
+		Gathering:      skill(),
+	}
+	body := CreatePlayers(RunConfig{PlayerCount: 1, Seed: seed, Players: []PlayerConfig{cfg}})[0]
+	body.ID = c.ID
+	initializeRuntimeBars(&body)
+	// Most rivals bulk up before the drop-off.
+	body.CaloriesReserveKcal += 3000 + r.Intn(4000)
+	body.FatReserveG += 150 + r.Intn(200)
+	refreshEffectBars(&body)
+	return body
+}
+
+// ensureContestants backfills hidden bodies for saves made before rivals were simulated and sites camps on the map.
+func (s *RunState) ensureContestants() {
+	for i := range s.Contestants {
+		c := &s.Contestants[i]
+		if c.Body.ID == 0 {
+			c.Body = newContestantBody(*c, s.Config.Seed)
+			c.Body.Energy, c.Body.Hydration, c.Body.Morale = c.Energy, c.Hydration, c.Morale
+			refreshEffectBars(&c.Body)
+			c.Camp = ContestantCamp{}
+		}
+	}
+	s.placeContestantCamps()
+}
+
+// placeContestantCamps picks a land cell near water for each rival, spaced away from the players and each other.
+func (s *RunState) placeContestantCamps() {
+	if s.Topology.Width <= 0 || s.Topology.Height <= 0 {
+		return
+	}
+	pending := make([]*ContestantState, 0, len(s.Contestants))
+	taken := make([][2]int, 0, len(s.Players)+len(s.Contestants))
+	for _, player := range s.Players {
+		taken = append(taken, [2]int{player.Travel.PosX, player.Travel.PosY})
+	}
+	for i := range s.Contestants {
+		c := &s.Contestants[i]
+		if c.Camp.WaterSource == "" {
+			pending = append(pending, c)
+			continue
+		}
+		taken = append(taken, [2]int{c.Camp.X, c.Camp.Y})
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	candidates := make([][2]int, 0, 64)
+	for y := 0; y < s.Topology.Height; y++ {
+		for x := 0; x < s.Topology.Width; x++ {
+			cell, _ := s.TopologyCellAt(x, y)
+			if cell.Flags&TopoFlagWater == 0 && s.isNearWater(x, y) {
+				candidates = append(candidates, [2]int{x, y})
+			}
+		}
 	}
+	r := rand.New(rand.NewSource(seedFromLabel(s.Config.Seed, "contestant-camps")))
+	r.Shuffle(len(candidates), func(i, j int) { candidates[i], candidates[j] = candidates[j], candidates[i] })
 
-	s.TimeSurvived += delta
-	s.DaysSurvived = int(s.TimeSurvived.Hours() / 24)
+	spacing := maxInt(2, min(s.Topology.Width, s.Topology.Height)/5)
+	for _, c := range pending {
+		x, y := s.CurrentMapPosition()
+		site, found := [2]int{x, y}, false
+		for gap := spacing; gap >= 0 && !found; gap-- {
+			for _, candidate := range candidates {
+				if campSpacingOK(candidate, taken, gap) {
+					site, found = candidate, true
+					break
+				}
+			}
+		}
+		c.Camp.X, c.Camp.Y = site[0], site[1]
+		c.Camp.WaterSource = s.campWaterSource(site[0], site[1])
+		c.Camp.Shelter = contestantShelterChoice(s.Scenario.Biome, r)
+		taken = append(taken, site)
+	}
+}
 
-	// Convert delta to hours for decay
-	hours := delta.Hours()
-	if hours <= 0 {
-		return false, ""
+func campSpacingOK(site [2]int, taken [][2]int, gap int) bool {
+	for _, other := range taken {
+		if absInt(site[0]-other[0]) <= gap && absInt(site[1]-other[1]) <= gap {
+			return false
+		}
 	}
+	return true
+}
+
+func (s *RunState) campWaterSource(x, y int) string {
+	for oy := -1; oy <= 1; oy++ {
+		for ox := -1; ox <= 1; ox++ {
+			if cell, ok := s.TopologyCellAt(x+ox, y+oy); ok {
+				if source, ok := waterSourceForCell(cell); ok {
+					return source
+				}
+			}
+		}
+	}
+	return waterSourceStanding
+}
 
-	// Base decay per hour
-	energyDecay := 1.5 * hours
-	hydrationDecay := 2.0 * hours
-	moraleDecay := 1.0 * hours
+func contestantShelterChoice(biome string, r *rand.Rand) ShelterType {
+	options := make([]ShelterSpec, 0, 4)
+	for _, spec := range SheltersForBiome(biome) {
+		if spec.SleepShelter {
+			options = append(options, spec)
+		}
+	}
+	if len(options) == 0 {
+		return ShelterLeanTo
+	}
+	return options[r.Intn(len(options))].ID
+}
+
+func shelterBuildHours(spec ShelterSpec) float64 {
+	hours := 0.0
+	for _, stage := range spec.Stages {
+		hours += stage.BuildHours
+	}
+	return math.Max(hours, 2)
+}
+
+func (c *ContestantState) hourOfDay() int {
+	return (int(c.TimeSurvived/time.Hour) + contestantStartHour) % 24
+}
+
+func (c *ContestantState) day() int {
+	return int(c.TimeSurvived/time.Hour+contestantStartHour)/24 + 1
+}
+
+func (c *ContestantState) sheltered() bool {
+	return c.Camp.ShelterDurability > 0
+}
+
+func (c *ContestantState) fireLit() bool {
+	return c.Camp.FireHours > 0
+}
+
+// simulateContestantHour steps one rival through an hour of the same rules players live under.
+// It returns an announcement when the rival leaves the show.
+func (s *RunState) simulateContestantHour(c *ContestantState, r *rand.Rand) string {
+	if c.Status != ContestantActive {
+		return ""
+	}
+	body := &c.Body
+	applyMetabolismFraction(body, 1.0/24)
+	applyPhysiologyFraction(body, 1.0/24)
+
+	hour := c.hourOfDay()
+	switch {
+	case hour >= 22 || hour < 6:
+		s.contestantSleepHour(c)
+		if msg := s.contestantNightRisk(c, r); msg != "" {
+			return msg
+		}
+	default:
+		s.contestantActHour(c, r)
+	}
+	c.Camp.FireHours = math.Max(0, c.Camp.FireHours-1)
+
+	c.TimeSurvived += time.Hour
+	c.DaysSurvived = int(c.TimeSurvived.Hours() / 24)
+	if c.hourOfDay() == 0 {
+		s.contestantEndOfDay(c)
+	}
+	clampPlayer(body)
+	refreshEffectBars(body)
+	c.syncFromBody()
+
+	if msg := s.contestantCriticalCheck(c, r); msg != "" {
+		return msg
+	}
+	if hour == 20 {
+		return s.contestantTapOutCheck(c, r)
+	}
+	return ""
+}
+
+// contestantActHour picks the most pressing job for a waking hour: water, shelter, fire, food, then rest.
+func (s *RunState) contestantActHour(c *ContestantState, r *rand.Rand) {
+	body := &c.Body
+	hour := c.hourOfDay()
+	evening := hour >= 17
+	cold := s.Weather.TemperatureC <= 12
+	hungry := body.Hunger >= 45
+	// Plants keep sugar up; fish and traps cover protein and fat.
+	needsPlants := body.SugarReserveG < DailyNutritionNeedsForPlayer(*body).SugarG/2
+
+	switch {
+	case body.Hydration < 55 || body.Thirst >= 45:
+		s.contestantDrink(c)
+	case !c.sheltered():
+		s.contestantBuildShelter(c)
+	case c.Camp.ShelterDurability < 40 && !evening:
+		c.Camp.ShelterDurability = min(100, c.Camp.ShelterDurability+25)
+		s.trainSkill(body, SkillSheltercraft, 3, true)
+		body.Energy -= 1
+	case !c.fireLit() && (cold || evening):
+		s.contestantTendFire(c, r)
+	case c.Camp.Traps > 0 && c.Camp.TrapsCheckedDay != c.day() && hour >= 7:
+		s.contestantCheckTraps(c, r)
+	case hungry && !needsPlants && c.Camp.WaterSource != waterSourceStanding:
+		s.contestantFish(c, r)
+	case hungry:
+		s.contestantForage(c, r)
+	case c.Camp.Traps < contestantMaxTraps:
+		c.Camp.Traps++
+		s.trainSkill(body, SkillTrapping, 4, true)
+		body.Energy -= 1
+	case c.Camp.FireHours < 3 && evening:
+		s.contestantTendFire(c, r)
+	default:
+		body.Energy += 1
+		if r.Float64() < 0.3 {
+			body.Morale += 1
+		}
+	}
+}
 
-	// Weather impact
-	if weather.TemperatureC < 0 {
-		energyDecay += 1.0 * hours
-		moraleDecay += 0.5 * hours
-	} else if weather.TemperatureC > 30 {
-		hydrationDecay += 1.5 * hours
-		energyDecay += 0.5 * hours
+func (s *RunState) contestantDrink(c *ContestantState) {
+	body := &c.Body
+	quality := WaterRaw
+	if c.fireLit() {
+		quality = WaterBoiled
 	}
+	body.Hydration += 32
+	label := fmt.Sprintf("contestant-drink:%s:%d:%d", quality, c.ID, int(c.TimeSurvived/time.Hour))
+	applyWaterborneRisks(s.Config.Seed, label, body, quality, c.Camp.WaterSource, 1)
+}
 
-	if weather.Type == WeatherHeavyRain || weather.Type == WeatherBlizzard {
-		moraleDecay += 2.0 * hours
+func (s *RunState) contestantBuildShelter(c *ContestantState) {
+	body := &c.Body
+	spec, ok := shelterByID(c.Camp.Shelter)
+	if !ok {
+		spec, _ = shelterByID(ShelterLeanTo)
+	}
+	total := shelterBuildHours(spec)
+	c.Camp.ShelterHours += 0.6 + float64(body.Sheltercraft)/100
+	body.Energy -= clamp(spec.BuildEnergyCost/2, 1, 4)
+	body.Hydration -= clamp(spec.BuildHydrationCost/2, 1, 3)
+	s.trainSkill(body, SkillSheltercraft, 6, true)
+	if c.Camp.ShelterHours >= total {
+		c.Camp.ShelterHours = 0
+		c.Camp.ShelterDurability = 100
+		body.Morale += spec.BuildMoraleBonus
 	}
+}
 
-	// Apply time-based decay
-	s.Energy = max(0, s.Energy-int(energyDecay))
-	s.Hydration = max(0, s.Hydration-int(hydrationDecay))
-	s.Morale = max(0, s.Morale-int(moraleDecay))
+func (s *RunState) contestantTendFire(c *ContestantState, r *rand.Rand) {
+	body := &c.Body
+	body.Energy -= 1
+	if c.fireLit() {
+		c.Camp.FireHours += 4
+		return
+	}
+	chance := 0.35 + float64(body.Firecraft)/150
+	if isRainyWeather(s.Weather.Type) || isSevereWeather(s.Weather.Type) {
+		chance -= 0.2
+	}
+	s.trainSkill(body, SkillFirecraft, 5, true)
+	if r.Float64() < chance {
+		c.Camp.FireHours = 5 + r.Float64()*3
+		body.Morale += 2
+		return
+	}
+	body.Morale -= 1
+}
 
-	// Health decay if starving/dehydrated
-	if s.Energy == 0 || s.Hydration == 0 {
-		s.Health = max(0, s.Health-int(2*hours))
+func (s *RunState) contestantForage(c *ContestantState, r *rand.Rand) {
+	body := &c.Body
+	body.Energy -= 1
+	season, ok := s.CurrentSeason()
+	if !ok {
+		season = SeasonAutumn
+	}
+	plants := make([]PlantSpec, 0, 8)
+	for _, plant := range PlantsForBiomeSeason(s.Scenario.Biome, PlantCategoryAny, season) {
+		if plant.Toxicity == 0 && plant.NutritionPer100g.CaloriesKcal > 0 {
+			plants = append(plants, plant)
+		}
+	}
+	s.trainSkill(body, SkillForaging, 4, true)
+	if len(plants) == 0 || r.Float64() >= 0.3+float64(body.Foraging)/200 {
+		return
 	}
+	plant := plants[r.Intn(len(plants))]
+	grams := plant.YieldMinG
+	if plant.YieldMaxG > plant.YieldMinG {
+		grams += r.Intn(plant.YieldMaxG - plant.YieldMinG + 1)
+	}
+	s.contestantEat(c, nutritionFromPer100g(plant.NutritionPer100g, grams))
+}
 
-	// Random recovery (simulating foraging/drinking/resting off-screen)
-	// They don't always succeed. (Only apply if they haven't tapped out / died yet)
-	if r.Float64() > 0.3 { // 70% chance to find water
-		s.Hydration = min(100, s.Hydration+int(10*hours))
+func (s *RunState) contestantFish(c *ContestantState, r *rand.Rand) {
+	body := &c.Body
+	body.Energy -= 1
+	s.trainSkill(body, SkillFishing, 4, true)
+	if r.Float64() >= 0.12+float64(body.Fishing)/300 {
+		return
 	}
-	if r.Float64() > 0.6 { // 40% chance to find food
-		s.Energy = min(100, s.Energy+int(15*hours))
+	catch, err := RandomCatch(s.Config.Seed, s.Scenario.Biome, AnimalDomainWater, c.day(), 1000+c.ID*24+c.hourOfDay())
+	if err != nil {
+		return
 	}
-	if r.Float64() > 0.5 { // 50% chance to rest well
-		s.Morale = min(100, s.Morale+int(5*hours))
+	ConsumeCatch(s.Config.Seed, c.day(), body, catch, MealChoice{PortionGrams: contestantMealGrams, Cooked: c.fireLit()})
+	body.Morale += 2
+}
+
+func (s *RunState) contestantCheckTraps(c *ContestantState, r *rand.Rand) {
+	body := &c.Body
+	c.Camp.TrapsCheckedDay = c.day()
+	body.Energy -= 1
+	for trap := 0; trap < c.Camp.Traps; trap++ {
+		if r.Float64() >= 0.08+float64(body.Trapping)/500 {
+			continue
+		}
+		catch, err := RandomCatch(s.Config.Seed, s.Scenario.Biome, AnimalDomainLand, c.day(), 100+c.ID*10+trap)
+		if err != nil {
+			return
+		}
+		ConsumeCatch(s.Config.Seed, c.day(), body, catch, MealChoice{PortionGrams: contestantMealGrams, Cooked: c.fireLit()})
+		body.Morale += 2
+		s.trainSkill(body, SkillTrapping, 8, true)
+		return
 	}
+	body.Morale -= 1
+}
 
-	// Event rolls
-	return s.rollForEvents(r)
+func (s *RunState) contestantEat(c *ContestantState, nutrition NutritionTotals) {
+	body := &c.Body
+	applyMealNutritionReserves(body, nutrition)
+	body.Nutrition = body.Nutrition.add(nutrition)
+	energy, hydration, morale := nutritionToPlayerEffects(nutrition)
+	body.Energy += energy
+	body.Hydration += hydration
+	body.Morale += morale
 }
 
-func (s *ContestantState) rollForEvents(r *rand.Rand) (bool, string) {
-	// 1. Extreme Medical Emergency (e.g. bear attack, severe fall)
-	// Base chance 0.005% per tick, increased if low energy
-	riskFactor := 1.0
-	if s.Energy < 20 {
-		riskFactor = 3.0
+func (s *RunState) contestantSleepHour(c *ContestantState) {
+	quality := 0.6
+	if c.sheltered() {
+		quality += 0.35
+	} else {
+		if isRainyWeather(s.Weather.Type) {
+			quality -= 0.22
+		}
+		if s.Weather.TemperatureC <= 2 {
+			quality -= 0.2
+		}
 	}
-	if r.Float64() < 0.00005*riskFactor {
-		s.Status = ContestantMedicallyExtracted
-		s.Health = 0
-		return true, "A contestant has been medically extracted due to a severe injury."
+	if c.fireLit() && s.Weather.TemperatureC <= 10 {
+		quality += 0.15
 	}
+	if c.Body.Hunger >= 70 {
+		quality -= 0.1
+	}
+	quality = clampFloat(quality, 0.15, 1.5)
+	c.Body.Energy += int(math.Round(sleepRecoveryPerHour(SleepModeSleep) * quality))
+}
 
-	// 2. Tap Out due to Morale/Condition
-	// If stats fall below risk tolerance, chance to tap
-	tapThreshold := s.RiskTolerance * 5 // e.g. 15 to 40
-	if s.Morale < tapThreshold || s.Energy < tapThreshold || s.Hydration < tapThreshold {
-		if r.Float64() < 0.1 { // 10% chance to actually tap when below threshold
-			s.Status = ContestantTappedOut
-			return true, "A contestant has tapped out."
+// contestantNightRisk is the rival's version of a predator waking camp; fire and shelter keep most away.
+func (s *RunState) contestantNightRisk(c *ContestantState, r *rand.Rand) string {
+	cell, ok := s.TopologyCellAt(c.Camp.X, c.Camp.Y)
+	if !ok {
+		return ""
+	}
+	predators := make([]encounterSpecies, 0, 2)
+	for _, sp := range biomeEncounterList(cell.Biome, "mammal") {
+		if sp.Predator {
+			predators = append(predators, sp)
 		}
 	}
+	if len(predators) == 0 {
+		return ""
+	}
+	chance := 0.004
+	if c.fireLit() {
+		chance *= 0.25
+	}
+	if c.sheltered() {
+		chance *= 0.5
+	}
+	if r.Float64() >= chance {
+		return ""
+	}
+	species := predators[r.Intn(len(predators))]
+	c.Body.Morale -= 6
+	if r.Float64() >= predatorAttackChance+0.02 {
+		return ""
+	}
+	cause := "mauled by " + strings.ToLower(species.Name)
+	if r.Float64() < predatorFatalChance {
+		return s.contestantExit(c, ContestantDeceased, cause)
+	}
+	return s.contestantExit(c, ContestantMedicallyExtracted, cause)
+}
+
+// contestantEndOfDay mirrors AdvanceDay for a rival: weather, their own camp, ailments and deficiencies.
+func (s *RunState) contestantEndOfDay(c *ContestantState) {
+	body := &c.Body
+	season, ok := s.CurrentSeason()
+	if !ok {
+		season = SeasonAutumn
+	}
+	impact := weatherImpactForDay(s.Scenario.Biome, season, s.Weather.Type, s.Weather.StreakDays, s.Weather.TemperatureC)
+	impact = adjustWeatherImpactForPlayer(impact, *body, s.Weather.Type)
+	body.Energy += impact.Energy
+	body.Hydration += impact.Hydration
+	body.Morale += impact.Morale
 
-	// 3. Medical Extraction due to Health
-	if s.Health < 20 {
-		if r.Float64() < 0.2 {
-			s.Status = ContestantMedicallyExtracted
-			return true, "A contestant has been medically extracted."
+	if c.sheltered() {
+		if spec, ok := shelterByID(c.Camp.Shelter); ok {
+			body.Energy += spec.Insulation / 2
+			body.Morale += spec.Comfort / 2
+			c.Camp.ShelterDurability = maxInt(0, c.Camp.ShelterDurability-spec.DurabilityPerDay)
 		}
+	} else if isRainyWeather(s.Weather.Type) || isSevereWeather(s.Weather.Type) {
+		body.Energy -= 2
+		body.Morale -= 2
+	}
+	// Isolation wears on everyone out there, more so the longer it runs; the stubborn feel it slower.
+	body.Morale -= contestantIsolationMorale + c.DaysSurvived/maxInt(4, 4+c.RiskTolerance+body.MentalStrength)
+	applyDailyAilmentPenalties(body)
+	applyDailyDeficiencyEffects(body)
+}
+
+// contestantCriticalCheck applies the same critical-hours rule as updatePlayerOutcomes.
+func (s *RunState) contestantCriticalCheck(c *ContestantState, r *rand.Rand) string {
+	body := &c.Body
+	reasons := criticalReasons(*body)
+	if len(reasons) == 0 {
+		body.CriticalHours = clampFloat(body.CriticalHours-2, 0, criticalHoursToExtract)
+		return ""
+	}
+	body.CriticalHours++
+	if body.CriticalHours < criticalHoursToExtract {
+		return ""
+	}
+	cause := strings.Join(reasons, ", ")
+	if len(reasons) >= 3 && r.Float64() < criticalDeathChance {
+		return s.contestantExit(c, ContestantDeceased, cause)
 	}
+	return s.contestantExit(c, ContestantMedicallyExtracted, cause)
+}
+
+// contestantTapOutCheck is the evening reckoning: the lower morale sits under their threshold, the likelier they call it.
+func (s *RunState) contestantTapOutCheck(c *ContestantState, r *rand.Rand) string {
+	body := &c.Body
+	threshold := (13 - clamp(c.RiskTolerance, 1, 10)) * 5
+	chance := 0.0
+	if body.Morale < threshold {
+		chance += 0.45 * float64(threshold-body.Morale) / float64(threshold)
+	}
+	if body.Hunger >= 80 || body.Energy < threshold/2 {
+		chance += 0.08
+	}
+	if body.CriticalHours > 0 {
+		chance += 0.15
+	}
+	if chance <= 0 || r.Float64() >= chance {
+		return ""
+	}
+	return s.contestantExit(c, ContestantTappedOut, "voluntary")
+}
 
-	// 4. Death (very rare, usually extracted first, but possible if health is 0)
-	if s.Health == 0 {
-		if r.Float64() < 0.05 {
-			s.Status = ContestantDeceased
-			return true, "A contestant has perished."
+func (s *RunState) contestantExit(c *ContestantState, status ContestantStatus, cause string) string {
+	c.Status = status
+	c.Cause = cause
+	c.ExitDay = c.day()
+	c.syncFromBody()
+	switch status {
+	case ContestantTappedOut:
+		return fmt.Sprintf("%s has tapped out.", c.Name)
+	case ContestantDeceased:
+		return fmt.Sprintf("%s has perished (%s).", c.Name, cause)
+	default:
+		return fmt.Sprintf("%s has been medically extracted (%s).", c.Name, cause)
+	}
+}
+
+// syncFromBody keeps the summary bars in step with the hidden body for saves and older callers.
+func (c *ContestantState) syncFromBody() {
+	c.Energy = c.Body.Energy
+	c.Hydration = c.Body.Hydration
+	c.Morale = c.Body.Morale
+	load := 0
+	for _, ailment := range c.Body.Ailments {
+		load += ailment.EnergyPenalty + ailment.HydrationPenalty + ailment.MoralePenalty
+	}
+	c.Health = clamp(100-int(c.Body.CriticalHours*3)-load*2, 0, 100)
+}
+
+// ProducersReport is what the crew shares each day: how many rivals remain and who has gone home, never their condition.
+func (s *RunState) ProducersReport() string {
+	if s == nil || s.Config.Mode != ModeAlone || len(s.Contestants) == 0 {
+		return "Producer's report: there are no other contestants in this mode."
+	}
+	remaining := 0
+	gone := make([]ContestantState, 0, len(s.Contestants))
+	for _, c := range s.Contestants {
+		if c.Status == ContestantActive {
+			remaining++
+			continue
 		}
+		gone = append(gone, c)
 	}
+	report := fmt.Sprintf("Producer's report, day %d: %d of %d other contestants remain.", s.Day, remaining, len(s.Contestants))
+	if len(gone) == 0 {
+		return report
+	}
+	sort.SliceStable(gone, func(i, j int) bool { return gone[i].ExitDay < gone[j].ExitDay })
+	parts := make([]string, 0, len(gone))
+	for _, c := range gone {
+		part := fmt.Sprintf("%s (%s", c.Name, StatusLabel(c.Status))
+		if c.ExitDay > 0 {
+			part += fmt.Sprintf(", day %d", c.ExitDay)
+		}
+		parts = append(parts, part+")")
+	}
+	return report + " Gone home: " + strings.Join(parts, ", ") + "."
+}
 
-	return false, ""
+func (s *RunState) executeProducerCommand() RunCommandResult {
+	return RunCommandResult{Handled: true, Message: s.ProducersReport()}
 }
 
 func max(a, b int) int {
@@ -164,3 +645,10 @@ func min(a, b int) int {
 	}
 	return b
 }
+
+func absInt(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
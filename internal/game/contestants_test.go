@@ -7,21 +7,28 @@ import (
 	"time"
 )
 
-func TestContestantSimulationSetup(t *testing.T) {
+func newAloneRun(t *testing.T, seed int64) RunState {
+	t.Helper()
 	run, err := NewRunState(RunConfig{
 		Mode:        ModeAlone,
 		ScenarioID:  ScenarioVancouverIslandID,
 		PlayerCount: 1,
 		RunLength:   RunLength{Days: 365},
-		Seed:        1234,
+		Seed:        seed,
 	})
 	if err != nil {
 		t.Fatalf("failed to create run state: %v", err)
 	}
+	return run
+}
+
+func TestContestantSimulationSetup(t *testing.T) {
+	run := newAloneRun(t, 1234)
 
 	if len(run.Contestants) != 9 {
 		t.Fatalf("expected 9 AI contestants, got %d", len(run.Contestants))
 	}
+	sites := map[[2]int]bool{{run.Players[0].Travel.PosX, run.Players[0].Travel.PosY}: true}
 	for _, c := range run.Contestants {
 		if c.Status != ContestantActive {
 			t.Fatalf("expected all contestants to start active, got %s", c.Status)
@@ -29,102 +36,97 @@ func TestContestantSimulationSetup(t *testing.T) {
 		if c.Energy != 100 || c.Hydration != 100 || c.Health != 100 {
 			t.Fatalf("expected all stats to start at 100")
 		}
+		if c.Body.ID != c.ID || c.Body.Name != c.Name || c.Body.Foraging < 25 {
+			t.Fatalf("expected a hidden body with survival skills, got %+v", c.Body)
+		}
+		cell, ok := run.TopologyCellAt(c.Camp.X, c.Camp.Y)
+		if !ok || cell.Flags&TopoFlagWater != 0 || c.Camp.WaterSource == "" || c.Camp.Shelter == "" {
+			t.Fatalf("expected a land camp with water and a planned shelter, got %+v", c.Camp)
+		}
+		site := [2]int{c.Camp.X, c.Camp.Y}
+		if sites[site] {
+			t.Fatalf("expected each contestant to camp on their own cell, %v is shared", site)
+		}
+		sites[site] = true
 	}
 }
 
-func TestContestantSimulationDecay(t *testing.T) {
-	c := ContestantState{
-		ID:            1,
-		Status:        ContestantActive,
-		Energy:        80, // Start below 100 to allow observing both recovery and decay
-		Hydration:     80,
-		Morale:        80,
-		Health:        100,
-		RiskTolerance: 5,
-	}
-
-	// Create a predictable RNG that always returns 0 for Float64
-	// This ensures r.Float64() > threshold always fails for recovery
-	r := rand.New(&mockSource{val: 0})
-	weather := WeatherState{TemperatureC: 15, Type: WeatherClear}
-
-	// 10 hours should drop stats
-	c.processMacroTick(10*time.Hour, weather, r)
+func TestContestantsBuildCampAndBurnReserves(t *testing.T) {
+	run := newAloneRun(t, 1234)
+	c := &run.Contestants[0]
+	startReserve := c.Body.CaloriesReserveKcal
+	r := rand.New(rand.NewSource(5))
 
-	if c.Energy >= 80 || c.Hydration >= 80 || c.Morale >= 80 {
-		t.Fatalf("expected stats to have a net decay over time, got energy:%d hydr:%d morale:%d", c.Energy, c.Hydration, c.Morale)
+	for h := 0; h < 24 && c.Status == ContestantActive; h++ {
+		run.simulateContestantHour(c, r)
+	}
+	if c.Status != ContestantActive {
+		t.Fatalf("expected a fresh contestant to last the first day, got %s (%s)", c.Status, c.Cause)
+	}
+	if !c.sheltered() {
+		t.Fatalf("expected a shelter by the end of day one, got %+v", c.Camp)
+	}
+	if c.DaysSurvived != 1 || c.Body.CaloriesReserveKcal == startReserve {
+		t.Fatalf("expected a day of metabolism, got day %d reserve %d -> %d", c.DaysSurvived, startReserve, c.Body.CaloriesReserveKcal)
 	}
 }
 
-type mockSource struct {
-	val int64
-}
-
-func (m *mockSource) Int63() int64    { return m.val }
-func (m *mockSource) Seed(seed int64) {}
-
 func TestContestantsTapOutOnZeroStats(t *testing.T) {
-	c := ContestantState{
-		ID:            2,
-		Status:        ContestantActive,
-		Energy:        0,
-		Hydration:     0,
-		Morale:        0,
-		Health:        100,
-		RiskTolerance: 5,
-	}
+	run := newAloneRun(t, 99)
+	c := &run.Contestants[1]
+	c.Body.Energy = 0
+	c.Body.Hydration = 0
+	c.Body.Morale = 0
+	c.Body.CaloriesReserveKcal = -3000
+	refreshEffectBars(&c.Body)
 
 	r := rand.New(rand.NewSource(99))
-	weather := WeatherState{TemperatureC: 15, Type: WeatherClear}
-
-	// Process ticks until tap out occurs (since it's a 10% chance per tick when below thresholds)
-	tapped := false
-	for i := 0; i < 50; i++ {
-		if hasEvent, _ := c.processMacroTick(1*time.Hour, weather, r); hasEvent {
-			tapped = true
+	left := false
+	for i := 0; i < 72; i++ {
+		if msg := run.simulateContestantHour(c, r); msg != "" {
+			left = true
 			break
 		}
 	}
 
-	if !tapped {
-		t.Fatalf("expected contestant to tap out due to 0 stats after 50 hourly rolls")
+	if !left {
+		t.Fatalf("expected contestant to leave within three days of collapse")
 	}
-	if c.Status != ContestantTappedOut && c.Status != ContestantMedicallyExtracted {
+	if c.Status != ContestantTappedOut && c.Status != ContestantMedicallyExtracted && c.Status != ContestantDeceased {
 		t.Fatalf("expected status change, got %s", c.Status)
 	}
+	if c.ExitDay == 0 || c.Cause == "" {
+		t.Fatalf("expected exit day and cause to be recorded, got %+v", c)
+	}
 }
 
 func TestContestantHealthDropsIfStarving(t *testing.T) {
-	c := ContestantState{
-		Status:    ContestantActive,
-		Energy:    0,
-		Hydration: 0,
-		Health:    100,
-	}
+	run := newAloneRun(t, 1)
+	c := &run.Contestants[0]
+	c.Body.CaloriesReserveKcal = -5000
+	c.Body.ProteinReserveG = -250
+	refreshEffectBars(&c.Body)
 
 	r := rand.New(rand.NewSource(1))
-	weather := WeatherState{TemperatureC: 15, Type: WeatherClear}
-
-	c.processMacroTick(10*time.Hour, weather, r)
+	for i := 0; i < 10 && c.Status == ContestantActive; i++ {
+		run.simulateContestantHour(c, r)
+	}
 	if c.Health == 100 {
-		t.Fatalf("expected health to drop due to starvation/dehydration")
+		t.Fatalf("expected health to drop due to starvation")
 	}
 }
 
 func TestProcessContestantSimulationReturnsMessages(t *testing.T) {
-	run, _ := NewRunState(RunConfig{
-		Mode:        ModeAlone,
-		ScenarioID:  ScenarioVancouverIslandID,
-		PlayerCount: 1,
-		RunLength:   RunLength{Days: 365},
-		Seed:        123,
-	})
+	run := newAloneRun(t, 123)
 
-	// Force everyone to 0 stats so someone taps out quickly
+	// Force everyone to collapse so someone leaves quickly
 	for i := range run.Contestants {
-		run.Contestants[i].Energy = 0
-		run.Contestants[i].Hydration = 0
-		run.Contestants[i].Morale = 0
+		body := &run.Contestants[i].Body
+		body.Energy = 0
+		body.Hydration = 0
+		body.Morale = 0
+		body.CaloriesReserveKcal = -4000
+		refreshEffectBars(body)
 	}
 
 	messages := run.ProcessContestantSimulation(100 * time.Hour)
@@ -141,16 +143,32 @@ func TestProcessContestantSimulationReturnsMessages(t *testing.T) {
 	if !foundTapOut {
 		t.Fatalf("expected tap-out or extraction message, got: %v", messages)
 	}
+	if last := messages[len(messages)-1]; !strings.HasPrefix(last, "Producer's report") {
+		t.Fatalf("expected the producer's report to close the day, got %q", last)
+	}
+}
+
+func TestProducersReportHidesContestantStats(t *testing.T) {
+	run := newAloneRun(t, 42)
+	run.Contestants[2].Status = ContestantTappedOut
+	run.Contestants[2].ExitDay = 3
+
+	report := run.ProducersReport()
+	if !strings.Contains(report, "8 of 9") || !strings.Contains(report, run.Contestants[2].Name+" (tapped out, day 3)") {
+		t.Fatalf("unexpected report: %q", report)
+	}
+	for _, word := range []string{"energy", "hydration", "morale", "health", "%"} {
+		if strings.Contains(strings.ToLower(report), word) {
+			t.Fatalf("expected the report to hide contestant stats, found %q in %q", word, report)
+		}
+	}
+	if res := run.ExecuteRunCommand("producer"); !res.Handled || res.Message != report {
+		t.Fatalf("expected producer command to show the report, got %+v", res)
+	}
 }
 
 func TestModeAloneWinCondition(t *testing.T) {
-	run, _ := NewRunState(RunConfig{
-		Mode:        ModeAlone,
-		ScenarioID:  ScenarioVancouverIslandID,
-		PlayerCount: 1,
-		RunLength:   RunLength{Days: 365},
-		Seed:        123,
-	})
+	run := newAloneRun(t, 123)
 
 	// Initially ongoing
 	outcome := run.EvaluateRun()
@@ -0,0 +1,432 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Discovery summary:
+// - PlayerState.CurrentTask is a display label ("Idle" via defaultTask); `ask` used to only echo the request.
+// - Every delegated task maps onto an existing command run for that player, so results, skills and energy
+//   costs come from the same executors as direct play.
+// - Tasks tick down as AdvanceMinutes moves the clock (or a whole day on `next`). On completion the command
+//   runs with the clock held, because those hours already passed while the rest of the team acted.
+
+const (
+	delegationMaxHours = 12.0
+	delegationMinHours = 0.5
+)
+
+type DelegatedTask struct {
+	Kind        string  `json:"kind"`
+	Label       string  `json:"label"`
+	Command     string  `json:"command"`
+	Skill       string  `json:"skill"`
+	HoursTotal  float64 `json:"hours_total"`
+	HoursLeft   float64 `json:"hours_left"`
+	AssignedDay int     `json:"assigned_day"`
+}
+
+type delegatedTaskPlan struct {
+	Kind      string
+	Label     string
+	Command   string
+	Skill     string
+	Target    string // shelter or craftable ID for the kinds that build something
+	BaseHours float64
+}
+
+// parseTaskQuantity reads "5", "5kg", "300g" or "2l" and returns the number in the unit the command expects.
+func parseTaskQuantity(token string) (float64, bool) {
+	token = strings.TrimSpace(strings.ToLower(token))
+	for _, suffix := range []string{"litres", "liters", "ml", "kg", "g", "l"} {
+		if strings.HasSuffix(token, suffix) {
+			number, err := strconv.ParseFloat(strings.TrimSuffix(token, suffix), 64)
+			if err != nil {
+				return 0, false
+			}
+			if suffix == "ml" {
+				number /= 1000
+			}
+			return number, true
+		}
+	}
+	number, err := strconv.ParseFloat(token, 64)
+	return number, err == nil
+}
+
+// planDelegatedTask turns free task wording ("gather wood 5kg", "check traps") into a command for playerID.
+func planDelegatedTask(playerID int, words []string) (delegatedTaskPlan, bool) {
+	has := func(options ...string) bool {
+		for _, word := range words {
+			for _, option := range options {
+				if word == option {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	qty := 0.0
+	hasQty := false
+	rest := make([]string, 0, len(words))
+	for _, word := range words {
+		if n, ok := parseTaskQuantity(word); ok && !hasQty && n > 0 {
+			qty, hasQty = n, true
+			continue
+		}
+		switch word {
+		case "the", "some", "a", "an", "more", "go", "please", "and", "for", "us", "camp":
+			continue
+		}
+		rest = append(rest, word)
+	}
+	pid := fmt.Sprintf("p%d", playerID)
+	qtyArg := ""
+	if hasQty {
+		qtyArg = " " + strconv.FormatFloat(qty, 'f', -1, 64)
+	}
+	firstOther := func(skip ...string) string {
+		for _, word := range rest {
+			skipped := false
+			for _, s := range skip {
+				if word == s {
+					skipped = true
+					break
+				}
+			}
+			if !skipped {
+				return word
+			}
+		}
+		return ""
+	}
+
+	switch {
+	case has("trap", "traps", "snares"):
+		return delegatedTaskPlan{Kind: "traps", Label: "checking traps", Command: "trap check", Skill: "trapping", BaseHours: 1}, true
+	case has("wood", "firewood", "logs", "kindling"):
+		hours, label := 1.0, "gathering wood"
+		if hasQty {
+			hours = math.Max(delegationMinHours, qty*0.35)
+			label = fmt.Sprintf("gathering %skg of wood", strings.TrimSpace(qtyArg))
+		}
+		return delegatedTaskPlan{Kind: "wood", Label: label,
+			Command: "wood gather" + qtyArg + " " + pid, Skill: "gathering", BaseHours: hours}, true
+	case has("water"):
+		action, label := "collect", "fetching water"
+		switch {
+		case has("boil"):
+			action, label = "boil", "boiling water"
+		case has("filter"):
+			action, label = "filter", "filtering water"
+		case has("treat", "purify"):
+			action, label = "treat", "treating water"
+		}
+		return delegatedTaskPlan{Kind: "water", Label: label, Command: "water " + action + qtyArg + " " + pid, Skill: "bushcraft", BaseHours: delegationMinHours}, true
+	case has("fire") && !has("ignite", "light", "start"):
+		return delegatedTaskPlan{Kind: "fire", Label: "tending the fire", Command: "fire tend" + qtyArg + " " + pid, Skill: "firecraft", BaseHours: delegationMinHours}, true
+	case has("forage", "berries", "roots", "fruits", "vegetables"):
+		category := "any"
+		for _, option := range []string{"roots", "berries", "fruits", "vegetables"} {
+			if has(option) {
+				category = option
+			}
+		}
+		return delegatedTaskPlan{Kind: "forage", Label: "foraging " + category, Command: "forage " + category + " " + pid + qtyArg, Skill: "foraging", BaseHours: 1}, true
+	case has("fish", "fishing"):
+		return delegatedTaskPlan{Kind: "fish", Label: "fishing", Command: "fish " + pid, Skill: "fishing", BaseHours: 1}, true
+	case has("hunt", "hunting"):
+		domain := "land"
+		if has("air", "birds", "bird") {
+			domain = "air"
+		}
+		return delegatedTaskPlan{Kind: "hunt", Label: "hunting (" + domain + ")", Command: "hunt " + domain + " " + pid, Skill: "hunting", BaseHours: 1}, true
+	case has("shelter") && has("build"):
+		id := firstOther("build", "shelter", "a")
+		if id == "" {
+			return delegatedTaskPlan{}, false
+		}
+		return delegatedTaskPlan{Kind: "shelter", Label: "building " + id, Command: "shelter build " + id + " " + pid, Skill: "sheltercraft", Target: id, BaseHours: 2}, true
+	case has("craft", "make"):
+		id := firstOther("craft", "make")
+		if id == "" {
+			return delegatedTaskPlan{}, false
+		}
+		return delegatedTaskPlan{Kind: "craft", Label: "crafting " + id, Command: "craft make " + id + " " + pid, Skill: "crafting", Target: id, BaseHours: 1}, true
+	case has("collect", "gather", "get", "fetch"):
+		id := firstOther("collect", "gather", "get", "fetch")
+		if id == "" {
+			return delegatedTaskPlan{}, false
+		}
+		return delegatedTaskPlan{Kind: "collect", Label: "collecting " + id, Command: "collect " + id + qtyArg + " " + pid, Skill: "gathering", BaseHours: 1}, true
+	}
+	return delegatedTaskPlan{}, false
+}
+
+func playerSkillByName(player *PlayerState, skill string) int {
+	switch skill {
+	case "trapping":
+		return player.Trapping
+	case "gathering":
+		return player.Gathering
+	case "firecraft":
+		return player.Firecraft
+	case "foraging":
+		return player.Foraging
+	case "fishing":
+		return player.Fishing
+	case "hunting":
+		return player.Hunting
+	case "sheltercraft":
+		return player.Sheltercraft
+	case "crafting":
+		return player.Crafting
+	default:
+		return 30 + player.Bushcraft*10
+	}
+}
+
+// delegationHourFactor stretches or shortens a task by the worker's skill and fatigue.
+func delegationHourFactor(player *PlayerState, skill string) float64 {
+	factor := 1.3 - float64(playerSkillByName(player, skill))/150.0 + float64(player.Fatigue)/200.0
+	return clampFloat(factor, 0.6, 2.0)
+}
+
+// delegationRefusalChance is certain below morale 15 or above fatigue 90 and climbs toward those limits.
+func delegationRefusalChance(player *PlayerState) float64 {
+	if player.Morale < 15 || player.Fatigue >= 90 {
+		return 1
+	}
+	chance := 0.0
+	if player.Morale < 40 {
+		chance += float64(40-player.Morale) / 60.0
+	}
+	if player.Fatigue > 65 {
+		chance += float64(player.Fatigue-65) / 60.0
+	}
+	return clampFloat(chance, 0, 0.95)
+}
+
+func delegationFailureChance(player *PlayerState) float64 {
+	chance := 0.0
+	if player.Fatigue > 55 {
+		chance += float64(player.Fatigue-55) / 120.0
+	}
+	if player.Morale < 35 {
+		chance += float64(35-player.Morale) / 140.0
+	}
+	return clampFloat(chance, 0, 0.6)
+}
+
+func (s *RunState) delegationRoll(playerID int, label string) float64 {
+	s.ProcessAttemptCount++
+	rng := seededRNG(seedFromLabel(s.Config.Seed, fmt.Sprintf("delegate:%s:%d:%d:%d", label, s.Day, playerID, s.ProcessAttemptCount)))
+	return rng.Float64()
+}
+
+// estimateTaskHours is how long a plan takes before skill and fatigue, read from the shelter stage or
+// craftable it builds. It fails only when the target does not exist here; anything else surfaces on completion.
+func (s *RunState) estimateTaskHours(plan delegatedTaskPlan) (float64, error) {
+	switch plan.Kind {
+	case "shelter":
+		for _, spec := range SheltersForBiome(s.Scenario.Biome) {
+			if string(spec.ID) != plan.Target {
+				continue
+			}
+			stages := spec.Stages
+			if len(stages) == 0 {
+				stages = defaultShelterStages(spec)
+			}
+			next := 0
+			if s.Shelter.Type == spec.ID {
+				next = s.Shelter.Stage
+			}
+			if next >= len(stages) {
+				return 0, fmt.Errorf("%s is already fully built", spec.Name)
+			}
+			return math.Max(delegationMinHours, stages[next].BuildHours), nil
+		}
+		return 0, fmt.Errorf("no %s shelter can be built here", plan.Target)
+	case "craft":
+		for _, spec := range CraftablesForBiome(s.Scenario.Biome) {
+			if spec.ID == plan.Target {
+				return math.Max(delegationMinHours, spec.BaseHours), nil
+			}
+		}
+		return 0, fmt.Errorf("no %s can be made here", plan.Target)
+	}
+	return plan.BaseHours, nil
+}
+
+func (s *RunState) AssignTask(playerID int, words []string) (DelegatedTask, error) {
+	if s.Config.Mode == ModeAlone {
+		return DelegatedTask{}, fmt.Errorf("there is no team to delegate to in this mode")
+	}
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return DelegatedTask{}, fmt.Errorf("player %d not found", playerID)
+	}
+	if !player.Active() {
+		return DelegatedTask{}, fmt.Errorf("%s is out of the run (%s)", player.Name, StatusLabel(player.Status))
+	}
+	if player.Task != nil {
+		return DelegatedTask{}, fmt.Errorf("%s is busy %s (%.1fh left)", player.Name, player.Task.Label, player.Task.HoursLeft)
+	}
+	plan, ok := planDelegatedTask(playerID, words)
+	if !ok {
+		return DelegatedTask{}, fmt.Errorf("%s doesn't know how to %q", player.Name, strings.Join(words, " "))
+	}
+
+	hours, err := s.estimateTaskHours(plan)
+	if err != nil {
+		return DelegatedTask{}, fmt.Errorf("%s can't start %s: %v", player.Name, plan.Label, err)
+	}
+
+	if roll := s.delegationRoll(playerID, plan.Kind); roll < delegationRefusalChance(player) {
+		player.Morale = clamp(player.Morale-1, 0, 100)
+		reason := "not in the mood"
+		if player.Fatigue >= 65 {
+			reason = "too exhausted"
+		}
+		return DelegatedTask{}, fmt.Errorf("%s refuses: %s", player.Name, reason)
+	}
+
+	hours *= delegationHourFactor(player, plan.Skill)
+	hours = clampFloat(math.Round(hours*4)/4, delegationMinHours, delegationMaxHours)
+	task := DelegatedTask{
+		Kind:        plan.Kind,
+		Label:       plan.Label,
+		Command:     plan.Command,
+		Skill:       plan.Skill,
+		HoursTotal:  hours,
+		HoursLeft:   hours,
+		AssignedDay: s.Day,
+	}
+	player.Task = &task
+	player.CurrentTask = capitalizeTaskLabel(task.Label)
+	return task, nil
+}
+
+func (s *RunState) CancelTask(playerID int) (DelegatedTask, bool) {
+	player, ok := s.playerByID(playerID)
+	if !ok || player.Task == nil {
+		return DelegatedTask{}, false
+	}
+	task := *player.Task
+	player.Task = nil
+	player.CurrentTask = defaultTask("")
+	return task, true
+}
+
+func capitalizeTaskLabel(label string) string {
+	if label == "" {
+		return label
+	}
+	return strings.ToUpper(label[:1]) + label[1:]
+}
+
+// progressDelegatedTasks spends hours of background work and completes any task that runs out of time.
+func (s *RunState) progressDelegatedTasks(hours float64) {
+	if hours <= 0 {
+		return
+	}
+	for i := range s.Players {
+		task := s.Players[i].Task
+		if task == nil {
+			continue
+		}
+		task.HoursLeft -= hours
+		if task.HoursLeft <= 1e-6 {
+			s.completeDelegatedTask(s.Players[i].ID)
+		}
+	}
+}
+
+func (s *RunState) completeDelegatedTask(playerID int) {
+	player, ok := s.playerByID(playerID)
+	if !ok || player.Task == nil {
+		return
+	}
+	task := *player.Task
+	player.Task = nil
+	player.CurrentTask = defaultTask("")
+
+	if roll := s.delegationRoll(playerID, task.Kind+":finish"); roll < delegationFailureChance(player) {
+		player.Morale = clamp(player.Morale-2, 0, 100)
+		player.Energy = clamp(player.Energy-2, 0, 100)
+		refreshEffectBars(player)
+		s.reports = append(s.reports, fmt.Sprintf("P%d gave up %s after %.1fh, too worn down to finish.", playerID, task.Label, task.HoursTotal))
+		return
+	}
+
+	s.clockHeld = true
+	res := s.dispatchRunCommand(task.Command)
+	s.clockHeld = false
+	if res.Err != nil {
+		player.Morale = clamp(player.Morale-1, 0, 100)
+		s.reports = append(s.reports, fmt.Sprintf("P%d couldn't finish %s after %.1fh: %s", playerID, task.Label, task.HoursTotal, strings.TrimSpace(res.Message)))
+		return
+	}
+	message := strings.TrimSpace(res.Message)
+	if !res.Handled || message == "" {
+		message = "nothing to show for it."
+	}
+	s.reports = append(s.reports, fmt.Sprintf("P%d finished %s (%.1fh): %s", playerID, task.Label, task.HoursTotal, message))
+}
+
+// DrainReports returns and clears background results (delegated tasks, player outcomes) not yet shown.
+func (s *RunState) DrainReports() []string {
+	if s == nil || len(s.reports) == 0 {
+		return nil
+	}
+	out := s.reports
+	s.reports = nil
+	return out
+}
+
+func (s *RunState) executeAskCommand(args []string) RunCommandResult {
+	if s.Config.Mode == ModeAlone {
+		return RunCommandResult{Handled: true, Message: "No teammates to ask in Alone. You do everything yourself."}
+	}
+	if len(args) < 2 {
+		return RunCommandResult{Handled: true, Message: "Usage: ask <p#> <task> | ask <p#> status | ask <p#> stop", Err: errCommandUsage}
+	}
+	playerID := parsePlayerToken(args[0])
+	if playerID == 0 {
+		for i := range s.Players {
+			names := strings.Fields(s.Players[i].Name)
+			if len(names) > 0 && (strings.EqualFold(s.Players[i].Name, args[0]) || strings.EqualFold(names[0], args[0])) {
+				playerID = s.Players[i].ID
+				break
+			}
+		}
+	}
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("No teammate called %s.", args[0])}
+	}
+
+	switch args[1] {
+	case "status", "progress":
+		if player.Task == nil {
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("P%d %s is idle.", player.ID, player.Name)}
+		}
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("P%d %s is %s (%.1fh of %.1fh left).",
+			player.ID, player.Name, player.Task.Label, math.Max(0, player.Task.HoursLeft), player.Task.HoursTotal)}
+	case "stop", "cancel", "halt":
+		task, ok := s.CancelTask(player.ID)
+		if !ok {
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("P%d %s has no task to stop.", player.ID, player.Name)}
+		}
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("P%d %s stopped %s.", player.ID, player.Name, task.Label)}
+	}
+
+	task, err := s.AssignTask(player.ID, args[1:])
+	if err != nil {
+		return RunCommandResult{Handled: true, Message: capitalizeTaskLabel(err.Error()) + "."}
+	}
+	return RunCommandResult{Handled: true, Message: fmt.Sprintf("P%d %s heads off %s (about %.1fh).", player.ID, player.Name, task.Label, task.HoursTotal)}
+}
@@ -0,0 +1,194 @@
+package game
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func newDelegationRun(t *testing.T, mode GameMode, players int) RunState {
+	t.Helper()
+	run, err := NewRunState(RunConfig{
+		Mode:        mode,
+		ScenarioID:  ScenarioVancouverIslandID,
+		PlayerCount: players,
+		RunLength:   RunLength{Days: 21},
+		Seed:        5151,
+	})
+	if err != nil {
+		t.Fatalf("new run: %v", err)
+	}
+	return run
+}
+
+func campWoodKg(s *RunState) float64 {
+	total := 0.0
+	for _, stock := range s.WoodStock {
+		total += stock.Kg
+	}
+	return total
+}
+
+func TestAskQueuesWoodTaskAndCompletesOverTime(t *testing.T) {
+	run := newDelegationRun(t, ModeNakedAndAfraid, 2)
+
+	res := run.ExecuteRunCommand("ask p2 gather wood 3kg")
+	if !res.Handled || !strings.Contains(res.Message, "heads off") {
+		t.Fatalf("expected task to be accepted, got %q", res.Message)
+	}
+	p2, _ := run.playerByID(2)
+	if p2.Task == nil || p2.CurrentTask == defaultTask("") {
+		t.Fatalf("expected p2 to be busy, got task %+v (%s)", p2.Task, p2.CurrentTask)
+	}
+	if got := run.ExecuteRunCommand("ask p2 collect bark"); !strings.Contains(got.Message, "busy") {
+		t.Fatalf("expected a busy player to refuse a second task, got %q", got.Message)
+	}
+
+	before := campWoodKg(&run)
+	res = run.ExecuteRunCommand("sleep 8")
+	if !strings.Contains(res.Message, "P2 finished gathering") {
+		t.Fatalf("expected the finished task in the message log, got %q", res.Message)
+	}
+	if campWoodKg(&run) <= before {
+		t.Fatalf("expected camp wood to grow, before %.1f after %.1f", before, campWoodKg(&run))
+	}
+	if p2.Task != nil || p2.CurrentTask != defaultTask("") {
+		t.Fatalf("expected p2 to be idle again, got %+v (%s)", p2.Task, p2.CurrentTask)
+	}
+}
+
+func TestAskRefusesWhenMoraleIsLow(t *testing.T) {
+	run := newDelegationRun(t, ModeNakedAndAfraidXL, 3)
+	p3, _ := run.playerByID(3)
+	p3.Morale = 10
+
+	res := run.ExecuteRunCommand("ask p3 check traps")
+	if !strings.Contains(res.Message, "refuses") {
+		t.Fatalf("expected refusal, got %q", res.Message)
+	}
+	if p3.Task != nil {
+		t.Fatalf("expected no task after refusal")
+	}
+}
+
+func TestAskIsUnavailableInAlone(t *testing.T) {
+	run := newDelegationRun(t, ModeAlone, 1)
+	res := run.ExecuteRunCommand("ask p1 gather wood")
+	if !res.Handled || !strings.Contains(res.Message, "No teammates") {
+		t.Fatalf("expected Alone to reject delegation, got %q", res.Message)
+	}
+}
+
+func TestExhaustedWorkerCanFailOnNextDay(t *testing.T) {
+	failed, finished := 0, 0
+	for seed := int64(1); seed <= 20; seed++ {
+		run := newDelegationRun(t, ModeNakedAndAfraid, 2)
+		run.Config.Seed = seed
+		p2, _ := run.playerByID(2)
+		if _, err := run.AssignTask(2, []string{"gather", "wood", "2kg"}); err != nil {
+			continue
+		}
+		p2.Fatigue = 89
+		p2.Morale = 20
+		run.AdvanceDay()
+		reports := strings.Join(run.DrainReports(), "\n")
+		switch {
+		case strings.Contains(reports, "gave up"):
+			failed++
+		case strings.Contains(reports, "finished"):
+			finished++
+		default:
+			t.Fatalf("seed %d: expected the task to resolve on next day, got %q", seed, reports)
+		}
+	}
+	if failed == 0 || finished == 0 {
+		t.Fatalf("expected exhaustion to cause some failures but not all, failed %d finished %d", failed, finished)
+	}
+}
+
+func TestTaskHoursComeFromTheBuildSpec(t *testing.T) {
+	run := newDelegationRun(t, ModeNakedAndAfraid, 2)
+	p2, _ := run.playerByID(2)
+	p2.Morale, p2.Fatigue = 80, 0
+
+	clock := run.ClockHours
+	task, err := run.AssignTask(2, []string{"build", "shelter", "lean_to"})
+	if err != nil {
+		t.Fatalf("assign shelter: %v", err)
+	}
+	var spec ShelterSpec
+	for _, option := range SheltersForBiome(run.Scenario.Biome) {
+		if option.ID == ShelterLeanTo {
+			spec = option
+		}
+	}
+	stages := spec.Stages
+	if len(stages) == 0 {
+		stages = defaultShelterStages(spec)
+	}
+	if want := stages[0].BuildHours * delegationHourFactor(p2, "sheltercraft"); math.Abs(task.HoursTotal-want) > 0.25 {
+		t.Fatalf("expected the task to take about the first stage's %.1fh, got %.1fh", want, task.HoursTotal)
+	}
+	if run.ClockHours != clock || run.Shelter.Type != "" {
+		t.Fatalf("expected assigning a task to leave the run untouched")
+	}
+
+	run.CancelTask(2)
+	if _, err := run.AssignTask(2, []string{"craft", "moon_rope"}); err == nil || !strings.Contains(err.Error(), "can't start") {
+		t.Fatalf("expected an unknown craftable to be refused up front, got %v", err)
+	}
+}
+
+func TestTaskThatCannotBeDoneReportsWhy(t *testing.T) {
+	run := newDelegationRun(t, ModeNakedAndAfraid, 2)
+	p2, _ := run.playerByID(2)
+	p2.Morale, p2.Fatigue, p2.Bushcraft = 80, 0, 3
+
+	if _, err := run.AssignTask(2, []string{"craft", "heavy_cordage"}); err != nil {
+		t.Fatalf("assign craft: %v", err)
+	}
+	run.AdvanceDay()
+	reports := strings.Join(run.DrainReports(), "\n")
+	if !strings.Contains(reports, "P2 couldn't finish crafting heavy_cordage") || !strings.Contains(reports, "natural_twine") {
+		t.Fatalf("expected the missing twine to be reported, got %q", reports)
+	}
+	if slices.Contains(run.CraftedItems, "heavy_cordage") {
+		t.Fatalf("expected nothing to be crafted")
+	}
+}
+
+func TestAskByNameSkipsBlankNames(t *testing.T) {
+	run := newDelegationRun(t, ModeNakedAndAfraid, 2)
+	run.Players[0].Name = "  "
+	run.Players[1].Name = "Sam Reyes"
+	if res := run.ExecuteRunCommand("ask sam status"); !strings.Contains(res.Message, "P2 Sam Reyes is idle") {
+		t.Fatalf("expected P2 to be found by first name, got %q", res.Message)
+	}
+	if res := run.ExecuteRunCommand("ask nobody status"); !strings.Contains(res.Message, "No teammate called") {
+		t.Fatalf("expected an unknown name to be reported, got %q", res.Message)
+	}
+}
+
+func TestAskByNameChecksTheNamedTeammate(t *testing.T) {
+	run := newDelegationRun(t, ModeNakedAndAfraid, 2)
+	run.Players[1].Name = "Bob"
+	run.Players[1].Status = ContestantTappedOut
+	if res := run.ExecuteRunCommand("ask bob gather wood"); !strings.Contains(res.Message, "out of the run") || run.Players[1].Task != nil {
+		t.Fatalf("expected a tapped-out teammate to be refused a task, got %q", res.Message)
+	}
+
+	run.Players[0].Status = ContestantMedicallyExtracted
+	run.Players[1].Status = ContestantActive
+	if res := run.ExecuteRunCommand("ask bob gather wood"); run.Players[1].Task == nil {
+		t.Fatalf("expected Bob to take the task with P1 gone, got %q", res.Message)
+	}
+}
+
+func TestTaskQuantitiesReadTheirUnits(t *testing.T) {
+	for token, want := range map[string]float64{"5": 5, "5kg": 5, "300g": 300, "2l": 2, "500ml": 0.5, "1litres": 1} {
+		if got, ok := parseTaskQuantity(token); !ok || got != want {
+			t.Fatalf("expected %q to read as %v, got %v (%v)", token, want, got, ok)
+		}
+	}
+}
@@ -36,6 +36,17 @@ type WeatherState struct {
 	Type         WeatherType `json:"type"`
 	TemperatureC int         `json:"temperature_c"`
 	StreakDays   int         `json:"streak_days"`
+
+	// Hourly conditions; see weather_hourly.go. Type and TemperatureC follow the
+	// current hour while DayType and MeanC keep the day's overall weather.
+	Hour     int           `json:"hour,omitempty"`
+	WindKph  int           `json:"wind_kph,omitempty"`
+	PrecipMM float64       `json:"precip_mm,omitempty"`
+	DayType  WeatherType   `json:"day_type,omitempty"`
+	MeanC    int           `json:"mean_c,omitempty"`
+	HighC    int           `json:"high_c,omitempty"`
+	LowC     int           `json:"low_c,omitempty"`
+	Hourly   []WeatherHour `json:"hourly,omitempty"`
 }
 
 type weightedWeather struct {
@@ -48,7 +48,7 @@ type ForageResult struct {
 	Nutrition    NutritionTotals
 }
 
-func PlantCatalog() []PlantSpec {
+func builtinPlantCatalog() []PlantSpec {
 	base := []PlantSpec{
 		{ID: "burdock_root", Name: "Burdock Root", Category: PlantCategoryRoots, BiomeTags: []string{"forest", "temperate", "mountain"}, YieldMinG: 120, YieldMaxG: 600, NutritionPer100g: NutritionPer100g{CaloriesKcal: 72, ProteinG: 1, FatG: 0, SugarG: 2}},
 		{ID: "cattail_root", Name: "Cattail Rhizome", Category: PlantCategoryRoots, BiomeTags: []string{"wetlands", "swamp", "delta", "lake"}, YieldMinG: 200, YieldMaxG: 900, NutritionPer100g: NutritionPer100g{CaloriesKcal: 80, ProteinG: 2, FatG: 0, SugarG: 3}},
@@ -268,17 +268,28 @@ func (s *RunState) ForageAndConsume(playerID int, category PlantCategory, grams
 	if !ok {
 		season = ""
 	}
-	biome := s.CurrentBiomeQuery()
+	biome := s.PlayerBiomeQuery(playerID)
 	if strings.TrimSpace(biome) == "" {
 		biome = s.Scenario.Biome
 	}
 	forage, err := RandomForageForSeasonWithClimate(s.Config.Seed, biome, category, season, s.Day, playerID, s.ActiveClimateProfile(), s.Weather.TemperatureC)
+	// A berry patch steers an open-ended forage to its berries while they are in season.
+	atPatch := (category == PlantCategoryAny || category == PlantCategoryBerries) && s.cellHasPOI(player, POIBerryPatch)
+	if atPatch && category == PlantCategoryAny {
+		if berries, berryErr := RandomForageForSeasonWithClimate(s.Config.Seed, biome, PlantCategoryBerries, season, s.Day, playerID, s.ActiveClimateProfile(), s.Weather.TemperatureC); berryErr == nil {
+			forage, err = berries, nil
+		}
+	}
 	if err != nil {
 		return ForageResult{}, err
 	}
-	applySkillEffort(&player.Foraging, 16, true)
-	applySkillEffort(&player.Gathering, 10, true)
+	s.trainSkill(player, SkillForaging, 16, true)
+	s.trainSkill(player, SkillGathering, 10, true)
 	bonusPct := player.Foraging/10 + player.Agility + positiveTraitModifier(player.Traits)/2
+	if atPatch && forage.Plant.Category == PlantCategoryBerries {
+		s.playerAtPOI(player, POIBerryPatch)
+		bonusPct += 60
+	}
 	if bonusPct != 0 {
 		forage.HarvestGrams = max(1, forage.HarvestGrams+(forage.HarvestGrams*bonusPct)/100)
 	}
@@ -290,6 +301,8 @@ func (s *RunState) ForageAndConsume(playerID int, category PlantCategory, grams
 
 	applyMealNutritionReserves(player, forage.Nutrition)
 	player.Nutrition = player.Nutrition.add(forage.Nutrition)
+	s.recordEvent(player, EventKcalEaten, float64(forage.Nutrition.CaloriesKcal))
+	s.recordEvent(player, EventForageKcal, float64(forage.Nutrition.CaloriesKcal))
 	energyGain, hydrationGain, moraleGain := nutritionToPlayerEffects(forage.Nutrition)
 	player.Energy = clamp(player.Energy+energyGain, 0, 100)
 	player.Hydration = clamp(player.Hydration+hydrationGain, 0, 100)
@@ -400,7 +413,7 @@ type ResourceStock struct {
 	Qty  float64 `json:"qty"`
 }
 
-func ResourceCatalog() []ResourceSpec {
+func builtinResourceCatalog() []ResourceSpec {
 	base := []ResourceSpec{
 		{ID: "dry_grass", Name: "Dry Grass", BiomeTags: []string{"savanna", "badlands", "dry", "desert", "forest"}, Unit: "bundle", GatherMin: 1, GatherMax: 4, Dryness: 0.85, Flammable: true, Uses: []string{"tinder", "thatch", "insulation"}},
 		{ID: "birch_bark", Name: "Birch Bark", BiomeTags: []string{"boreal", "subarctic", "forest", "mountain"}, Unit: "sheet", GatherMin: 1, GatherMax: 3, Dryness: 0.8, Flammable: true, Uses: []string{"tinder", "container", "roofing"}},
@@ -556,12 +569,22 @@ func (s *RunState) CollectResource(playerID int, resourceID string, requestedQty
 	if s == nil {
 		return ResourceSpec{}, 0, fmt.Errorf("run state is nil")
 	}
-	if _, ok := s.playerByID(playerID); !ok {
+	player, ok := s.playerByID(playerID)
+	if !ok {
 		return ResourceSpec{}, 0, fmt.Errorf("player %d not found", playerID)
 	}
 
 	resourceID = strings.ToLower(strings.TrimSpace(resourceID))
 	available := ResourcesForBiome(s.Scenario.Biome)
+	// A clay bank has clay and mud whatever the biome list says.
+	atClayBank := s.cellHasPOI(player, POIClayBank)
+	if atClayBank {
+		for _, id := range []string{"clay", "mud"} {
+			if spec, ok := s.findResourceForBiome(id); ok && !slices.ContainsFunc(available, func(r ResourceSpec) bool { return r.ID == id }) {
+				available = append(available, spec)
+			}
+		}
+	}
 	if len(available) == 0 {
 		return ResourceSpec{}, 0, fmt.Errorf("no resources available")
 	}
@@ -604,6 +627,10 @@ func (s *RunState) CollectResource(playerID int, resourceID string, requestedQty
 	if qty < min {
 		qty = min
 	}
+	if atClayBank && (resource.ID == "clay" || resource.ID == "mud") {
+		s.playerAtPOI(player, POIClayBank)
+		qty *= 2
+	}
 
 	if err := s.addResourceStock(resource, qty); err != nil {
 		return ResourceSpec{}, 0, err
@@ -656,7 +683,7 @@ type TreeSpec struct {
 	Tags          []string
 }
 
-func TreeCatalog() []TreeSpec {
+func builtinTreeCatalog() []TreeSpec {
 	base := []TreeSpec{
 		{ID: "cedar", Name: "Cedar", BiomeTags: []string{"coast", "temperate_rainforest", "vancouver", "forest"}, WoodType: WoodTypeSoftwood, GatherMinKg: 0.8, GatherMaxKg: 4.2, HeatFactor: 0.85, BurnFactor: 0.8, SparkEase: 3, Hardness: 2, Structural: 3, ResinQuality: 0.5, RotResistance: 5, SmokeFactor: 0.8, BarkResource: "cedar_bark", BarkUses: []string{"cordage", "roofing"}, Tags: []string{"conifer"}},
 		{ID: "spruce", Name: "Spruce", BiomeTags: []string{"boreal", "subarctic", "forest", "mountain"}, WoodType: WoodTypeResinous, GatherMinKg: 0.9, GatherMaxKg: 4.5, HeatFactor: 0.92, BurnFactor: 0.85, SparkEase: 4, Hardness: 3, Structural: 4, ResinQuality: 0.85, RotResistance: 3, SmokeFactor: 1.1, BarkResource: "spruce_root", BarkUses: []string{"sewing", "lashing"}, Tags: []string{"conifer"}},
@@ -857,7 +884,7 @@ func (s *RunState) DryWood(playerID int, kg float64) (float64, error) {
 	if dried <= 0 {
 		return 0, fmt.Errorf("no wood stock to dry")
 	}
-	applySkillEffort(&player.Gathering, int(math.Ceil(dried*8)), true)
+	s.trainSkill(player, SkillGathering, int(math.Ceil(dried*8)), true)
 	player.Energy = clamp(player.Energy-clamp(int(math.Ceil(dried)), 1, 5), 0, 100)
 	player.Hydration = clamp(player.Hydration-clamp(int(math.Ceil(dried/2)), 1, 3), 0, 100)
 	refreshEffectBars(player)
@@ -900,14 +927,23 @@ func (s *RunState) GatherWood(playerID int, requestedKg float64) (TreeSpec, floa
 	if kg < 0.2 {
 		kg = 0.2
 	}
-	applySkillEffort(&player.Gathering, int(math.Round(kg*10)), true)
+	s.trainSkill(player, SkillGathering, int(math.Round(kg*10)), true)
 	bonusPct := float64(player.Gathering)/100.0*0.2 + float64(player.Strength+player.Agility)*0.03 + float64(sumTraitModifier(player.Traits))*0.01
 	if bonusPct != 0 {
 		kg = math.Max(0.2, kg*(1.0+bonusPct))
 	}
-	if err := s.addWoodStockWithWetness(tree.WoodType, kg, s.ambientWoodWetness()); err != nil {
+	// Cut or burnt hands bring back less in the same time.
+	kg = math.Max(0.2, kg/(1+ailmentSlowdown(*player, activityHands)))
+	wetness := s.ambientWoodWetness()
+	if _, ok := s.playerAtPOI(player, POIDeadwood); ok {
+		kg *= 1.5
+		wetness *= 0.5
+	}
+	if err := s.addWoodStockWithWetness(tree.WoodType, kg, wetness); err != nil {
 		return TreeSpec{}, 0, err
 	}
+	s.wearCuttingTool(player, kg*0.9, KitHatchet, KitFoldingSaw, KitMachete, KitSixInchKnife)
+	s.toolAccident(player, "gathering wood", KitHatchet, KitFoldingSaw, KitMachete, KitSixInchKnife)
 	return tree, kg, nil
 }
 
@@ -1000,9 +1036,12 @@ func (s *RunState) StripBark(playerID int, treeID string, requestedQty float64)
 
 	score := float64(player.Bushcraft+player.Agility+player.Crafting/25) + float64(sumTraitModifier(player.Traits))/2
 	quality := qualityFromScore(score)
-	applySkillEffort(&player.Gathering, int(math.Round(float64(primaryQty)*10)), true)
-	applySkillEffort(&player.Crafting, int(math.Round(float64(fiberQty)*8)), true)
+	s.trainSkill(player, SkillGathering, int(math.Round(float64(primaryQty)*10)), true)
+	s.trainSkill(player, SkillCrafting, int(math.Round(float64(fiberQty)*8)), true)
 	hours := clampFloat(0.35+(float64(requestedQty)*0.18)-qualityTimeReduction(quality), 0.2, 2.5)
+	hours *= 1 + ailmentSlowdown(*player, activityHands)
+	s.wearCuttingTool(player, requestedQty*1.5, KitSixInchKnife, KitMultiTool, KitMachete, KitHatchet)
+	s.toolAccident(player, "stripping bark", KitSixInchKnife, KitMultiTool, KitMachete, KitHatchet)
 	player.Energy = clamp(player.Energy-int(math.Ceil(hours*3)), 0, 100)
 	player.Hydration = clamp(player.Hydration-int(math.Ceil(hours*2)), 0, 100)
 	refreshEffectBars(player)
@@ -1116,7 +1155,7 @@ type ShelterSpec struct {
 	Stages             []ShelterStageSpec
 }
 
-func ShelterCatalog() []ShelterSpec {
+func builtinShelterCatalog() []ShelterSpec {
 	return []ShelterSpec{
 		{
 			ID: ShelterDebrisHut, Name: "Debris Hut", BiomeTags: []string{"forest", "boreal", "subarctic", "mountain"},
@@ -1324,6 +1363,10 @@ func (s *RunState) BuildShelter(playerID int, shelterID string) (ShelterSpec, er
 		return ShelterSpec{}, fmt.Errorf("shelter not available in biome: %s", shelterID)
 	}
 
+	if err := s.requireAtCamp(player); err != nil {
+		return ShelterSpec{}, err
+	}
+	s.playerAtPOI(player, POIRockOverhang)
 	if s.Shelter.Type != "" && s.Shelter.Type != chosen.ID && s.Shelter.Durability > 0 {
 		return ShelterSpec{}, fmt.Errorf("already maintaining %s; finish or replace deliberately", s.Shelter.Type)
 	}
@@ -1365,17 +1408,21 @@ func (s *RunState) BuildShelter(playerID int, shelterID string) (ShelterSpec, er
 	}
 
 	if s.Shelter.Type != chosen.ID {
+		s.placeCamp()
 		s.Shelter = ShelterState{
 			Type:       chosen.ID,
 			Durability: 30,
 			BuiltDay:   s.Day,
 			Stage:      0,
-			SiteX:      s.Travel.PosX,
-			SiteY:      s.Travel.PosY,
+			SiteX:      s.Camp.X,
+			SiteY:      s.Camp.Y,
 		}
 	}
 	s.Shelter.Stage = nextStage
 	s.Shelter.Durability = clamp(s.Shelter.Durability+stage.DurabilityBonus, 10, 100)
+	if nextStage == len(stages) {
+		s.recordEvent(player, EventShelterBuilt, 1)
+	}
 
 	energyCost := max(1, stage.BuildEnergyCost)
 	hydrationCost := max(1, stage.BuildHydrationCost)
@@ -1396,7 +1443,7 @@ func (s *RunState) BuildShelter(playerID int, shelterID string) (ShelterSpec, er
 	if effortHours <= 0 {
 		effortHours = 1.2
 	}
-	applySkillEffort(&player.Sheltercraft, int(math.Round(effortHours*16)), true)
+	s.trainSkill(player, SkillSheltercraft, int(math.Round(effortHours*16)), true)
 	if stage.BuildHours > 0 {
 		_ = s.AdvanceActionClock(stage.BuildHours)
 	}
@@ -1488,7 +1535,7 @@ func (s *RunState) shelterLocationModifier(state ShelterState) shelterMetrics {
 	}
 	x, y := state.SiteX, state.SiteY
 	if x == 0 && y == 0 {
-		x, y = s.Travel.PosX, s.Travel.PosY
+		x, y = s.CurrentMapPosition()
 	}
 	cell, ok := s.TopologyCellAt(x, y)
 	if !ok {
@@ -1519,6 +1566,14 @@ func (s *RunState) shelterLocationModifier(state ShelterState) shelterMetrics {
 		mod.InsectProtection -= 1
 		mod.DrynessProtection -= 2
 	}
+	if poi, ok := s.PointOfInterestAt(x, y); ok && poi.Kind == POIRockOverhang {
+		mod.RainProtection += 2
+		mod.WindProtection += 2
+		mod.Insulation += 1
+		mod.PredatorSafety += 1
+		mod.DrynessProtection += 2
+		mod.Maintenance += 2
+	}
 	return mod
 }
 
@@ -1571,11 +1626,14 @@ func (s *RunState) applyShelterUpgradeModifiers(metrics shelterMetrics) shelterM
 	apply("stone_hearth", func() { metrics.Comfort += 1; metrics.RainProtection += 1 })
 	apply("smoke_hole_baffle", func() { metrics.WindProtection += 1; metrics.RainProtection += 1 })
 	apply("storage_shelves", func() { metrics.StorageCapacityKg += 6 })
-	apply("elevated_food_cache", func() { metrics.StorageCapacityKg += 8; metrics.PredatorSafety += 2 })
 	apply("door_latch", func() { metrics.PredatorSafety += 1; metrics.Stealth += 1 })
 	apply("camouflage_screen", func() { metrics.Stealth += 2 })
 	apply("lookout_platform", func() { metrics.PredatorSafety += 1; metrics.WindProtection += 1 })
 	apply("cold_air_trench", func() { metrics.Insulation += 1; metrics.DrynessProtection += 1 })
+	// The food cache stands on its own at camp and adds storage there; it also keeps scavengers off the shelter.
+	if s.campHas(CampStructureFoodCache) {
+		metrics.PredatorSafety += 2
+	}
 	return metrics
 }
 
@@ -1645,6 +1703,9 @@ func (s *RunState) startFireWithMethod(playerID int, woodType WoodType, kg float
 	if !ok {
 		return fmt.Errorf("player %d not found", playerID)
 	}
+	if err := s.requireAtCamp(player); err != nil {
+		return err
+	}
 	if kg <= 0 {
 		kg = 1.0
 	}
@@ -1667,9 +1728,10 @@ func (s *RunState) startFireWithMethod(playerID int, woodType WoodType, kg float
 		LastTendedDay: s.Day,
 		LastMethod:    string(method),
 	}
+	s.recordEvent(player, EventFireLit, 1)
 	player.Morale = clamp(player.Morale+3, 0, 100)
 	player.Energy = clamp(player.Energy-1, 0, 100)
-	applySkillEffort(&player.Firecraft, 12, true)
+	s.trainSkill(player, SkillFirecraft, 12, true)
 	refreshEffectBars(player)
 	return nil
 }
@@ -1707,7 +1769,8 @@ func (s *RunState) TendFire(playerID int, kg float64, woodType WoodType) error {
 	s.Fire.WoodType = woodType
 	s.Fire.LastTendedDay = s.Day
 	player.Morale = clamp(player.Morale+2, 0, 100)
-	applySkillEffort(&player.Firecraft, 8, true)
+	s.trainSkill(player, SkillFirecraft, 8, true)
+	s.rollAccident(player, AilmentBurn, "tending the fire", fireAccidentChance)
 	refreshEffectBars(player)
 	return nil
 }
@@ -1755,7 +1818,7 @@ func (s *RunState) PrepareFireMaterial(playerID int, material string, count int)
 		s.FirePrep.TinderBundles += created
 		s.FirePrep.TinderQuality = blendQuality(s.FirePrep.TinderQuality, s.FirePrep.TinderBundles-created, quality/float64(created), created)
 		player.Energy = clamp(player.Energy-created, 0, 100)
-		applySkillEffort(&player.Firecraft, created*3, true)
+		s.trainSkill(player, SkillFirecraft, created*3, true)
 		refreshEffectBars(player)
 		return created, nil
 	case "kindling":
@@ -1776,7 +1839,7 @@ func (s *RunState) PrepareFireMaterial(playerID int, material string, count int)
 		s.FirePrep.KindlingQuality = blendQuality(s.FirePrep.KindlingQuality, s.FirePrep.KindlingBundles-created, quality/float64(created), created)
 		player.Energy = clamp(player.Energy-created, 0, 100)
 		player.Hydration = clamp(player.Hydration-created/2, 0, 100)
-		applySkillEffort(&player.Firecraft, created*3, true)
+		s.trainSkill(player, SkillFirecraft, created*3, true)
 		refreshEffectBars(player)
 		return created, nil
 	case "feather", "feathersticks", "feather_sticks":
@@ -1796,7 +1859,7 @@ func (s *RunState) PrepareFireMaterial(playerID int, material string, count int)
 		s.FirePrep.FeatherSticks += created
 		s.FirePrep.FeatherQuality = blendQuality(s.FirePrep.FeatherQuality, s.FirePrep.FeatherSticks-created, quality/float64(created), created)
 		player.Energy = clamp(player.Energy-created, 0, 100)
-		applySkillEffort(&player.Firecraft, created*4, true)
+		s.trainSkill(player, SkillFirecraft, created*4, true)
 		refreshEffectBars(player)
 		return created, nil
 	default:
@@ -1853,6 +1916,9 @@ func (s *RunState) TryCreateEmber(playerID int, method FireMethod, preferredWood
 	if method != FireMethodBowDrill && method != FireMethodHandDrill {
 		return 0, false, fmt.Errorf("ember method must be bow_drill or hand_drill")
 	}
+	if err := requireTechnique(*player, string(method)); err != nil {
+		return 0, false, err
+	}
 	if err := s.ensureFireMethodComponents(method); err != nil {
 		return 0, false, err
 	}
@@ -1879,7 +1945,7 @@ func (s *RunState) TryCreateEmber(playerID int, method FireMethod, preferredWood
 	} else {
 		player.Morale = clamp(player.Morale-1, 0, 100)
 	}
-	applySkillEffort(&player.Firecraft, 8, success)
+	s.trainSkill(player, SkillFirecraft, 8, success)
 	refreshEffectBars(player)
 
 	return chance, success, nil
@@ -2044,7 +2110,7 @@ func (s *RunState) IgniteFromEmber(playerID int, woodType WoodType, kg float64)
 
 	if !success {
 		player.Morale = clamp(player.Morale-1, 0, 100)
-		applySkillEffort(&player.Firecraft, 6, false)
+		s.trainSkill(player, SkillFirecraft, 6, false)
 		refreshEffectBars(player)
 		return chance, false, nil
 	}
@@ -2052,7 +2118,7 @@ func (s *RunState) IgniteFromEmber(playerID int, woodType WoodType, kg float64)
 	if err := s.startFireWithMethod(playerID, woodType, kg, FireMethodBowDrill); err != nil {
 		return chance, false, err
 	}
-	applySkillEffort(&player.Firecraft, 12, true)
+	s.trainSkill(player, SkillFirecraft, 12, true)
 	refreshEffectBars(player)
 	return chance, true, nil
 }
@@ -2116,6 +2182,8 @@ func (s *RunState) progressCampState() {
 			s.Shelter = ShelterState{}
 		}
 	}
+	s.decayCampStructures()
+	s.collectRainWater()
 
 	// Prepared fire materials can degrade in persistent wet weather.
 	if isRainyWeather(s.Weather.Type) || s.Weather.Type == WeatherSnow || s.Weather.Type == WeatherBlizzard {
@@ -2143,6 +2211,9 @@ func (s *RunState) progressCampState() {
 	case WoodTypeDriftwood:
 		burn *= 1.15
 	}
+	if s.campHas(CampStructureFirePit) {
+		burn *= fireBurnWithPit
+	}
 	s.Fire.FuelKg -= burn
 	if s.Fire.FuelKg <= 0.05 {
 		s.ExtinguishFire()
@@ -2221,7 +2292,7 @@ type ResourceRequirement struct {
 	Qty float64
 }
 
-func CraftableCatalog() []CraftableSpec {
+func builtinCraftableCatalog() []CraftableSpec {
 	base := []CraftableSpec{
 		// Fire method components.
 		{ID: "bow_drill_spindle", Name: "Bow Drill Spindle", BiomeTags: []string{"forest", "coast", "mountain", "jungle", "savanna", "badlands", "desert"}, Description: "Straight spindle for bow drill fire set.", MinBushcraft: 1, WoodKg: 0.2, Effects: statDelta{Morale: 1}},
@@ -2263,6 +2334,7 @@ func CraftableCatalog() []CraftableSpec {
 		{ID: "snow_melt_station", Name: "Snow Melt Station", BiomeTags: []string{"arctic", "subarctic", "tundra", "winter"}, Description: "Converts snow to usable water efficiently.", MinBushcraft: 1, RequiresFire: true, WoodKg: 0.9, Effects: statDelta{Hydration: 2}},
 		{ID: "raised_bed", Name: "Raised Bed", BiomeTags: []string{"swamp", "wetlands", "jungle", "forest"}, Description: "Improves overnight rest by lifting off damp ground.", MinBushcraft: 2, RequiresShelter: true, WoodKg: 1.1, RequiresResources: []ResourceRequirement{{ID: "reed_bundle", Qty: 1}}, Effects: statDelta{Energy: 2, Morale: 1}},
 		{ID: "signal_beacon", Name: "Signal Beacon", BiomeTags: []string{"coast", "island", "mountain", "badlands", "savanna"}, Description: "High-visibility signal structure.", MinBushcraft: 0, RequiresFire: true, WoodKg: 1.3, Effects: statDelta{Morale: 2}},
+		{ID: "signal_fire", Name: "Signal Fire", Category: "fire", BiomeTags: []string{"forest", "coast", "mountain", "jungle", "savanna", "badlands", "desert", "boreal", "tundra", "wetlands", "swamp", "island", "delta", "lake", "river", "arctic", "subarctic"}, Description: "Laid pyre with green cover, lit from the camp fire when a patrol passes.", MinBushcraft: 0, WoodKg: 2.5, Effects: statDelta{Morale: 1}},
 
 		// Clay-enabled builds for biomes where clay is available.
 		{ID: "clay_pot", Name: "Clay Pot", BiomeTags: []string{"river", "delta", "wetlands", "swamp", "lake", "badlands", "coast"}, Description: "Fire-hardened pot for boiling and stewing.", MinBushcraft: 2, RequiresFire: true, WoodKg: 0.4, RequiresResources: []ResourceRequirement{{ID: "clay", Qty: 1.2}}, Effects: statDelta{Hydration: 2, Morale: 1}},
@@ -2299,6 +2371,7 @@ func expandedCraftableCatalog() []CraftableSpec {
 
 		// Fire and cooking.
 		{ID: "ember_pot", Name: "Ember Pot", Category: "fire", BiomeTags: []string{"forest", "boreal", "mountain", "coast", "river"}, Description: "Keeps live embers for easier fire restart.", MinBushcraft: 2, RequiresFire: true, WeightKg: 1.2, BaseHours: 2.2, Portable: true, RequiresResources: []ResourceRequirement{{ID: "clay", Qty: 1.0}}, Effects: statDelta{Morale: 1}},
+		{ID: "fire_pit", Name: "Fire Pit", Category: "fire", BiomeTags: []string{"forest", "boreal", "mountain", "coast", "jungle", "savanna", "badlands", "desert", "dry", "wetlands", "swamp", "tundra", "arctic", "river", "lake", "delta", "island"}, Description: "Dug and ringed pit that shelters the fire and stretches its fuel.", MinBushcraft: 0, BaseHours: 1.0, Portable: false, Effects: statDelta{Morale: 1}},
 		{ID: "drying_rack", Name: "Drying Rack", Category: "food", BiomeTags: []string{"forest", "coast", "savanna", "jungle", "mountain"}, Description: "Passive drying rack for meat, fish, and herbs.", MinBushcraft: 1, RequiresShelter: true, WoodKg: 1.4, WeightKg: 4.5, BaseHours: 2.8, Portable: false, RequiresItems: []string{"heavy_cordage"}, Effects: statDelta{Energy: 1, Morale: 1}},
 		{ID: "smoking_rack", Name: "Smoking Rack", Category: "food", BiomeTags: []string{"forest", "coast", "savanna", "jungle", "wetlands"}, Description: "Controlled smoking rack for preservation.", MinBushcraft: 2, RequiresFire: true, RequiresShelter: true, WoodKg: 1.8, WeightKg: 5.0, BaseHours: 3.4, Portable: false, RequiresItems: []string{"drying_rack"}, Effects: statDelta{Morale: 2}},
 		{ID: "stone_oven", Name: "Stone Oven", Category: "food", BiomeTags: []string{"mountain", "badlands", "river", "coast", "forest"}, Description: "Stone-lined oven for baking and long cooks.", MinBushcraft: 2, RequiresFire: true, RequiresShelter: true, WoodKg: 1.0, WeightKg: 14, BaseHours: 5.0, Portable: false, RequiresResources: []ResourceRequirement{{ID: "stone_cobble", Qty: 3}, {ID: "mud", Qty: 1}}, Effects: statDelta{Morale: 2}},
@@ -2336,6 +2409,9 @@ func expandedCraftableCatalog() []CraftableSpec {
 		{ID: "storage_shelves", Name: "Storage Shelves", Category: "shelter_upgrade", BiomeTags: []string{"forest", "coast", "mountain", "jungle", "boreal"}, Description: "Raised shelves to keep stores off damp ground.", MinBushcraft: 1, RequiresShelter: true, WoodKg: 1.1, WeightKg: 3.0, BaseHours: 1.9, Portable: false, Effects: statDelta{Morale: 1}},
 		{ID: "elevated_food_cache", Name: "Elevated Food Cache", Category: "storage", BiomeTags: []string{"forest", "boreal", "wetlands", "tundra", "mountain"}, Description: "Suspended cache to protect food from scavengers.", MinBushcraft: 2, RequiresShelter: true, WoodKg: 1.7, WeightKg: 4.2, BaseHours: 2.7, Portable: false, RequiresItems: []string{"heavy_cordage"}, Effects: statDelta{Morale: 1}},
 		{ID: "underground_cold_pit", Name: "Underground Cold Pit", Category: "storage", BiomeTags: []string{"forest", "boreal", "mountain", "badlands", "tundra"}, Description: "Cool pit storage for preserving perishables.", MinBushcraft: 2, RequiresShelter: true, BaseHours: 2.5, Portable: false, RequiresItems: []string{"digging_stick"}, RequiresResources: []ResourceRequirement{{ID: "gravel", Qty: 1}, {ID: "clay", Qty: 0.8}}, Effects: statDelta{Energy: 1}},
+		{ID: "bear_hang", Name: "Bear Hang", Category: "storage", BiomeTags: []string{"forest", "boreal", "mountain", "coast", "jungle", "wetlands", "tundra"}, Description: "Food bag hung from a high branch, away from the trunk, out of reach of raiders.", MinBushcraft: 1, WoodKg: 0.6, WeightKg: 1.5, BaseHours: 1.2, Portable: false, RequiresItems: []string{"heavy_cordage"}, Effects: statDelta{Morale: 1}},
+		{ID: "cache_pit", Name: "Cache Pit", Category: "storage", BiomeTags: []string{"forest", "boreal", "mountain", "coast", "savanna", "badlands", "desert", "dry", "tundra", "arctic", "river", "lake", "delta", "island"}, Description: "Lined and covered pit that keeps stored food cool and out of smell.", MinBushcraft: 1, WoodKg: 0.4, WeightKg: 2.0, BaseHours: 2.5, Portable: false, RequiresItems: []string{"digging_stick"}, Effects: statDelta{Morale: 1}},
+		{ID: "sealed_container", Name: "Sealed Food Container", Category: "storage", BiomeTags: []string{"forest", "boreal", "mountain", "coast", "savanna", "jungle"}, Description: "Pitch-sealed container that holds in the smell of stored food.", MinBushcraft: 2, WoodKg: 0.3, WeightKg: 0.6, BaseHours: 1.4, Portable: true, RequiresItems: []string{"pitch_glue"}, Effects: statDelta{Morale: 1}},
 		{ID: "drying_box", Name: "Drying Box", Category: "storage", BiomeTags: []string{"forest", "coast", "savanna", "jungle", "badlands"}, Description: "Ventilated drying box for jerky and herbs.", MinBushcraft: 1, RequiresShelter: true, WoodKg: 1.2, WeightKg: 3.4, BaseHours: 2.0, Portable: false, RequiresItems: []string{"drying_rack"}, Effects: statDelta{Morale: 1}},
 
 		// Mobility/transport.
@@ -2405,6 +2481,12 @@ func (s *RunState) CraftItem(playerID int, craftID string) (CraftOutcome, error)
 	if !found {
 		return CraftOutcome{}, fmt.Errorf("craft item not available in biome: %s", craftID)
 	}
+	_, structure := campStructureSpecFor(chosen.ID)
+	if structure {
+		if err := s.requireAtCamp(player); err != nil {
+			return CraftOutcome{}, err
+		}
+	}
 
 	effectiveCraft := player.Bushcraft + player.Crafting/25 + player.Agility + positiveTraitModifier(player.Traits)/2 + negativeTraitModifier(player.Traits)/2
 	if effectiveCraft < chosen.MinBushcraft {
@@ -2440,7 +2522,9 @@ func (s *RunState) CraftItem(playerID int, craftID string) (CraftOutcome, error)
 		portability = true
 	}
 	storeAt := "camp"
-	if portability {
+	if structure {
+		storeAt = "standing at camp"
+	} else if portability {
 		if inventoryWeightKg(player.PersonalItems)+itemWeightKg <= s.playerCarryLimitKg(player)+1e-9 {
 			storeAt = "personal"
 		} else if !s.canStoreAtCamp(itemWeightKg) {
@@ -2449,6 +2533,11 @@ func (s *RunState) CraftItem(playerID int, craftID string) (CraftOutcome, error)
 	} else if !s.canStoreAtCamp(itemWeightKg) {
 		return CraftOutcome{}, fmt.Errorf("camp inventory full (%.1f/%.1fkg)", s.campUsedKg(), s.campCapacityKg())
 	}
+	if storeAt == "camp" {
+		if err := s.requireCampStores(player); err != nil {
+			return CraftOutcome{}, err
+		}
+	}
 
 	if chosen.WoodKg > 0 {
 		woodType := s.Fire.WoodType
@@ -2469,7 +2558,7 @@ func (s *RunState) CraftItem(playerID int, craftID string) (CraftOutcome, error)
 	if !slices.Contains(s.CraftedItems, chosen.ID) {
 		s.CraftedItems = append(s.CraftedItems, chosen.ID)
 	}
-	if s.Shelter.Type != "" && s.Shelter.Durability > 0 && (strings.EqualFold(chosen.Category, "shelter_upgrade") || isShelterUpgradeID(chosen.ID)) {
+	if !structure && s.Shelter.Type != "" && s.Shelter.Durability > 0 && (strings.EqualFold(chosen.Category, "shelter_upgrade") || isShelterUpgradeID(chosen.ID)) {
 		if !slices.Contains(s.Shelter.Upgrades, chosen.ID) {
 			s.Shelter.Upgrades = append(s.Shelter.Upgrades, chosen.ID)
 		}
@@ -2477,8 +2566,15 @@ func (s *RunState) CraftItem(playerID int, craftID string) (CraftOutcome, error)
 	rng := seededRNG(seedFromLabel(s.Config.Seed, fmt.Sprintf("craft:%s:%d:%d", chosen.ID, s.Day, playerID)))
 	qualityScore := float64(effectiveCraft) + float64(player.MentalStrength)/2 + rng.Float64()*2.4 - 1.2
 	quality := qualityFromScore(qualityScore)
+	s.recordCraftedGear(chosen.ID, quality)
 	hours := clampFloat(baseHours-qualityTimeReduction(quality), 0.2, 14)
+	hours *= 1 + ailmentSlowdown(*player, activityHands)
 	_ = s.AdvanceActionClock(hours)
+	if chosen.RequiresFire {
+		s.rollAccident(player, AilmentBurn, "working at the fire", fireAccidentChance)
+	} else {
+		s.toolAccident(player, "crafting", KitSixInchKnife, KitMultiTool, KitHatchet, KitMachete)
+	}
 
 	item := InventoryItem{
 		ID:       chosen.ID,
@@ -2489,8 +2585,13 @@ func (s *RunState) CraftItem(playerID int, craftID string) (CraftOutcome, error)
 		Category: chosen.Category,
 		Quality:  string(quality),
 	}
-	if storeAt == "personal" {
+	if structure {
+		s.placeCampStructure(chosen.ID, quality)
+	} else if storeAt == "personal" {
 		if err := s.AddPersonalInventoryItem(playerID, item); err != nil {
+			if err := s.requireCampStores(player); err != nil {
+				return CraftOutcome{}, err
+			}
 			if err := s.addCampInventoryItem(item); err != nil {
 				return CraftOutcome{}, err
 			}
@@ -2502,14 +2603,15 @@ func (s *RunState) CraftItem(playerID int, craftID string) (CraftOutcome, error)
 		}
 	}
 
-	applySkillEffort(&player.Crafting, int(math.Round(hours*18)), true)
+	s.trainSkill(player, SkillCrafting, int(math.Round(hours*18)), true)
+	s.recordEvent(player, EventItemCrafted, 1)
 	switch strings.ToLower(strings.TrimSpace(chosen.Category)) {
 	case "shelter_upgrade", "structures":
-		applySkillEffort(&player.Sheltercraft, int(math.Round(hours*14)), true)
+		s.trainSkill(player, SkillSheltercraft, int(math.Round(hours*14)), true)
 	case "fire":
-		applySkillEffort(&player.Firecraft, int(math.Round(hours*14)), true)
+		s.trainSkill(player, SkillFirecraft, int(math.Round(hours*14)), true)
 	case "fishing", "trapping":
-		applySkillEffort(&player.Trapping, int(math.Round(hours*12)), true)
+		s.trainSkill(player, SkillTrapping, int(math.Round(hours*12)), true)
 	}
 	player.Energy = clamp(player.Energy+chosen.Effects.Energy-int(math.Ceil(hours*2))+qualityCraftEffectBonus(quality), 0, 100)
 	player.Hydration = clamp(player.Hydration+chosen.Effects.Hydration-int(math.Ceil(hours*1.4)), 0, 100)
@@ -458,8 +458,8 @@ func TestGoTravelUsesWatercraftWhenAvailable(t *testing.T) {
 	}
 
 	run.CraftedItems = append(run.CraftedItems, "brush_raft")
-	run.Travel.PosX = startX
-	run.Travel.PosY = startY
+	run.Players[0].Travel.PosX = startX
+	run.Players[0].Travel.PosY = startY
 	run.Players[0].Energy = 100
 	run.Players[0].Hydration = 100
 	with, err := run.TravelMove(1, "north", 3)
@@ -6,7 +6,7 @@ import (
 	"strings"
 )
 
-type foodItemSpec struct {
+type FoodItemSpec struct {
 	ID              string
 	Name            string
 	Category        string
@@ -19,7 +19,7 @@ type foodItemSpec struct {
 	IllnessRisk     float64
 }
 
-var foodItemCatalog = map[string]foodItemSpec{
+var foodItemCatalog = map[string]FoodItemSpec{
 	"raw_small_game_meat":    {ID: "raw_small_game_meat", Name: "Raw Small Game Meat", Category: "meat", Cooked: false, Perishable: true, ShelfLifeDays: 1, DecayPerDay: 0.58, NutritionPer100: NutritionPer100g{CaloriesKcal: 150, ProteinG: 22, FatG: 6, SugarG: 0}, IllnessRisk: 0.16},
 	"raw_bird_meat":          {ID: "raw_bird_meat", Name: "Raw Bird Meat", Category: "meat", Cooked: false, Perishable: true, ShelfLifeDays: 1, DecayPerDay: 0.6, NutritionPer100: NutritionPer100g{CaloriesKcal: 145, ProteinG: 21, FatG: 5, SugarG: 0}, IllnessRisk: 0.18},
 	"raw_fish_meat":          {ID: "raw_fish_meat", Name: "Raw Fish Meat", Category: "fish", Cooked: false, Perishable: true, ShelfLifeDays: 1, DecayPerDay: 0.64, NutritionPer100: NutritionPer100g{CaloriesKcal: 120, ProteinG: 20, FatG: 4, SugarG: 0}, IllnessRisk: 0.12},
@@ -99,14 +99,23 @@ func (s *RunState) getInventoryQty(playerID int, itemID string) float64 {
 	if itemID == "" {
 		return 0
 	}
-	total := 0.0
-	if player, ok := s.playerByID(playerID); ok {
-		total += inventoryTotalQtyByID(player.PersonalItems, itemID)
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return 0
+	}
+	total := inventoryTotalQtyByID(player.PersonalItems, itemID)
+	if s.playerAtCamp(player) {
+		total += inventoryTotalQtyByID(s.CampInventory, itemID)
 	}
-	total += inventoryTotalQtyByID(s.CampInventory, itemID)
 	return total
 }
 
+// campStoresReachable reports whether the player can reach into the camp stores from where they stand.
+func (s *RunState) campStoresReachable(playerID int) bool {
+	player, ok := s.playerByID(playerID)
+	return ok && s.playerAtCamp(player)
+}
+
 func (s *RunState) consumeItemForPlayer(playerID int, itemID string, qty float64, preferPersonal bool) (InventoryItem, string, error) {
 	itemID = strings.ToLower(strings.TrimSpace(itemID))
 	if qty <= 0 {
@@ -117,8 +126,10 @@ func (s *RunState) consumeItemForPlayer(playerID int, itemID string, qty float64
 			return got, "personal", nil
 		}
 	}
-	if got, err := s.removeCampInventoryItem(itemID, qty); err == nil {
-		return got, "camp", nil
+	if s.campStoresReachable(playerID) {
+		if got, err := s.removeCampInventoryItem(itemID, qty); err == nil {
+			return got, "camp", nil
+		}
 	}
 	if !preferPersonal {
 		if got, err := s.removePersonalInventoryItem(playerID, itemID, qty); err == nil {
@@ -129,9 +140,11 @@ func (s *RunState) consumeItemForPlayer(playerID int, itemID string, qty float64
 }
 
 func (s *RunState) addItemForPlayer(playerID int, source string, item InventoryItem) error {
-	if source == "personal" {
-		if err := s.AddPersonalInventoryItem(playerID, item); err == nil {
-			return nil
+	// Away from camp everything stays in the player's own pack.
+	atCamp := s.campStoresReachable(playerID)
+	if source == "personal" || !atCamp {
+		if err := s.AddPersonalInventoryItem(playerID, item); err == nil || !atCamp {
+			return err
 		}
 	}
 	return s.addCampInventoryItem(item)
@@ -218,11 +231,11 @@ func (s *RunState) GutCarcass(playerID int, carcassID string, kg float64) (GutRe
 
 	hours := clampFloat(0.3+(kg*0.7)-(skill*0.03), 0.2, 8)
 	_ = s.AdvanceActionClock(hours)
-	applySkillEffort(&player.Crafting, int(math.Round(hours*18)), true)
+	s.trainSkill(player, SkillCrafting, int(math.Round(hours*18)), true)
 	if carcassID == "fish_carcass" {
-		applySkillEffort(&player.Fishing, int(math.Round(hours*16)), !pierced)
+		s.trainSkill(player, SkillFishing, int(math.Round(hours*16)), !pierced)
 	} else {
-		applySkillEffort(&player.Hunting, int(math.Round(hours*16)), !pierced)
+		s.trainSkill(player, SkillHunting, int(math.Round(hours*16)), !pierced)
 	}
 	player.Energy = clamp(player.Energy-int(math.Ceil(hours*3.2)), 0, 100)
 	player.Hydration = clamp(player.Hydration-int(math.Ceil(hours*2.2)), 0, 100)
@@ -312,7 +325,7 @@ func (s *RunState) PreserveFood(playerID int, method string, itemID string, kg f
 		return PreserveResult{}, fmt.Errorf("unknown preserve method")
 	}
 	itemID = strings.ToLower(strings.TrimSpace(itemID))
-	spec, ok := foodItemCatalog[itemID]
+	spec, ok := lookupFoodItem(itemID)
 	if !ok || itemID == "spoiled_meat" {
 		return PreserveResult{}, fmt.Errorf("item cannot be preserved: %s", itemID)
 	}
@@ -323,11 +336,11 @@ func (s *RunState) PreserveFood(playerID int, method string, itemID string, kg f
 	if preservedID == "" {
 		return PreserveResult{}, fmt.Errorf("item cannot be preserved: %s", itemID)
 	}
-	preservedSpec, ok := foodItemCatalog[preservedID]
+	preservedSpec, ok := lookupFoodItem(preservedID)
 	if !ok {
 		return PreserveResult{}, fmt.Errorf("no preserved profile for %s", preservedID)
 	}
-	if method == "smoke" && !s.Fire.Lit {
+	if method == "smoke" && (!s.Fire.Lit || !s.playerAtCamp(player)) {
 		return PreserveResult{}, fmt.Errorf("smoking requires active fire")
 	}
 	if method == "salt" && !hasAnyKitItem(*player, s.Config.IssuedKit, KitSalt) && s.getInventoryQty(playerID, "salt") <= 0 {
@@ -352,7 +365,7 @@ func (s *RunState) PreserveFood(playerID int, method string, itemID string, kg f
 	case "smoke":
 		yield = kg * 0.82
 		hours = 2.8 + (kg * 2.4)
-		if strings.Contains(strings.ToLower(strings.TrimSpace(string(s.Shelter.Type))), "smoke") || containsStringFold(s.CraftedItems, "smoke_rack") {
+		if strings.Contains(strings.ToLower(strings.TrimSpace(string(s.Shelter.Type))), "smoke") || s.campHasHere(player, CampStructureSmokeHouse) {
 			yield += kg * 0.04
 			hours -= 0.6
 		}
@@ -364,6 +377,9 @@ func (s *RunState) PreserveFood(playerID int, method string, itemID string, kg f
 			hours += 2.2
 			yield -= kg * 0.04
 		}
+		if s.campHasHere(player, CampStructureDryingRack) {
+			hours *= dryingRackHoursFactor
+		}
 	case "salt":
 		yield = kg * 0.86
 		hours = 1.2 + (kg * 1.5)
@@ -394,10 +410,10 @@ func (s *RunState) PreserveFood(playerID int, method string, itemID string, kg f
 	}
 
 	_ = s.AdvanceActionClock(hours)
-	applySkillEffort(&player.Crafting, int(math.Round(hours*18)), true)
-	applySkillEffort(&player.Cooking, int(math.Round(hours*12)), true)
+	s.trainSkill(player, SkillCrafting, int(math.Round(hours*18)), true)
+	s.trainSkill(player, SkillCooking, int(math.Round(hours*12)), true)
 	if method == "smoke" || method == "dry" {
-		applySkillEffort(&player.Gathering, int(math.Round(hours*8)), true)
+		s.trainSkill(player, SkillGathering, int(math.Round(hours*8)), true)
 	}
 	player.Energy = clamp(player.Energy-int(math.Ceil(hours*1.8)), 0, 100)
 	player.Hydration = clamp(player.Hydration-int(math.Ceil(hours*1.1)), 0, 100)
@@ -428,7 +444,7 @@ func (s *RunState) CookFood(playerID int, rawID string, kg float64) (CookResult,
 		return CookResult{}, fmt.Errorf("requires active fire")
 	}
 	rawID = strings.ToLower(strings.TrimSpace(rawID))
-	spec, ok := foodItemCatalog[rawID]
+	spec, ok := lookupFoodItem(rawID)
 	if !ok || spec.Cooked {
 		return CookResult{}, fmt.Errorf("item cannot be cooked: %s", rawID)
 	}
@@ -472,8 +488,8 @@ func (s *RunState) CookFood(playerID int, rawID string, kg float64) (CookResult,
 
 	hours := clampFloat(0.2+(kg*0.5)-float64(player.Crafting)/250.0, 0.12, 4)
 	_ = s.AdvanceActionClock(hours)
-	applySkillEffort(&player.Crafting, int(math.Round(hours*16)), true)
-	applySkillEffort(&player.Cooking, int(math.Round(hours*14)), true)
+	s.trainSkill(player, SkillCrafting, int(math.Round(hours*16)), true)
+	s.trainSkill(player, SkillCooking, int(math.Round(hours*14)), true)
 	player.Energy = clamp(player.Energy-int(math.Ceil(hours*1.2)), 0, 100)
 	player.Hydration = clamp(player.Hydration-int(math.Ceil(hours*0.8)), 0, 100)
 	player.Morale = clamp(player.Morale+1, 0, 100)
@@ -502,7 +518,7 @@ func (s *RunState) EatFood(playerID int, itemID string, amount float64) (EatResu
 		return EatResult{}, fmt.Errorf("player %d not found", playerID)
 	}
 	itemID = strings.ToLower(strings.TrimSpace(itemID))
-	spec, ok := foodItemCatalog[itemID]
+	spec, ok := lookupFoodItem(itemID)
 	if !ok {
 		return EatResult{}, fmt.Errorf("item is not edible profile: %s", itemID)
 	}
@@ -536,6 +552,7 @@ func (s *RunState) EatFood(playerID int, itemID string, amount float64) (EatResu
 	}
 	nutrition := nutritionFromPer100g(spec.NutritionPer100, grams)
 	applyMealNutritionReserves(player, nutrition)
+	s.recordEvent(player, EventKcalEaten, float64(nutrition.CaloriesKcal))
 	energyGain, hydrationGain, moraleGain := nutritionToPlayerEffects(nutrition)
 	player.Energy = clamp(player.Energy+energyGain, 0, 100)
 	player.Hydration = clamp(player.Hydration+hydrationGain, 0, 100)
@@ -653,7 +670,7 @@ func degradeFoodInventory(items []InventoryItem, weather WeatherState) ([]Invent
 			continue
 		}
 
-		spec, ok := foodItemCatalog[itemID]
+		spec, ok := lookupFoodItem(itemID)
 		if !ok || !spec.Perishable {
 			continue
 		}
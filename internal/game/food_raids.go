@@ -0,0 +1,241 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Discovery summary:
+//   - CampInventory holds food ("food") and carcasses ("carcass"); foodItemCatalog says whether a food is raw, cooked or preserved.
+//   - biomeEncounterList marks mammals as Predator and Scavenger; those are the animals that raid camp stores overnight.
+//   - Smell comes from raw meat, carcasses and spoiled meat far more than smoked, dried or salted food, plus the camp cell's CarcassToken.
+//   - A lit fire, a stealthy shelter and someone sleeping at camp keep raiders off; bear hangs, cache pits, the elevated cache and
+//     sealed containers cut the chance further.
+//   - Raids run in AdvanceDay and land in the run's report queue, so they read as the morning's news.
+const (
+	raidBaseChance       = 0.03
+	raidChancePerSmell   = 0.06
+	raidMaxChance        = 0.5
+	raidFireFactor       = 0.6
+	raidAwayFactor       = 1.5
+	sealedContainerSmell = 0.6
+	raidCacheDamage      = 15
+	raidShelterDamage    = 6
+)
+
+// raidCacheFactors is how much each food store cuts the chance of a raid getting in; the best one counts.
+var raidCacheFactors = map[CampStructureKind]float64{
+	CampStructureBearHang:  0.45,
+	CampStructureFoodCache: 0.5,
+	CampStructureCachePit:  0.6,
+}
+
+// foodSmellPerKg is how strongly a stored item draws animals, per kg.
+func foodSmellPerKg(item InventoryItem) float64 {
+	if item.Category == "carcass" {
+		return 1.5
+	}
+	spec, ok := lookupFoodItem(item.ID)
+	switch {
+	case !ok:
+		if item.Category == "food" {
+			return 0.1
+		}
+		return 0
+	case spec.Category == "waste":
+		return 1.2
+	case spec.Preserved && strings.HasPrefix(spec.ID, "smoked_"):
+		return 0.25
+	case spec.Preserved:
+		return 0.2
+	case spec.Cooked:
+		return 0.6
+	default:
+		return 1.0
+	}
+}
+
+// campFoodSmell is how much the camp's stores smell.
+func (s *RunState) campFoodSmell() float64 {
+	smell := 0.0
+	for _, item := range s.CampInventory {
+		smell += foodSmellPerKg(item) * item.Qty * math.Max(item.WeightKg, 0.01)
+	}
+	if hasCraftedItem(s.CraftedItems, "sealed_container") {
+		smell *= sealedContainerSmell
+	}
+	x, y, _ := s.campSite()
+	if idx, ok := s.topoIndex(x, y); ok && idx < len(s.CellStates) {
+		smell += float64(s.CellStates[idx].CarcassToken) * 0.2
+	}
+	return smell
+}
+
+// bestFoodCache is the food store at camp that does the most to keep raiders out.
+func (s *RunState) bestFoodCache() (CampStructureKind, float64) {
+	best, factor := CampStructureKind(""), 1.0
+	for kind, f := range raidCacheFactors {
+		if f < factor && s.campHas(kind) {
+			best, factor = kind, f
+		}
+	}
+	return best, factor
+}
+
+// foodRaidChance is the chance of a raid tonight before and after the camp's food stores.
+func (s *RunState) foodRaidChance() (float64, float64) {
+	smell := s.campFoodSmell()
+	if smell < 0.2 {
+		return 0, 0
+	}
+	chance := math.Min(raidMaxChance, raidBaseChance+raidChancePerSmell*smell)
+	if s.Fire.Lit {
+		chance *= raidFireFactor
+	}
+	if metrics, ok := s.currentShelterMetrics(); ok {
+		chance *= clampFloat(1-0.04*float64(metrics.Stealth), 0.6, 1.2)
+	}
+	if !s.atCamp() {
+		chance *= raidAwayFactor
+	}
+	_, factor := s.bestFoodCache()
+	return chance, chance * factor
+}
+
+// campRaider picks the scavenger or predator that comes for the stores.
+func (s *RunState) campRaider(roll float64) (encounterSpecies, bool) {
+	x, y, _ := s.campSite()
+	cell, ok := s.TopologyCellAt(x, y)
+	if !ok {
+		return encounterSpecies{}, false
+	}
+	season, ok := s.CurrentSeason()
+	if !ok {
+		season = SeasonAutumn
+	}
+	species := filterEncounterSpeciesForClimate(biomeEncounterList(cell.Biome, "mammal"), "mammal", s.ActiveClimateProfile(), season, s.Weather.TemperatureC, cell.Biome)
+	raiders := []encounterSpecies{}
+	weights := []float64{}
+	total := 0.0
+	for _, sp := range species {
+		if !sp.Predator && !sp.Scavenger {
+			continue
+		}
+		w := float64(sp.Weight)
+		if sp.Scavenger {
+			w += 6
+		}
+		if sp.Nocturnal {
+			w += 3
+		}
+		raiders = append(raiders, sp)
+		weights = append(weights, w)
+		total += w
+	}
+	pick := roll * total
+	for i, w := range weights {
+		if pick < w {
+			return raiders[i], true
+		}
+		pick -= w
+	}
+	return encounterSpecies{}, false
+}
+
+// raidLosses takes the smelliest stores first, up to what the animal can carry off.
+func (s *RunState) raidLosses(appetiteKg float64) []string {
+	items := make([]InventoryItem, 0, len(s.CampInventory))
+	for _, item := range s.CampInventory {
+		if foodSmellPerKg(item) > 0 && item.Qty > 0 {
+			items = append(items, item)
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool { return foodSmellPerKg(items[i]) > foodSmellPerKg(items[j]) })
+	lost := []string{}
+	for _, item := range items {
+		if appetiteKg < 0.05 {
+			break
+		}
+		weight := math.Max(item.WeightKg, 0.01)
+		qty := normalizeInventoryQty(item.Unit, math.Min(item.Qty, appetiteKg/weight))
+		if qty <= 0 {
+			continue
+		}
+		taken, err := s.removeCampInventoryItem(item.ID, qty)
+		if err != nil || taken.Qty <= 0 {
+			continue
+		}
+		appetiteKg -= taken.Qty * weight
+		name := item.Name
+		if name == "" {
+			name = strings.ReplaceAll(item.ID, "_", " ")
+		}
+		lost = append(lost, fmt.Sprintf("%s %s", formatInventoryQty(item.Unit, taken.Qty), strings.ToLower(name)))
+	}
+	return lost
+}
+
+// rollFoodRaid runs overnight: animals drawn by the stores may get into camp and carry food off.
+func (s *RunState) rollFoodRaid() {
+	open, guarded := s.foodRaidChance()
+	if open <= 0 {
+		return
+	}
+	rng := seededRNG(seedFromLabel(s.Config.Seed, fmt.Sprintf("raid:%d", s.Day)))
+	roll := rng.Float64()
+	if roll >= open {
+		return
+	}
+	raider, ok := s.campRaider(rng.Float64())
+	if !ok {
+		return
+	}
+	cache, _ := s.bestFoodCache()
+	if roll >= guarded {
+		s.damageFoodCache(cache, raidCacheDamage/3)
+		s.reports = append(s.reports, fmt.Sprintf("Morning: %s tracks around the %s; something tried the stores overnight but the cache held.", strings.ToLower(raider.Name), strings.ReplaceAll(string(cache), "_", " ")))
+		return
+	}
+
+	danger := predatorDanger(raider.Name)
+	appetite := 1.5 + (danger-0.9)*16
+	if cache != "" {
+		appetite /= 2
+		s.damageFoodCache(cache, raidCacheDamage)
+	}
+	lost := s.raidLosses(appetite)
+	if len(lost) == 0 {
+		return
+	}
+	msg := fmt.Sprintf("Morning: a %s got into camp stores overnight and took %s.", strings.ToLower(raider.Name), strings.Join(lost, ", "))
+	if danger >= 1.3 && s.Shelter.Type != "" && s.Shelter.Durability > 0 {
+		s.Shelter.Durability = clamp(s.Shelter.Durability-raidShelterDamage, 1, 100)
+		msg += " It tore at the shelter on the way."
+	}
+	x, y, _ := s.campSite()
+	if idx, ok := s.topoIndex(x, y); ok && idx < len(s.CellStates) {
+		s.CellStates[idx].Disturbance = uint8(min(255, int(s.CellStates[idx].Disturbance)+10))
+	}
+	for i := range s.Players {
+		if s.Players[i].Active() {
+			s.Players[i].Morale = clamp(s.Players[i].Morale-3, 0, 100)
+		}
+	}
+	s.reports = append(s.reports, msg)
+}
+
+func (s *RunState) damageFoodCache(kind CampStructureKind, amount int) {
+	if kind == "" {
+		return
+	}
+	x, y, _ := s.campSite()
+	for i := range s.Camp.Structures {
+		structure := &s.Camp.Structures[i]
+		if structure.Kind == kind && structure.X == x && structure.Y == y && structure.Durability > 0 {
+			structure.Durability = clamp(structure.Durability-amount, 1, 100)
+			return
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+func stockCampFood(run *RunState, id string, kg float64) {
+	run.CampInventory = addOrMergeInventory(run.CampInventory, InventoryItem{ID: id, Name: strings.ReplaceAll(id, "_", " "), Unit: "kg", Qty: kg, WeightKg: 1, Category: "food"})
+}
+
+func TestRawMeatDrawsRaidsMoreThanSmokedMeat(t *testing.T) {
+	raw := newRunForCommands(t)
+	stockCampFood(&raw, "raw_fish_meat", 2)
+	smoked := newRunForCommands(t)
+	stockCampFood(&smoked, "smoked_fish_meat", 2)
+
+	rawChance, _ := raw.foodRaidChance()
+	smokedChance, _ := smoked.foodRaidChance()
+	if rawChance <= smokedChance || smokedChance <= 0 {
+		t.Fatalf("expected raw meat to smell more than smoked: %.3f vs %.3f", rawChance, smokedChance)
+	}
+
+	raw.Fire = FireState{Lit: true, Intensity: 40, HeatC: 50, FuelKg: 2}
+	if withFire, _ := raw.foodRaidChance(); withFire >= rawChance {
+		t.Fatalf("expected a lit fire to keep raiders off: %.3f vs %.3f", withFire, rawChance)
+	}
+	raw.Fire = FireState{}
+	raw.placeCampStructure("bear_hang", CraftQualityFair)
+	if _, guarded := raw.foodRaidChance(); guarded >= rawChance*0.5 {
+		t.Fatalf("expected the bear hang to cut the raid chance: %.3f vs %.3f", guarded, rawChance)
+	}
+	raw.CraftedItems = append(raw.CraftedItems, "sealed_container")
+	if sealed, _ := raw.foodRaidChance(); sealed >= rawChance {
+		t.Fatalf("expected a sealed container to hold the smell in")
+	}
+}
+
+func TestFoodRaidsTakeTheSmelliestFoodAndReportIt(t *testing.T) {
+	raided := false
+	for seed := int64(1); seed <= 60 && !raided; seed++ {
+		run := newRunForCommands(t)
+		run.Config.Seed = seed
+		stockCampFood(&run, "raw_small_game_meat", 6)
+		stockCampFood(&run, "dried_fish_meat", 2)
+		run.AdvanceDay()
+		reports := strings.Join(run.DrainReports(), " ")
+		if !strings.Contains(reports, "got into camp stores") {
+			continue
+		}
+		raided = true
+		if !strings.Contains(reports, "raw small game meat") || !strings.HasPrefix(reports[strings.Index(reports, "Morning:"):], "Morning: a ") {
+			t.Fatalf("expected the raw meat to go first in a morning report, got %q", reports)
+		}
+		if inventoryTotalQtyByID(run.CampInventory, "raw_small_game_meat") >= 6 {
+			t.Fatalf("expected raw meat to be lost: %+v", run.CampInventory)
+		}
+		if inventoryTotalQtyByID(run.CampInventory, "dried_fish_meat") != 2 {
+			t.Fatalf("expected the dried fish to be left while raw meat remains: %+v", run.CampInventory)
+		}
+	}
+	if !raided {
+		t.Fatalf("expected a raid on well-stocked, unguarded stores within 60 seeds")
+	}
+}
@@ -252,6 +252,13 @@ func (p *PlayerState) applyAilment(next Ailment) {
 		p.Ailments[i].EnergyPenalty = maxInt(p.Ailments[i].EnergyPenalty, next.EnergyPenalty)
 		p.Ailments[i].HydrationPenalty = maxInt(p.Ailments[i].HydrationPenalty, next.HydrationPenalty)
 		p.Ailments[i].MoralePenalty = maxInt(p.Ailments[i].MoralePenalty, next.MoralePenalty)
+		p.Ailments[i].Stage = maxInt(p.Ailments[i].Stage, next.Stage)
+		p.Ailments[i].Infection = maxInt(p.Ailments[i].Infection, next.Infection)
+		if next.Wound {
+			// A fresh wound on top of an old one needs cleaning again.
+			p.Ailments[i].Wound = true
+			p.Ailments[i].Cleaned = false
+		}
 		if p.Ailments[i].Name == "" {
 			p.Ailments[i].Name = next.Name
 		}
@@ -0,0 +1,372 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Discovery summary:
+// - Kit stays a []KitItem and CraftedItems a []string; wear lives beside them in RunState.Gear, keyed per owner.
+// - Gear with no entry is in new condition, so older saves and untouched items cost nothing.
+// - Personal kit is keyed by its owner, team-issued kit and crafted camp gear by player 0.
+// - Each craft of an item is its own instance with its own condition; the best working copy is the one in use.
+// - Wear comes from the actions that work the gear hard, is worse in the wet, and is reported through the run's report queue.
+// - The multi-tool fixes hard gear, the sewing kit fabric and cordage, and duct tape patches anything a little.
+const (
+	gearWornThreshold   = 40.0
+	gearRepairMultiTool = 35.0
+	gearRepairSewing    = 35.0
+	gearRepairDuctTape  = 20.0
+)
+
+// GearCondition is the wear on one piece of kit or crafted gear, from 100 (new) to 0 (broken).
+type GearCondition struct {
+	PlayerID  int          `json:"player_id,omitempty"`
+	Kit       KitItem      `json:"kit,omitempty"`
+	CraftedID string       `json:"crafted_id,omitempty"`
+	Instance  int          `json:"instance,omitempty"` // which copy of a crafted item, in the order they were made
+	Quality   CraftQuality `json:"quality,omitempty"`
+	Condition float64      `json:"condition"`
+}
+
+func (g GearCondition) label() string {
+	if g.Kit != "" {
+		return string(g.Kit)
+	}
+	name := g.CraftedID
+	if spec, ok := craftableByID(g.CraftedID); ok {
+		name = spec.Name
+	}
+	if g.Instance > 0 {
+		name += fmt.Sprintf(" #%d", g.Instance+1)
+	}
+	return name
+}
+
+// Broken reports whether the gear has stopped doing its job until it is repaired.
+func (g GearCondition) Broken() bool {
+	return g.Condition <= 0
+}
+
+type repairKind int
+
+const (
+	repairHard repairKind = iota
+	repairFabric
+	repairAny
+)
+
+var fabricKit = map[KitItem]bool{
+	KitParacord50ft: true,
+	KitGillNet:      true,
+	KitTarp:         true,
+	KitSleepingBag:  true,
+	KitWoolBlanket:  true,
+	KitThermalLayer: true,
+	KitRainJacket:   true,
+	KitMosquitoNet:  true,
+	KitClimbingRope: true,
+	KitDryBag:       true,
+}
+
+func (g GearCondition) fabric() bool {
+	if g.Kit != "" {
+		return fabricKit[g.Kit]
+	}
+	spec, ok := craftableByID(g.CraftedID)
+	if !ok {
+		return false
+	}
+	return spec.Category == "clothing" || spec.Category == "cordage" || spec.ID == "gill_net"
+}
+
+func craftableByID(id string) (CraftableSpec, bool) {
+	id = strings.ToLower(strings.TrimSpace(id))
+	for _, spec := range CraftableCatalog() {
+		if spec.ID == id {
+			return spec, true
+		}
+	}
+	return CraftableSpec{}, false
+}
+
+// kitOwner says whose instance of a kit item the player is using: their own, or the team's issued one.
+func (s *RunState) kitOwner(player *PlayerState, item KitItem) (int, bool) {
+	if player == nil {
+		return 0, false
+	}
+	if slicesContainsKit(player.Kit, item) {
+		return player.ID, true
+	}
+	if slicesContainsKit(s.Config.IssuedKit, item) {
+		return 0, true
+	}
+	return 0, false
+}
+
+func (s *RunState) findGear(playerID int, kit KitItem, craftedID string) int {
+	for i := range s.Gear {
+		if s.Gear[i].PlayerID == playerID && s.Gear[i].Kit == kit && s.Gear[i].CraftedID == craftedID {
+			return i
+		}
+	}
+	return -1
+}
+
+// craftedInUse is the copy of a crafted item that gets used: the best one still working, else the least broken.
+func (s *RunState) craftedInUse(id string) int {
+	best := -1
+	for i := range s.Gear {
+		gear := s.Gear[i]
+		if gear.PlayerID != 0 || gear.Kit != "" || gear.CraftedID != id {
+			continue
+		}
+		if best < 0 || gear.Condition > s.Gear[best].Condition {
+			best = i
+		}
+	}
+	return best
+}
+
+func (s *RunState) gearEntry(playerID int, kit KitItem, craftedID string) *GearCondition {
+	if idx := s.findGear(playerID, kit, craftedID); idx >= 0 {
+		return &s.Gear[idx]
+	}
+	s.Gear = append(s.Gear, GearCondition{PlayerID: playerID, Kit: kit, CraftedID: craftedID, Condition: 100})
+	return &s.Gear[len(s.Gear)-1]
+}
+
+// KitCondition is the condition of the kit item the player would use; 0 when they don't have one.
+func (s *RunState) KitCondition(player *PlayerState, item KitItem) float64 {
+	owner, ok := s.kitOwner(player, item)
+	if !ok {
+		return 0
+	}
+	if idx := s.findGear(owner, item, ""); idx >= 0 {
+		return s.Gear[idx].Condition
+	}
+	return 100
+}
+
+// CraftedCondition is the condition of a crafted piece of camp gear; 0 when it was never made.
+func (s *RunState) CraftedCondition(id string) float64 {
+	id = strings.ToLower(strings.TrimSpace(id))
+	if !hasCraftedItem(s.CraftedItems, id) {
+		return 0
+	}
+	if idx := s.craftedInUse(id); idx >= 0 {
+		return s.Gear[idx].Condition
+	}
+	return 100
+}
+
+func (s *RunState) kitUsable(player *PlayerState, item KitItem) bool {
+	return s.KitCondition(player, item) > 0
+}
+
+func (s *RunState) craftedUsable(id string) bool {
+	return s.CraftedCondition(id) > 0
+}
+
+// recordCraftedGear adds a freshly made copy of an item with its quality; older copies keep their wear.
+func (s *RunState) recordCraftedGear(id string, quality CraftQuality) {
+	instance := 0
+	for _, gear := range s.Gear {
+		if gear.PlayerID == 0 && gear.Kit == "" && gear.CraftedID == id {
+			instance = max(instance, gear.Instance+1)
+		}
+	}
+	s.Gear = append(s.Gear, GearCondition{CraftedID: id, Instance: instance, Quality: quality, Condition: 100})
+}
+
+// gearWetFactor is how much faster gear wears in the current weather.
+func (s *RunState) gearWetFactor() float64 {
+	switch s.Weather.Type {
+	case WeatherHeavyRain, WeatherStorm:
+		return 2.0
+	case WeatherRain, WeatherSnow, WeatherBlizzard:
+		return 1.5
+	default:
+		return 1.0
+	}
+}
+
+func qualityWearFactor(quality CraftQuality) float64 {
+	switch quality {
+	case CraftQualityPoor:
+		return 1.5
+	case CraftQualityGood:
+		return 0.85
+	case CraftQualityExcellent:
+		return 0.65
+	default:
+		return 1.0
+	}
+}
+
+// wearGear applies wear to one instance and reports when it crosses into worn or breaks.
+func (s *RunState) wearGear(entry *GearCondition, amount float64) {
+	if entry == nil || amount <= 0 || entry.Broken() {
+		return
+	}
+	before := entry.Condition
+	entry.Condition = clampFloat(entry.Condition-amount*s.gearWetFactor()*qualityWearFactor(entry.Quality), 0, 100)
+	owner := ""
+	if entry.PlayerID > 0 {
+		owner = fmt.Sprintf("P%d's ", entry.PlayerID)
+	}
+	switch {
+	case entry.Broken():
+		s.reports = append(s.reports, fmt.Sprintf("%s%s has broken and needs repair.", owner, entry.label()))
+	case before > gearWornThreshold && entry.Condition <= gearWornThreshold:
+		s.reports = append(s.reports, fmt.Sprintf("%s%s is badly worn (%.0f%%).", owner, entry.label(), entry.Condition))
+	}
+}
+
+func (s *RunState) wearKit(player *PlayerState, item KitItem, amount float64) {
+	owner, ok := s.kitOwner(player, item)
+	if !ok {
+		return
+	}
+	s.wearGear(s.gearEntry(owner, item, ""), amount)
+}
+
+func (s *RunState) wearCrafted(id string, amount float64) {
+	if !hasCraftedItem(s.CraftedItems, id) {
+		return
+	}
+	if idx := s.craftedInUse(id); idx >= 0 {
+		s.wearGear(&s.Gear[idx], amount)
+		return
+	}
+	s.wearGear(s.gearEntry(0, "", id), amount)
+}
+
+// firstUsableKit picks the first working item from a preference list.
+func (s *RunState) firstUsableKit(player *PlayerState, items ...KitItem) (KitItem, bool) {
+	for _, item := range items {
+		if s.kitUsable(player, item) {
+			return item, true
+		}
+	}
+	return "", false
+}
+
+// wearCuttingTool wears whichever blade does the work.
+func (s *RunState) wearCuttingTool(player *PlayerState, amount float64, preference ...KitItem) {
+	if item, ok := s.firstUsableKit(player, preference...); ok {
+		s.wearKit(player, item, amount)
+		return
+	}
+	for _, id := range []string{"stone_adze", "wedge_set"} {
+		if s.craftedUsable(id) {
+			s.wearCrafted(id, amount)
+			return
+		}
+	}
+}
+
+// wearHuntingGear wears the weapon or tackle used for a hunt.
+func (s *RunState) wearHuntingGear(player *PlayerState, domain AnimalDomain) {
+	kit := []KitItem{KitBowArrows, KitSpear}
+	crafted := []string{"long_bow", "short_bow", "fire_hardened_spear", "atlatl"}
+	if domain == AnimalDomainWater {
+		kit = []KitItem{KitFishingLineHooks, KitSpear, KitGillNet}
+		crafted = []string{"trotline_set", "gill_net", "fire_hardened_spear"}
+	}
+	if item, ok := s.firstUsableKit(player, kit...); ok {
+		s.wearKit(player, item, 4)
+		return
+	}
+	for _, id := range crafted {
+		if s.craftedUsable(id) {
+			s.wearCrafted(id, 5)
+			return
+		}
+	}
+}
+
+// applyWeatherGearWear soaks the gear that is out in the weather each day; a dry bag protects the rest.
+func (s *RunState) applyWeatherGearWear() {
+	if !isRainyWeather(s.Weather.Type) && s.Weather.Type != WeatherBlizzard {
+		return
+	}
+	for i := range s.Players {
+		player := &s.Players[i]
+		if !player.Active() {
+			continue
+		}
+		for _, item := range []KitItem{KitRainJacket, KitTarp} {
+			if s.kitUsable(player, item) {
+				s.wearKit(player, item, 1.5)
+			}
+		}
+	}
+	for i := range s.Gear {
+		if s.Gear[i].Kit == "" || s.Gear[i].fabric() || s.Gear[i].Condition >= 100 {
+			continue
+		}
+		player, ok := s.playerByID(s.Gear[i].PlayerID)
+		if ok && s.kitUsable(player, KitDryBag) {
+			continue
+		}
+		// Already-worn metal rusts at the damaged edges.
+		s.wearGear(&s.Gear[i], 0.5)
+	}
+}
+
+// repairGear restores the most worn piece of gear the repair kit can work on; a kit can't repair itself.
+func (s *RunState) repairGear(player *PlayerState, tool KitItem, kind repairKind, amount float64) string {
+	candidates := make([]int, 0, len(s.Gear))
+	for i, gear := range s.Gear {
+		if gear.Condition >= 100 || (tool != "" && gear.Kit == tool) {
+			continue
+		}
+		if gear.PlayerID != 0 && gear.PlayerID != player.ID {
+			continue
+		}
+		if gear.Kit != "" {
+			if owner, ok := s.kitOwner(player, gear.Kit); !ok || owner != gear.PlayerID {
+				continue
+			}
+		} else if !hasCraftedItem(s.CraftedItems, gear.CraftedID) {
+			continue
+		}
+		switch kind {
+		case repairHard:
+			if gear.fabric() {
+				continue
+			}
+		case repairFabric:
+			if !gear.fabric() {
+				continue
+			}
+		}
+		candidates = append(candidates, i)
+	}
+	if len(candidates) == 0 {
+		return "nothing needs repair"
+	}
+	sort.SliceStable(candidates, func(a, b int) bool {
+		return s.Gear[candidates[a]].Condition < s.Gear[candidates[b]].Condition
+	})
+	gear := &s.Gear[candidates[0]]
+	restored := amount + float64(player.Crafting)/10
+	before := gear.Condition
+	gear.Condition = clampFloat(gear.Condition+restored, 0, 100)
+	s.trainSkill(player, SkillCrafting, 8, true)
+	return fmt.Sprintf("repaired %s %.0f%% -> %.0f%%", gear.label(), before, gear.Condition)
+}
+
+// GearConditionLabel annotates an item in listings once it has taken wear.
+func GearConditionLabel(condition float64) string {
+	switch {
+	case condition <= 0:
+		return " (broken)"
+	case condition < 100:
+		return fmt.Sprintf(" (%.0f%%)", condition)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,121 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWoodGatheringWearsTheHatchetFasterInTheWet(t *testing.T) {
+	dry := newRunForCommands(t)
+	dry.Players[0].Kit = append(dry.Players[0].Kit, KitHatchet)
+	dry.Weather.Type = WeatherClear
+	wet := newRunForCommands(t)
+	wet.Players[0].Kit = append(wet.Players[0].Kit, KitHatchet)
+	wet.Weather.Type = WeatherHeavyRain
+
+	for _, run := range []*RunState{&dry, &wet} {
+		if _, _, err := run.GatherWood(1, 4); err != nil {
+			t.Fatalf("gather wood: %v", err)
+		}
+	}
+	dryCondition := dry.KitCondition(&dry.Players[0], KitHatchet)
+	wetCondition := wet.KitCondition(&wet.Players[0], KitHatchet)
+	if dryCondition >= 100 || wetCondition >= dryCondition {
+		t.Fatalf("expected wear, worse in the wet: dry %.1f wet %.1f", dryCondition, wetCondition)
+	}
+}
+
+func TestBrokenKitStopsEnablingActions(t *testing.T) {
+	run := newRunForCommands(t)
+	player := &run.Players[0]
+	run.gearEntry(player.ID, KitParacord50ft, "").Condition = 0
+
+	if res := run.ExecuteRunCommand("use paracord tie sticks"); !strings.Contains(res.Message, "broken") {
+		t.Fatalf("expected broken paracord to be refused, got %q", res.Message)
+	}
+	if res := run.ExecuteRunCommand("actions"); !strings.Contains(res.Message, "broken") {
+		t.Fatalf("expected actions to flag broken paracord, got %q", res.Message)
+	}
+
+	// Issued kit is the team's instance, separate from anything personal.
+	run.wearKit(player, KitTarp, 30)
+	if idx := run.findGear(0, KitTarp, ""); idx < 0 || run.Gear[idx].Condition >= 100 {
+		t.Fatalf("expected the issued tarp to be tracked as team gear: %+v", run.Gear)
+	}
+}
+
+func TestRepairKitsRestoreTheirKindOfGear(t *testing.T) {
+	run := newRunForCommands(t)
+	player := &run.Players[0]
+	player.Kit = append(player.Kit, KitHatchet, KitMultiTool, KitSewingKit)
+	run.gearEntry(player.ID, KitHatchet, "").Condition = 20
+	run.gearEntry(player.ID, KitParacord50ft, "").Condition = 10
+
+	res := run.ExecuteRunCommand("use multitool repair_gear")
+	if !strings.Contains(res.Message, "repaired Hatchet") || run.KitCondition(player, KitHatchet) <= 20 {
+		t.Fatalf("expected the multi-tool to repair the hatchet, got %q", res.Message)
+	}
+	if run.KitCondition(player, KitParacord50ft) != 10 {
+		t.Fatalf("expected the multi-tool to leave fabric alone")
+	}
+	res = run.ExecuteRunCommand("use sewing kit mend")
+	if !strings.Contains(res.Message, "repaired Paracord") || run.KitCondition(player, KitParacord50ft) <= 10 {
+		t.Fatalf("expected the sewing kit to mend the paracord, got %q", res.Message)
+	}
+	if run.KitCondition(player, KitMultiTool) >= 100 {
+		t.Fatalf("expected repairs to wear the multi-tool")
+	}
+}
+
+func TestCraftedGearKeepsQualityAndBreaks(t *testing.T) {
+	run := newRunForCommands(t)
+	run.CraftedItems = append(run.CraftedItems, "natural_twine", "fish_trap")
+	run.recordCraftedGear("natural_twine", CraftQualityPoor)
+	run.recordCraftedGear("fish_trap", CraftQualityExcellent)
+
+	run.wearCrafted("natural_twine", 10)
+	run.wearCrafted("fish_trap", 10)
+	if run.CraftedCondition("natural_twine") >= run.CraftedCondition("fish_trap") {
+		t.Fatalf("expected poor work to wear faster: %.1f vs %.1f", run.CraftedCondition("natural_twine"), run.CraftedCondition("fish_trap"))
+	}
+
+	run.Players[0].Bushcraft = 3
+	run.wearCrafted("natural_twine", 200)
+	if !strings.Contains(strings.Join(run.DrainReports(), " "), "Natural Twine has broken") {
+		t.Fatalf("expected a report when the twine breaks")
+	}
+	if _, err := run.SetTrap(1, "bird_noose_perch"); err == nil || !strings.Contains(err.Error(), "broken") {
+		t.Fatalf("expected a broken crafted requirement to block the trap, got %v", err)
+	}
+}
+
+func TestEachCraftedCopyKeepsItsOwnCondition(t *testing.T) {
+	run := newRunForCommands(t)
+	run.CraftedItems = append(run.CraftedItems, "fish_trap")
+	run.recordCraftedGear("fish_trap", CraftQualityFair)
+	run.wearCrafted("fish_trap", 70)
+	worn := run.CraftedCondition("fish_trap")
+
+	run.recordCraftedGear("fish_trap", CraftQualityFair)
+	if run.CraftedCondition("fish_trap") != 100 {
+		t.Fatalf("expected the new copy to be the one in use, got %.1f", run.CraftedCondition("fish_trap"))
+	}
+	run.wearCrafted("fish_trap", 10)
+	conditions := []float64{}
+	for _, gear := range run.Gear {
+		if gear.CraftedID == "fish_trap" {
+			conditions = append(conditions, gear.Condition)
+		}
+	}
+	if len(conditions) != 2 || conditions[0] != worn || conditions[1] >= 100 {
+		t.Fatalf("expected two copies, the old one still worn to %.1f, got %v", worn, conditions)
+	}
+
+	player := &run.Players[0]
+	player.Kit = append(player.Kit, KitMultiTool)
+	run.gearEntry(player.ID, KitMultiTool, "").Condition = 5
+	res := run.ExecuteRunCommand("use multitool repair_gear")
+	if !strings.Contains(res.Message, "repaired Fish Trap") || run.KitCondition(player, KitMultiTool) >= 5 {
+		t.Fatalf("expected the multi-tool to fix the worn trap, not itself, got %q", res.Message)
+	}
+}
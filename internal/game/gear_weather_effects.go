@@ -30,9 +30,9 @@ func (s *RunState) applyCraftedWeatherModifiersForPlayer(impact statDelta, playe
 	hasWovenTunic := hasPersonalItem(player, "woven_tunic")
 	hasMoccasins := hasPersonalItem(player, "hide_moccasins") || hasPersonalItem(player, "bast_sandals")
 
-	hasThermal := hasAnyKitItem(player, s.Config.IssuedKit, KitThermalLayer)
-	hasRainJacket := hasAnyKitItem(player, s.Config.IssuedKit, KitRainJacket)
-	hasWool := hasAnyKitItem(player, s.Config.IssuedKit, KitWoolBlanket)
+	hasThermal := s.kitUsable(&player, KitThermalLayer)
+	hasRainJacket := s.kitUsable(&player, KitRainJacket)
+	hasWool := s.kitUsable(&player, KitWoolBlanket)
 
 	if tempC <= 2 {
 		if hasHideJacket {
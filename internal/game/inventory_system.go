@@ -27,22 +27,32 @@ func normalizeInventoryQty(unit string, qty float64) float64 {
 	if qty <= 0 {
 		return 0
 	}
-	if strings.EqualFold(strings.TrimSpace(unit), "kg") {
+	if isFractionalUnit(unit) {
 		return math.Round(qty*10) / 10
 	}
 	return math.Round(qty)
 }
 
 func formatInventoryQty(unit string, qty float64) string {
-	if strings.EqualFold(strings.TrimSpace(unit), "kg") {
+	if isFractionalUnit(unit) {
 		return fmt.Sprintf("%.1f%s", qty, unit)
 	}
 	return fmt.Sprintf("%.0f%s", qty, unit)
 }
 
+// Weight and volume stacks keep one decimal; counted units round to whole items.
+func isFractionalUnit(unit string) bool {
+	switch strings.ToLower(strings.TrimSpace(unit)) {
+	case "kg", "l":
+		return true
+	default:
+		return false
+	}
+}
+
 func defaultUnitWeightKg(unit string) float64 {
 	switch strings.ToLower(strings.TrimSpace(unit)) {
-	case "kg":
+	case "kg", "l":
 		return 1.0
 	case "bundle":
 		return 0.35
@@ -74,7 +84,7 @@ func (s *RunState) campCapacityKg() float64 {
 		return 0
 	}
 	// Minimal ground cache before shelter.
-	capKg := 8.0
+	capKg := groundCacheKg
 	if s.Shelter.Type != "" && s.Shelter.Durability > 0 {
 		if metrics, ok := s.currentShelterMetrics(); ok && metrics.StorageCapacityKg > 0 {
 			capKg = metrics.StorageCapacityKg
@@ -82,6 +92,7 @@ func (s *RunState) campCapacityKg() float64 {
 			capKg = shelter.StorageCapacityKg
 		}
 	}
+	capKg += s.campStorageKg()
 	if slices.Contains(s.CraftedItems, "split_basket") {
 		capKg += 6
 	}
@@ -387,6 +398,11 @@ func (s *RunState) removeCampInventoryItem(itemID string, qty float64) (Inventor
 }
 
 func (s *RunState) StashPersonalItem(playerID int, itemID string, qty float64) (InventoryItem, error) {
+	if player, ok := s.playerByID(playerID); ok {
+		if err := s.requireCampStores(player); err != nil {
+			return InventoryItem{}, err
+		}
+	}
 	item, err := s.removePersonalInventoryItem(playerID, itemID, qty)
 	if err != nil {
 		return InventoryItem{}, err
@@ -399,6 +415,11 @@ func (s *RunState) StashPersonalItem(playerID int, itemID string, qty float64) (
 }
 
 func (s *RunState) TakeCampItem(playerID int, itemID string, qty float64) (InventoryItem, error) {
+	if player, ok := s.playerByID(playerID); ok {
+		if err := s.requireCampStores(player); err != nil {
+			return InventoryItem{}, err
+		}
+	}
 	item, err := s.removeCampInventoryItem(itemID, qty)
 	if err != nil {
 		return InventoryItem{}, err
@@ -473,10 +494,14 @@ func (s *RunState) AdvanceActionClock(hours float64) int {
 }
 
 func (s *RunState) AdvanceMinutes(minutes int) int {
-	if s == nil || minutes <= 0 {
+	if s == nil || minutes <= 0 || s.clockHeld {
 		return 0
 	}
 	s.EnsurePlayerRuntimeStats()
+	s.expireEncounter()
+	s.advancingClock = true
+	defer func() { s.advancingClock = false }()
+	_, wasPassing := s.ActivePatrol()
 	daysAdvanced := 0
 	remaining := minutes
 	for remaining > 0 {
@@ -488,15 +513,31 @@ func (s *RunState) AdvanceMinutes(minutes int) int {
 		if stepMinutes > minUntilMidnight {
 			stepMinutes = minUntilMidnight
 		}
+		// Step an hour at a time so the hourly weather applies to the time it covers.
+		if untilHour := 60 - int(math.Round(s.ClockHours*60))%60; stepMinutes > untilHour {
+			stepMinutes = untilHour
+		}
+		s.syncWeatherHour()
 
 		fraction := float64(stepMinutes) / 1440.0
 		for i := range s.Players {
+			if !s.Players[i].Active() {
+				continue
+			}
+			s.recordKcalBurned(&s.Players[i], fraction)
 			applyMetabolismFraction(&s.Players[i], fraction)
 			applyPhysiologyFraction(&s.Players[i], fraction)
+			s.applyThermalHours(&s.Players[i], float64(stepMinutes)/60.0)
+		}
+		if s.Fire.Lit {
+			s.logRunEvent(0, EventFireHours, float64(stepMinutes)/60.0, "")
 		}
 		s.MetabolismProgress = clampFloat(s.MetabolismProgress+fraction, 0, 1)
 		s.ClockHours += float64(stepMinutes) / 60.0
 		remaining -= stepMinutes
+		s.progressDelegatedTasks(float64(stepMinutes) / 60.0)
+		s.updatePlayerOutcomes(float64(stepMinutes) / 60.0)
+		s.refreshSight()
 
 		for s.ClockHours >= 24.0 {
 			s.ClockHours -= 24.0
@@ -504,5 +545,6 @@ func (s *RunState) AdvanceMinutes(minutes int) int {
 			daysAdvanced++
 		}
 	}
+	s.announcePatrol(wasPassing)
 	return daysAdvanced
 }
@@ -0,0 +1,241 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"time"
+)
+
+// Discovery summary:
+// - Weather, encounters, traps and food rolls already derive from Config.Seed plus run counters, so the same
+//   inputs rebuild the same run; the inputs are commands, day advances, realtime metabolism and contestant ticks.
+// - A journal records the resolved RunConfig and each of those inputs with a state hash taken after it.
+// - ReplayJournal re-applies the entries to a fresh NewRunState and reports the first step whose hash differs.
+
+const JournalFormatVersion = 1
+
+// metabolismJournalSteps quantizes realtime metabolism to game minutes so a GUI run journals one entry per
+// minute rather than one per frame.
+const metabolismJournalSteps = 1440
+
+type JournalEntryKind string
+
+const (
+	JournalCommand     JournalEntryKind = "command"
+	JournalAdvanceDay  JournalEntryKind = "advance_day"
+	JournalMetabolism  JournalEntryKind = "metabolism"
+	JournalContestants JournalEntryKind = "contestants"
+)
+
+type JournalEntry struct {
+	Step     int              `json:"step"`
+	Kind     JournalEntryKind `json:"kind"`
+	Command  string           `json:"command,omitempty"`
+	Progress float64          `json:"progress,omitempty"`
+	Delta    time.Duration    `json:"delta,omitempty"`
+	Day      int              `json:"day"`
+	Clock    float64          `json:"clock"`
+	Hash     string           `json:"hash"`
+}
+
+type RunJournal struct {
+	FormatVersion int              `json:"format_version"`
+	RecordedAt    time.Time        `json:"recorded_at"`
+	Config        RunConfig        `json:"config"`
+	Scenario      Scenario         `json:"scenario"`
+	InitialHash   string           `json:"initial_hash"`
+	ContentPacks  []ContentPackRef `json:"content_packs,omitempty"`
+	Entries       []JournalEntry   `json:"entries"`
+}
+
+type JournalDivergence struct {
+	Step     int              `json:"step"`
+	Kind     JournalEntryKind `json:"kind"`
+	Command  string           `json:"command,omitempty"`
+	Expected string           `json:"expected"`
+	Got      string           `json:"got"`
+}
+
+type ReplayReport struct {
+	StepsApplied int                `json:"steps_applied"`
+	FinalHash    string             `json:"final_hash"`
+	Divergence   *JournalDivergence `json:"divergence,omitempty"`
+}
+
+// NewRunJournal starts recording from a freshly created run. The run must not have been advanced yet.
+func NewRunJournal(s *RunState) *RunJournal {
+	if s == nil {
+		return nil
+	}
+	return &RunJournal{
+		FormatVersion: JournalFormatVersion,
+		RecordedAt:    time.Now().UTC(),
+		Config:        s.Config,
+		Scenario:      s.Scenario,
+		InitialHash:   StateHash(s),
+		ContentPacks:  ActiveContentPackRefs(),
+	}
+}
+
+// LastHash is the hash of the state after the most recent entry, used to check a save still matches its journal.
+func (j *RunJournal) LastHash() string {
+	if j == nil {
+		return ""
+	}
+	if len(j.Entries) == 0 {
+		return j.InitialHash
+	}
+	return j.Entries[len(j.Entries)-1].Hash
+}
+
+func (j *RunJournal) record(s *RunState, entry JournalEntry) {
+	if j == nil {
+		return
+	}
+	entry.Step = len(j.Entries) + 1
+	entry.Day = s.Day
+	entry.Clock = s.ClockHours
+	entry.Hash = StateHash(s)
+	j.Entries = append(j.Entries, entry)
+}
+
+// The methods below perform the action on s and journal it. A nil journal just performs the action.
+
+func (j *RunJournal) ExecuteRunCommand(s *RunState, raw string) RunCommandResult {
+	res := s.ExecuteRunCommand(raw)
+	if res.Handled {
+		j.record(s, JournalEntry{Kind: JournalCommand, Command: raw})
+	}
+	return res
+}
+
+func (j *RunJournal) AdvanceDay(s *RunState) {
+	s.AdvanceDay()
+	j.record(s, JournalEntry{Kind: JournalAdvanceDay})
+}
+
+func (j *RunJournal) ApplyRealtimeMetabolism(s *RunState, elapsed time.Duration, dayDuration time.Duration) {
+	if s == nil || dayDuration <= 0 {
+		return
+	}
+	target := clampFloat(float64(elapsed)/float64(dayDuration), 0, 1)
+	if j != nil {
+		target = float64(int(target*metabolismJournalSteps)) / metabolismJournalSteps
+	}
+	if target <= s.MetabolismProgress {
+		return
+	}
+	s.ApplyMetabolismProgress(target)
+	j.record(s, JournalEntry{Kind: JournalMetabolism, Progress: target})
+}
+
+func (j *RunJournal) ProcessContestantSimulation(s *RunState, delta time.Duration) []string {
+	if s.Config.Mode != ModeAlone || len(s.Contestants) == 0 {
+		return nil
+	}
+	messages := s.ProcessContestantSimulation(delta)
+	j.record(s, JournalEntry{Kind: JournalContestants, Delta: delta})
+	return messages
+}
+
+func applyJournalEntry(s *RunState, entry JournalEntry) error {
+	switch entry.Kind {
+	case JournalCommand:
+		if res := s.ExecuteRunCommand(entry.Command); !res.Handled {
+			return fmt.Errorf("step %d: command not handled: %s", entry.Step, entry.Command)
+		}
+	case JournalAdvanceDay:
+		s.AdvanceDay()
+	case JournalMetabolism:
+		s.ApplyMetabolismProgress(entry.Progress)
+	case JournalContestants:
+		_ = s.ProcessContestantSimulation(entry.Delta)
+	default:
+		return fmt.Errorf("step %d: unknown journal entry kind: %s", entry.Step, entry.Kind)
+	}
+	return nil
+}
+
+// ReplayJournal rebuilds the run from the journal's config and entries. It stops at the first step whose
+// state hash differs from the recorded one and returns the state as it stood at that point.
+func ReplayJournal(j RunJournal) (RunState, ReplayReport, error) {
+	if j.FormatVersion > JournalFormatVersion {
+		return RunState{}, ReplayReport{}, fmt.Errorf("journal format %d is newer than supported %d", j.FormatVersion, JournalFormatVersion)
+	}
+	// A custom scenario that isn't loaded here replays from the copy the journal carries.
+	scenarios := AllScenarios()
+	if _, ok := GetScenario(scenarios, j.Config.ScenarioID); !ok && j.Scenario.ID == j.Config.ScenarioID {
+		scenarios = append(scenarios, j.Scenario)
+	}
+	state, err := newRunStateFrom(j.Config, scenarios)
+	if err != nil {
+		return RunState{}, ReplayReport{}, fmt.Errorf("rebuild run: %w", err)
+	}
+
+	report := ReplayReport{}
+	if got := StateHash(&state); j.InitialHash != "" && got != j.InitialHash {
+		report.Divergence = &JournalDivergence{Step: 0, Expected: j.InitialHash, Got: got}
+		report.FinalHash = got
+		return state, report, nil
+	}
+	for _, entry := range j.Entries {
+		if err := applyJournalEntry(&state, entry); err != nil {
+			report.FinalHash = StateHash(&state)
+			return state, report, err
+		}
+		report.StepsApplied++
+		got := StateHash(&state)
+		if got != entry.Hash {
+			report.Divergence = &JournalDivergence{
+				Step:     entry.Step,
+				Kind:     entry.Kind,
+				Command:  entry.Command,
+				Expected: entry.Hash,
+				Got:      got,
+			}
+			report.FinalHash = got
+			return state, report, nil
+		}
+	}
+	report.FinalHash = StateHash(&state)
+	return state, report, nil
+}
+
+// StateHash fingerprints the run as saved, which includes the players' metabolism carries.
+func StateHash(s *RunState) string {
+	if s == nil {
+		return ""
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return ""
+	}
+	h := fnv.New64a()
+	_, _ = h.Write(data)
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+func SaveJournal(path string, j *RunJournal) error {
+	if j == nil {
+		return fmt.Errorf("journal is nil")
+	}
+	data, err := json.MarshalIndent(j, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+func LoadJournal(path string) (RunJournal, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return RunJournal{}, err
+	}
+	var j RunJournal
+	if err := json.Unmarshal(data, &j); err != nil {
+		return RunJournal{}, fmt.Errorf("parse journal: %w", err)
+	}
+	return j, nil
+}
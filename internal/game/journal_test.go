@@ -0,0 +1,139 @@
+package game
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func recordJournalRun(t *testing.T) (RunState, *RunJournal) {
+	t.Helper()
+
+	run, err := NewRunState(RunConfig{
+		Mode:        ModeAlone,
+		ScenarioID:  ScenarioVancouverIslandID,
+		PlayerCount: 1,
+		RunLength:   RunLength{Days: 30},
+		Seed:        9090,
+	})
+	if err != nil {
+		t.Fatalf("new run: %v", err)
+	}
+	journal := NewRunJournal(&run)
+	day := 2 * time.Hour
+	for _, command := range []string{"forage any", "wood gather 2", "hunt land", "fire ignite"} {
+		journal.ExecuteRunCommand(&run, command)
+	}
+	journal.ApplyRealtimeMetabolism(&run, 20*time.Minute, day)
+	journal.ApplyRealtimeMetabolism(&run, 50*time.Minute, day)
+	journal.AdvanceDay(&run)
+	journal.ProcessContestantSimulation(&run, day)
+	journal.ExecuteRunCommand(&run, "sleep")
+	journal.ExecuteRunCommand(&run, "not a real command")
+	return run, journal
+}
+
+func TestReplayJournalRebuildsIdenticalState(t *testing.T) {
+	run, journal := recordJournalRun(t)
+	if len(journal.Entries) < 8 {
+		t.Fatalf("expected commands, metabolism, day and contestant entries, got %d", len(journal.Entries))
+	}
+
+	path := filepath.Join(t.TempDir(), "run.journal")
+	if err := SaveJournal(path, journal); err != nil {
+		t.Fatalf("save journal: %v", err)
+	}
+	loaded, err := LoadJournal(path)
+	if err != nil {
+		t.Fatalf("load journal: %v", err)
+	}
+
+	replayed, report, err := ReplayJournal(loaded)
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if report.Divergence != nil {
+		t.Fatalf("unexpected divergence: %+v", *report.Divergence)
+	}
+	if report.StepsApplied != len(journal.Entries) {
+		t.Fatalf("expected %d steps applied, got %d", len(journal.Entries), report.StepsApplied)
+	}
+	if report.FinalHash != StateHash(&run) {
+		t.Fatalf("expected final hash %s, got %s", StateHash(&run), report.FinalHash)
+	}
+	want, _ := json.Marshal(run)
+	got, _ := json.Marshal(replayed)
+	if string(want) != string(got) {
+		t.Fatalf("expected replayed state to match the recorded run")
+	}
+}
+
+func TestReplayJournalReportsFirstDivergentStep(t *testing.T) {
+	_, journal := recordJournalRun(t)
+	tampered := *journal
+	tampered.Entries = append([]JournalEntry(nil), journal.Entries...)
+	tampered.Entries[1].Command = "wood gather 3"
+
+	_, report, err := ReplayJournal(tampered)
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if report.Divergence == nil {
+		t.Fatalf("expected divergence after tampering")
+	}
+	if report.Divergence.Step != 2 || report.Divergence.Command != "wood gather 3" {
+		t.Fatalf("expected divergence at step 2, got %+v", *report.Divergence)
+	}
+}
+
+func TestContestantSimulationIsSeeded(t *testing.T) {
+	newRun := func() RunState {
+		run, err := NewRunState(RunConfig{
+			Mode:        ModeAlone,
+			ScenarioID:  ScenarioVancouverIslandID,
+			PlayerCount: 1,
+			RunLength:   RunLength{Days: 30},
+			Seed:        77,
+		})
+		if err != nil {
+			t.Fatalf("new run: %v", err)
+		}
+		return run
+	}
+	a, b := newRun(), newRun()
+	for i := 0; i < 20; i++ {
+		a.ProcessContestantSimulation(24 * time.Hour)
+		b.ProcessContestantSimulation(24 * time.Hour)
+	}
+	for i := range a.Contestants {
+		if !reflect.DeepEqual(a.Contestants[i], b.Contestants[i]) {
+			t.Fatalf("expected identical contestant %d, got %+v vs %+v", i, a.Contestants[i], b.Contestants[i])
+		}
+	}
+}
+
+func TestReplayJournalUsesItsOwnCustomScenario(t *testing.T) {
+	custom, _ := GetScenario(BuiltInScenarios(), ScenarioVancouverIslandID)
+	custom.ID = "journal_custom_island"
+	custom.Name = "Journal Custom Island"
+	SetExternalScenarios([]Scenario{custom})
+	run, err := NewRunState(RunConfig{Mode: ModeAlone, ScenarioID: custom.ID, PlayerCount: 1, RunLength: RunLength{Days: 10}, Seed: 31})
+	SetExternalScenarios(nil)
+	if err != nil {
+		t.Fatalf("new run: %v", err)
+	}
+	journal := NewRunJournal(&run)
+	journal.ExecuteRunCommand(&run, "forage any")
+
+	for i := 0; i < 2; i++ {
+		replayed, report, err := ReplayJournal(*journal)
+		if err != nil || report.Divergence != nil || replayed.Scenario.ID != custom.ID {
+			t.Fatalf("expected the journal's scenario to replay, got %v %+v %s", err, report.Divergence, replayed.Scenario.ID)
+		}
+	}
+	if got := ExternalScenarios(); len(got) != 0 {
+		t.Fatalf("expected replay to leave the loaded scenarios alone, got %d", len(got))
+	}
+}
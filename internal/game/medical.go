@@ -0,0 +1,546 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Discovery summary:
+// - Ailments were flat daily penalties that ran out on their own; injuries now share the Ailment record and add a stage.
+// - Wounds (cuts, burns, claw wounds) want cleaning; left dirty they turn infected, and infection stalls healing and climbs.
+// - Each injury type names the work it hampers: sprains slow TravelMinutesForStep, cut or burnt hands slow crafting and wood work.
+// - Injuries come from falls while travelling, blade and fire accidents, predators and bitter cold without warmth.
+// - Treatment draws on boiled water, the first-aid kit and medicinal plants growing nearby (PlantSpec.Medicinal and UtilityTags).
+const (
+	treatMinutes       = 20
+	treatHerbMinutes   = 45
+	cleanWaterLitres   = 0.5
+	firstAidKitWear    = 15
+	maxAilmentStage    = 3
+	maxInfectionLevel  = 3
+	toolAccidentChance = 0.03
+	fireAccidentChance = 0.02
+	fallChancePerRough = 0.0008
+)
+
+type ailmentActivity int
+
+const (
+	activityTravel ailmentActivity = iota
+	activityHands
+)
+
+// injurySpec is the per-stage cost of an injury and the work it gets in the way of.
+type injurySpec struct {
+	Name      string
+	Days      int
+	Energy    int
+	Hydration int
+	Morale    int
+	Wound     bool
+	Travel    bool
+	Hands     bool
+}
+
+var injurySpecs = map[AilmentType]injurySpec{
+	AilmentSprain:         {Name: "Sprained ankle", Days: 3, Energy: 2, Morale: 2, Travel: true},
+	AilmentCut:            {Name: "Cut", Days: 3, Energy: 1, Hydration: 1, Morale: 1, Wound: true, Hands: true},
+	AilmentBurn:           {Name: "Burn", Days: 4, Energy: 1, Hydration: 2, Morale: 2, Wound: true, Hands: true},
+	AilmentFrostbite:      {Name: "Frostbite", Days: 4, Energy: 2, Morale: 2, Travel: true, Hands: true},
+	AilmentHypothermia:    {Name: "Hypothermia", Days: 1, Energy: 5, Hydration: 1, Morale: 3, Travel: true, Hands: true},
+	AilmentHeatExhaustion: {Name: "Heat exhaustion", Days: 1, Energy: 4, Hydration: 4, Morale: 2, Travel: true},
+	AilmentInjury:         {Name: "Claw wounds", Days: 3, Energy: 3, Hydration: 1, Morale: 3, Wound: true, Travel: true, Hands: true},
+}
+
+var (
+	stageWords     = []string{"", "mild", "moderate", "severe"}
+	infectionWords = []string{"", "infected", "spreading infection", "septic"}
+)
+
+func newInjury(kind AilmentType, stage int) Ailment {
+	spec := injurySpecs[kind]
+	ailment := Ailment{Type: kind, Name: spec.Name, Wound: spec.Wound}
+	stage = clamp(stage, 1, maxAilmentStage)
+	ailment.Stage = stage
+	ailment.DaysRemaining = spec.Days * stage
+	ailment.EnergyPenalty = spec.Energy * stage
+	ailment.HydrationPenalty = spec.Hydration * stage
+	ailment.MoralePenalty = spec.Morale * stage
+	return ailment
+}
+
+func ailmentStage(a Ailment) int {
+	return clamp(a.Stage, 1, maxAilmentStage)
+}
+
+// lowerStage steps an injury down a stage; a mild one is left to finish healing within the day.
+func (a *Ailment) lowerStage() {
+	spec, ok := injurySpecs[a.Type]
+	if !ok || a.Stage <= 1 {
+		a.DaysRemaining = min(a.DaysRemaining, 1)
+		return
+	}
+	a.Stage--
+	a.DaysRemaining = min(a.DaysRemaining, spec.Days*a.Stage)
+	a.EnergyPenalty = spec.Energy * a.Stage
+	a.HydrationPenalty = spec.Hydration * a.Stage
+	a.MoralePenalty = spec.Morale * a.Stage
+}
+
+// Label names the ailment with its stage and the state of the wound.
+func (a Ailment) Label() string {
+	name := a.Name
+	if name == "" {
+		name = string(a.Type)
+	}
+	notes := make([]string, 0, 2)
+	if a.Stage > 0 {
+		notes = append(notes, stageWords[ailmentStage(a)])
+	}
+	switch {
+	case a.Infection > 0:
+		notes = append(notes, infectionWords[clamp(a.Infection, 1, maxInfectionLevel)])
+	case a.Wound && !a.Cleaned:
+		notes = append(notes, "dirty")
+	}
+	if len(notes) == 0 {
+		return name
+	}
+	return fmt.Sprintf("%s (%s)", name, strings.Join(notes, ", "))
+}
+
+// ailmentLoad is how hard an ailment is on the body each day, infection included.
+func ailmentLoad(a Ailment) int {
+	return a.EnergyPenalty + a.HydrationPenalty + a.MoralePenalty + a.Infection*4
+}
+
+// progressInfection lets a dirty wound turn infected and an infection climb. It returns a note when it gets worse.
+func progressInfection(a *Ailment) string {
+	if !a.Wound || a.Infection >= maxInfectionLevel || (a.Infection == 0 && a.Cleaned) {
+		return ""
+	}
+	a.DaysDirty++
+	threshold := 4 - ailmentStage(*a)
+	if a.Infection > 0 {
+		threshold = 2
+	}
+	if a.DaysDirty < threshold {
+		return ""
+	}
+	a.DaysDirty = 0
+	a.Infection++
+	name := a.Name
+	if name == "" {
+		name = string(a.Type)
+	}
+	return fmt.Sprintf("%s is now %s", strings.ToLower(name), infectionWords[a.Infection])
+}
+
+// ailmentSlowdown is the extra time injuries add to a kind of work, as a fraction.
+func ailmentSlowdown(p PlayerState, activity ailmentActivity) float64 {
+	total := 0.0
+	for _, a := range p.Ailments {
+		spec, ok := injurySpecs[a.Type]
+		if !ok || (activity == activityTravel && !spec.Travel) || (activity == activityHands && !spec.Hands) {
+			continue
+		}
+		total += 0.15*float64(ailmentStage(a)) + 0.1*float64(a.Infection)
+	}
+	return clampFloat(total, 0, 1)
+}
+
+func injuryCause(kind AilmentType, activity string) string {
+	switch kind {
+	case AilmentBurn:
+		return "got burned " + activity
+	case AilmentSprain:
+		return "went over on an ankle " + activity
+	default:
+		return "slipped with the blade " + activity
+	}
+}
+
+// rollAccident checks for a slip at work and reports any injury through the run's report queue.
+func (s *RunState) rollAccident(player *PlayerState, kind AilmentType, activity string, risk float64) bool {
+	if player == nil || !player.Active() {
+		return false
+	}
+	// Tired people slip more; practised, agile hands less.
+	chance := risk * (1 + float64(clamp(player.Fatigue, 0, 100))/50) * (1 - float64(clamp(player.Crafting, 0, 100))/200) * (1 - float64(clamp(player.Agility, -3, 3))*0.1)
+	chance = clampFloat(chance, 0, 0.25)
+	rng := seededRNG(seedFromLabel(s.Config.Seed, fmt.Sprintf("accident:%s:%s:%d:%d:%.2f", kind, activity, s.Day, player.ID, s.ClockHours)))
+	if rng.Float64() >= chance {
+		return false
+	}
+	stage := 1
+	switch roll := rng.Float64(); {
+	case roll < 0.08:
+		stage = 3
+	case roll < 0.3:
+		stage = 2
+	}
+	injury := newInjury(kind, stage)
+	player.applyAilment(injury)
+	player.Morale = clamp(player.Morale-2*stage, 0, 100)
+	s.reports = append(s.reports, fmt.Sprintf("P%d %s %s: %s.", player.ID, player.Name, injuryCause(kind, activity), injury.Label()))
+	return true
+}
+
+// toolAccident is rollAccident for blade work; a worn tool slips more often.
+func (s *RunState) toolAccident(player *PlayerState, activity string, tools ...KitItem) {
+	risk := toolAccidentChance
+	if item, ok := s.firstUsableKit(player, tools...); ok && s.KitCondition(player, item) <= gearWornThreshold {
+		risk += 0.03
+	}
+	s.rollAccident(player, AilmentCut, activity, risk)
+}
+
+// travelFall rolls for a fall on a step of rough ground.
+func (s *RunState) travelFall(player *PlayerState, cell TopoCell) {
+	risk := fallChancePerRough * float64(cell.Roughness)
+	if isRainyWeather(s.Weather.Type) || s.Weather.Type == WeatherSnow || s.Weather.Type == WeatherBlizzard {
+		risk += 0.004
+	}
+	if s.CurrentTimeBlock() == TimeBlockNight {
+		risk += 0.006
+	}
+	s.rollAccident(player, AilmentSprain, "on rough ground", risk)
+}
+
+// applyColdExposure gives frostbite to anyone out in bitter cold through the day without enough warmth.
+// Hypothermia follows the hourly core temperature in thermal.go.
+func (s *RunState) applyColdExposure(player *PlayerState) {
+	if player == nil || s.Weather.TemperatureC > -10 {
+		return
+	}
+	feels := s.feelsLikeC(player)
+	if feels > -15 || s.clothingInsulation(player) >= 10 {
+		return
+	}
+	stage := 1
+	if feels <= -30 {
+		stage = 2
+	}
+	injury := newInjury(AilmentFrostbite, stage)
+	player.applyAilment(injury)
+	s.reports = append(s.reports, fmt.Sprintf("P%d %s has frostbite: %s.", player.ID, player.Name, injury.Label()))
+}
+
+// worstAilment picks what most needs treating: infection first, then stage and daily load.
+func worstAilment(p *PlayerState, match func(Ailment) bool) (*Ailment, bool) {
+	idx := -1
+	best := -1
+	for i, a := range p.Ailments {
+		if match != nil && !match(a) {
+			continue
+		}
+		score := a.Infection*20 + a.Stage*5 + ailmentLoad(a)
+		if score > best {
+			idx, best = i, score
+		}
+	}
+	if idx < 0 {
+		return nil, false
+	}
+	return &p.Ailments[idx], true
+}
+
+func dropHealedAilments(p *PlayerState) {
+	kept := p.Ailments[:0]
+	for _, a := range p.Ailments {
+		if a.DaysRemaining > 0 {
+			kept = append(kept, a)
+		}
+	}
+	p.Ailments = kept
+}
+
+// takeBoiledWater uses boiled water from the player's inventory, then the camp's.
+func (s *RunState) takeBoiledWater(playerID int, litres float64) bool {
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return false
+	}
+	id := waterItemID(WaterBoiled)
+	if inventoryTotalQtyByID(player.PersonalItems, id)+1e-9 >= litres {
+		_, err := s.removePersonalInventoryItem(playerID, id, litres)
+		return err == nil
+	}
+	if s.playerAtCamp(player) && inventoryTotalQtyByID(s.CampInventory, id)+1e-9 >= litres {
+		_, err := s.removeCampInventoryItem(id, litres)
+		return err == nil
+	}
+	return false
+}
+
+// CleanWounds washes every open wound with boiled water, which also clears an infection that has only just set in.
+func (s *RunState) CleanWounds(playerID int) (string, error) {
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return "", fmt.Errorf("player %d not found", playerID)
+	}
+	dirty := make([]string, 0, len(player.Ailments))
+	for _, a := range player.Ailments {
+		if a.Wound && (!a.Cleaned || a.Infection == 1) {
+			dirty = append(dirty, a.Label())
+		}
+	}
+	if len(dirty) == 0 {
+		return "", fmt.Errorf("P%d has no wounds that need cleaning", playerID)
+	}
+	if !s.takeBoiledWater(playerID, cleanWaterLitres) {
+		return "", fmt.Errorf("cleaning wounds needs %.1fL of boiled water (water boil)", cleanWaterLitres)
+	}
+	for i := range player.Ailments {
+		a := &player.Ailments[i]
+		if !a.Wound {
+			continue
+		}
+		a.Cleaned = true
+		a.DaysDirty = 0
+		if a.Infection == 1 {
+			a.Infection = 0
+		}
+	}
+	return fmt.Sprintf("P%d washed %s with boiled water.", playerID, strings.Join(dirty, ", ")), nil
+}
+
+// FirstAid uses the first-aid kit on the worst ailment: wounds are dressed and disinfected, sprains strapped, illness eased.
+func (s *RunState) FirstAid(playerID int) (string, error) {
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return "", fmt.Errorf("player %d not found", playerID)
+	}
+	if !s.kitUsable(player, KitFirstAidKit) {
+		return "", fmt.Errorf("P%d has no first-aid kit with supplies left", playerID)
+	}
+	target, ok := worstAilment(player, nil)
+	if !ok {
+		return "", fmt.Errorf("P%d has no ailments to treat", playerID)
+	}
+	before := target.Label()
+	switch {
+	case target.Wound:
+		target.Cleaned = true
+		target.DaysDirty = 0
+		target.Infection = maxInt(0, target.Infection-2)
+		target.lowerStage()
+	case target.Stage > 0:
+		target.lowerStage()
+	default:
+		target.DaysRemaining -= 2
+	}
+	after := target.Label()
+	if target.DaysRemaining <= 0 {
+		after = "healing"
+	}
+	dropHealedAilments(player)
+	s.wearKit(player, KitFirstAidKit, firstAidKitWear)
+	return fmt.Sprintf("treated %s -> %s", before, after), nil
+}
+
+type herbUse int
+
+const (
+	herbGeneral herbUse = iota
+	herbWound
+	herbBurn
+	herbSwelling
+	herbImmune
+)
+
+func plantHerbUse(plant PlantSpec) herbUse {
+	for _, tag := range plant.UtilityTags {
+		switch strings.ToLower(tag) {
+		case "wound herb", "antiseptic wash", "poultice", "antimicrobial":
+			return herbWound
+		case "burn gel":
+			return herbBurn
+		case "anti-inflammatory tea":
+			return herbSwelling
+		case "immune support":
+			return herbImmune
+		}
+	}
+	return herbGeneral
+}
+
+func herbSuits(use herbUse, a Ailment) bool {
+	switch use {
+	case herbWound:
+		return a.Wound
+	case herbBurn:
+		return a.Type == AilmentBurn
+	case herbSwelling:
+		return a.Type == AilmentSprain || a.Type == AilmentFrostbite || a.Type == AilmentInjury
+	case herbImmune:
+		return a.Infection > 0 || injurySpecs[a.Type].Name == ""
+	}
+	return false
+}
+
+// medicinalPlantsHere lists the medicinal plants growing where the player is this season.
+func (s *RunState) medicinalPlantsHere(player *PlayerState) []PlantSpec {
+	biome := s.BiomeQueryAt(player.Travel.PosX, player.Travel.PosY)
+	if strings.TrimSpace(biome) == "" {
+		biome = s.Scenario.Biome
+	}
+	season, _ := s.CurrentSeason()
+	plants := filterPlantsForClimate(filteredPlantsForBiome(biome, PlantCategoryAny, season), s.ActiveClimateProfile(), season, s.Weather.TemperatureC)
+	out := make([]PlantSpec, 0, len(plants))
+	for _, plant := range plants {
+		if plant.Medicinal > 0 {
+			out = append(out, plant)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Medicinal > out[j].Medicinal })
+	return out
+}
+
+// TreatWithHerb gathers a medicinal plant nearby and applies it. Without a plant named, it picks the best match for the worst ailment.
+func (s *RunState) TreatWithHerb(playerID int, plantID string) (string, error) {
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return "", fmt.Errorf("player %d not found", playerID)
+	}
+	if len(player.Ailments) == 0 {
+		return "", fmt.Errorf("P%d has no ailments to treat", playerID)
+	}
+	plants := s.medicinalPlantsHere(player)
+	if len(plants) == 0 {
+		return "", fmt.Errorf("no medicinal plants grow here this season")
+	}
+	var plant PlantSpec
+	found := false
+	plantID = strings.ToLower(strings.TrimSpace(plantID))
+	for _, candidate := range plants {
+		if plantID != "" && candidate.ID != plantID {
+			continue
+		}
+		if !found {
+			plant, found = candidate, true
+		}
+		if plantID != "" {
+			break
+		}
+		if _, ok := worstAilment(player, func(a Ailment) bool { return herbSuits(plantHerbUse(candidate), a) }); ok {
+			plant = candidate
+			break
+		}
+	}
+	if !found {
+		return "", fmt.Errorf("%s doesn't grow here; try: %s", plantID, medicinalPlantNames(plants))
+	}
+
+	s.trainSkill(player, SkillForaging, 10, true)
+	rng := seededRNG(seedFromLabel(s.Config.Seed, fmt.Sprintf("herb:%s:%d:%d:%.2f", plant.ID, s.Day, playerID, s.ClockHours)))
+	if rng.Float64() > clampFloat(0.5+float64(player.Foraging)/200+float64(plant.Medicinal)*0.1, 0.3, 0.95) {
+		return fmt.Sprintf("P%d searched for %s but found too little to help.", playerID, plant.Name), nil
+	}
+	use := plantHerbUse(plant)
+	target, suited := worstAilment(player, func(a Ailment) bool { return herbSuits(use, a) })
+	if !suited {
+		target, _ = worstAilment(player, nil)
+	}
+	before := target.Label()
+	switch {
+	case !suited:
+		target.DaysRemaining--
+	case use == herbWound:
+		target.Cleaned = true
+		target.DaysDirty = 0
+		target.Infection = maxInt(0, target.Infection-1)
+		if plant.Medicinal >= 2 {
+			target.lowerStage()
+		}
+	case use == herbImmune && target.Infection > 0:
+		target.Infection--
+	case use == herbImmune:
+		target.DaysRemaining -= plant.Medicinal
+	default:
+		target.lowerStage()
+	}
+	after := target.Label()
+	if target.DaysRemaining <= 0 {
+		after = "healing"
+	}
+	dropHealedAilments(player)
+	player.Morale = clamp(player.Morale+plant.Medicinal, 0, 100)
+	refreshEffectBars(player)
+	return fmt.Sprintf("P%d applied %s to %s -> %s.", playerID, plant.Name, before, after), nil
+}
+
+func medicinalPlantNames(plants []PlantSpec) string {
+	names := make([]string, 0, len(plants))
+	for _, plant := range plants {
+		names = append(names, plant.ID)
+	}
+	return strings.Join(names, ", ")
+}
+
+// MedicalStatus lists a player's ailments, what they slow down and the treatments to hand.
+func (s *RunState) MedicalStatus(playerID int) string {
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return fmt.Sprintf("Player %d not found.", playerID)
+	}
+	if len(player.Ailments) == 0 {
+		return fmt.Sprintf("P%d has no ailments.", playerID)
+	}
+	parts := make([]string, 0, len(player.Ailments))
+	for _, a := range player.Ailments {
+		parts = append(parts, fmt.Sprintf("%s %dd", a.Label(), a.DaysRemaining))
+	}
+	msg := fmt.Sprintf("P%d: %s.", playerID, strings.Join(parts, "; "))
+	if slow := ailmentSlowdown(*player, activityTravel); slow > 0 {
+		msg += fmt.Sprintf(" Travel +%.0f%% time.", slow*100)
+	}
+	if slow := ailmentSlowdown(*player, activityHands); slow > 0 {
+		msg += fmt.Sprintf(" Hand work +%.0f%% time.", slow*100)
+	}
+	options := []string{}
+	if s.kitUsable(player, KitFirstAidKit) {
+		options = append(options, "kit")
+	}
+	options = append(options, "clean (boiled water)")
+	if plants := s.medicinalPlantsHere(player); len(plants) > 0 {
+		options = append(options, "herb ["+medicinalPlantNames(plants)+"]")
+	}
+	return msg + " Treat: " + strings.Join(options, ", ") + "."
+}
+
+func (s *RunState) executeTreatCommand(args []string) RunCommandResult {
+	playerID, tokens := extractPlayerID(args)
+	if len(tokens) == 0 {
+		return RunCommandResult{Handled: true, Message: s.MedicalStatus(playerID)}
+	}
+	var (
+		msg     string
+		err     error
+		minutes = treatMinutes
+	)
+	switch tokens[0] {
+	case "clean", "wash":
+		msg, err = s.CleanWounds(playerID)
+	case "kit", "firstaid", "first", "dress", "bandage", "splint":
+		msg, err = s.FirstAid(playerID)
+		if err == nil {
+			msg = fmt.Sprintf("P%d used the first-aid kit: %s.", playerID, msg)
+		}
+	case "herb", "herbs", "plant", "poultice", "tea":
+		plantID := ""
+		if len(tokens) > 1 {
+			plantID = tokens[1]
+		}
+		minutes = treatHerbMinutes
+		msg, err = s.TreatWithHerb(playerID, plantID)
+	default:
+		return RunCommandResult{Handled: true, Message: "Usage: treat [clean|kit|herb [plant]] [p#]", Err: errCommandUsage}
+	}
+	if err != nil {
+		return RunCommandResult{Handled: true, Message: capitalizeTaskLabel(err.Error()) + "."}
+	}
+	s.AdvanceMinutes(minutes)
+	return RunCommandResult{Handled: true, Message: msg, HoursAdvanced: float64(minutes) / 60}
+}
@@ -0,0 +1,130 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDirtyWoundsTurnInfectedAndStopHealing(t *testing.T) {
+	dirty := PlayerState{Energy: 100, Hydration: 100, Morale: 100, Ailments: []Ailment{newInjury(AilmentCut, 2)}}
+	clean := dirty
+	clean.Ailments = []Ailment{newInjury(AilmentCut, 2)}
+	clean.Ailments[0].Cleaned = true
+
+	var notes []string
+	for day := 0; day < 3; day++ {
+		notes = append(notes, applyDailyAilmentPenalties(&dirty)...)
+		applyDailyAilmentPenalties(&clean)
+	}
+	if len(dirty.Ailments) != 1 || dirty.Ailments[0].Infection == 0 || len(notes) == 0 {
+		t.Fatalf("expected the dirty cut to turn infected: %+v %v", dirty.Ailments, notes)
+	}
+	if !strings.Contains(dirty.Ailments[0].Label(), "infected") {
+		t.Fatalf("expected the label to show the infection, got %q", dirty.Ailments[0].Label())
+	}
+	if len(clean.Ailments) != 1 || clean.Ailments[0].Infection != 0 || clean.Ailments[0].DaysRemaining >= dirty.Ailments[0].DaysRemaining {
+		t.Fatalf("expected the clean cut to heal while the infected one stalls: %+v vs %+v", clean.Ailments, dirty.Ailments)
+	}
+	if dirty.Energy >= clean.Energy {
+		t.Fatalf("expected infection to cost more energy: %d vs %d", dirty.Energy, clean.Energy)
+	}
+}
+
+func TestInjuriesSlowTheWorkTheyHamper(t *testing.T) {
+	run := newRunForCommands(t)
+	player := &run.Players[0]
+	x, y := run.CurrentMapPosition()
+	healthy := TravelMinutesForStep(&run, x, y, x, y-1, player)
+
+	player.Ailments = []Ailment{newInjury(AilmentSprain, 2)}
+	if sprained := TravelMinutesForStep(&run, x, y, x, y-1, player); sprained <= healthy {
+		t.Fatalf("expected a sprained ankle to slow travel: %d vs %d", sprained, healthy)
+	}
+	if ailmentSlowdown(*player, activityHands) != 0 {
+		t.Fatalf("expected a sprain to leave the hands alone")
+	}
+
+	player.Ailments = []Ailment{newInjury(AilmentCut, 1)}
+	mild := ailmentSlowdown(*player, activityHands)
+	player.Ailments[0].Infection = 2
+	if mild <= 0 || ailmentSlowdown(*player, activityHands) <= mild {
+		t.Fatalf("expected a cut to slow hand work, more once infected")
+	}
+}
+
+func TestTreatCommandUsesBoiledWaterAndTheKit(t *testing.T) {
+	run := newRunForCommands(t)
+	player := &run.Players[0]
+	cut := newInjury(AilmentCut, 3)
+	cut.Infection = 1
+	player.Ailments = []Ailment{cut}
+
+	if res := run.ExecuteRunCommand("treat clean"); !strings.Contains(res.Message, "boiled water") || res.HoursAdvanced != 0 {
+		t.Fatalf("expected cleaning to need boiled water, got %+v", res)
+	}
+	player.PersonalItems = append(player.PersonalItems, InventoryItem{ID: waterItemID(WaterBoiled), Name: "Boiled Water", Unit: "L", Qty: 1, WeightKg: 1})
+	if res := run.ExecuteRunCommand("treat clean"); res.HoursAdvanced == 0 {
+		t.Fatalf("expected the wound to be cleaned, got %q", res.Message)
+	}
+	if !player.Ailments[0].Cleaned || player.Ailments[0].Infection != 0 {
+		t.Fatalf("expected a clean wound with the early infection gone: %+v", player.Ailments[0])
+	}
+
+	res := run.ExecuteRunCommand("treat kit")
+	if !strings.Contains(res.Message, "first-aid kit") || player.Ailments[0].Stage != 2 {
+		t.Fatalf("expected the kit to dress the cut down a stage, got %q %+v", res.Message, player.Ailments)
+	}
+	if run.KitCondition(player, KitFirstAidKit) >= 100 {
+		t.Fatalf("expected the kit to use up supplies")
+	}
+	if res := run.ExecuteRunCommand("treat"); !strings.Contains(res.Message, "Cut (moderate)") || !strings.Contains(res.Message, "Hand work") {
+		t.Fatalf("expected a status line, got %q", res.Message)
+	}
+}
+
+func TestHerbsComeFromTheLocalPlants(t *testing.T) {
+	run := newRunForCommands(t)
+	player := &run.Players[0]
+	plants := run.medicinalPlantsHere(player)
+	if len(plants) == 0 {
+		t.Fatalf("expected medicinal plants on Vancouver Island")
+	}
+	player.Ailments = []Ailment{newInjury(AilmentCut, 2)}
+	if _, err := run.TreatWithHerb(1, "aloe_vera"); err == nil || !strings.Contains(err.Error(), "doesn't grow here") {
+		t.Fatalf("expected a plant from another climate to be refused, got %v", err)
+	}
+
+	for try := 0; try < 10 && !player.Ailments[0].Cleaned; try++ {
+		if _, err := run.TreatWithHerb(1, ""); err != nil {
+			t.Fatalf("herb: %v", err)
+		}
+		run.ClockHours += 1
+	}
+	if !player.Ailments[0].Cleaned {
+		t.Fatalf("expected a wound herb to clean the cut, have %s", medicinalPlantNames(plants))
+	}
+}
+
+func TestBitterColdWithoutWarmthCausesFrostbite(t *testing.T) {
+	run := newRunForCommands(t)
+	player := &run.Players[0]
+	player.Kit = nil
+	run.Weather.TemperatureC = -18
+	run.Weather.Type = WeatherWindy
+	run.Fire = FireState{}
+	run.Shelter = ShelterState{}
+
+	run.applyColdExposure(player)
+	if len(player.Ailments) != 1 || player.Ailments[0].Type != AilmentFrostbite {
+		t.Fatalf("expected frostbite, got %+v", player.Ailments)
+	}
+
+	warm := newRunForCommands(t)
+	warm.Weather.TemperatureC = -12
+	warm.Fire = FireState{Lit: true, Intensity: 40, HeatC: 60}
+	warm.Shelter = ShelterState{Type: ShelterLeanTo, Durability: 80}
+	warm.applyColdExposure(&warm.Players[0])
+	if len(warm.Players[0].Ailments) != 0 {
+		t.Fatalf("expected fire and shelter to keep the cold off, got %+v", warm.Players[0].Ailments)
+	}
+}
@@ -6,19 +6,33 @@ func (s *RunState) ApplyRealtimeMetabolism(elapsed time.Duration, dayDuration ti
 	if s == nil || dayDuration <= 0 {
 		return
 	}
+	s.ApplyMetabolismProgress(clampFloat(float64(elapsed)/float64(dayDuration), 0, 1))
+}
+
+// ApplyMetabolismProgress drains needs up to target (0..1) of the current day; journals replay through here.
+func (s *RunState) ApplyMetabolismProgress(target float64) {
+	if s == nil {
+		return
+	}
 	s.EnsurePlayerRuntimeStats()
 
-	target := clampFloat(float64(elapsed)/float64(dayDuration), 0, 1)
+	target = clampFloat(target, 0, 1)
 	delta := target - s.MetabolismProgress
 	if delta <= 0 {
 		return
 	}
 
 	for i := range s.Players {
+		if !s.Players[i].Active() {
+			continue
+		}
+		s.recordKcalBurned(&s.Players[i], delta)
 		applyMetabolismFraction(&s.Players[i], delta)
 		applyPhysiologyFraction(&s.Players[i], delta)
 	}
+	s.applyThermalSteps(delta * 24)
 	s.MetabolismProgress = target
+	s.updatePlayerOutcomes(delta * 24)
 }
 
 func (s *RunState) consumePendingDayMetabolism() {
@@ -28,6 +42,10 @@ func (s *RunState) consumePendingDayMetabolism() {
 	remaining := 1.0 - s.MetabolismProgress
 	if remaining > 0 {
 		for i := range s.Players {
+			if !s.Players[i].Active() {
+				continue
+			}
+			s.recordKcalBurned(&s.Players[i], remaining)
 			applyMetabolismFraction(&s.Players[i], remaining)
 			applyPhysiologyFraction(&s.Players[i], remaining)
 		}
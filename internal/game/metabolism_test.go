@@ -1,6 +1,7 @@
 package game
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 )
@@ -209,3 +210,26 @@ func TestDailyDeficiencyEffectsApplyMalnutritionAndDehydration(t *testing.T) {
 		t.Fatalf("expected dehydration ailment under sustained low hydration")
 	}
 }
+
+func TestPlayerJSONPersistsMetabolismCarries(t *testing.T) {
+	players := CreatePlayers(RunConfig{PlayerCount: 1, Seed: 5})
+	p := players[0]
+	applyMetabolismFraction(&p, 0.013)
+	applyPhysiologyFraction(&p, 0.013)
+	if p.metabolismCarryCalories == 0 && p.physiologyCarryEnergy == 0 {
+		t.Fatalf("expected fractional carries after a partial drain")
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var loaded PlayerState
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if loaded.metabolismCarryCalories != p.metabolismCarryCalories || loaded.physiologyCarryEnergy != p.physiologyCarryEnergy ||
+		loaded.metabolismCarryMorale != p.metabolismCarryMorale || loaded.Name != p.Name || loaded.Energy != p.Energy {
+		t.Fatalf("expected carries and fields to round-trip, got %+v", loaded)
+	}
+}
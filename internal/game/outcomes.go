@@ -0,0 +1,338 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Discovery summary:
+// - EvaluateRun only flagged RunOutcomeCritical; players could sit at zero bars indefinitely.
+// - Human players now share the contestant statuses (tapped_out, medically_extracted, deceased).
+//   Time spent critical accumulates with the metabolism clock; 24h of it ends in extraction,
+//   and a body failing on several fronts at once may not survive it.
+// - Predator contact can maul, `tap out` lets a player leave, and a seen signal gets everyone rescued. Every exit is kept as a PlayerOutcome
+//   so the end-of-run summary can say who went home, when and why.
+
+const (
+	criticalHoursToExtract = 24.0
+	criticalDeathChance    = 0.35
+	predatorAttackChance   = 0.025
+	predatorFatalChance    = 0.15
+)
+
+type PlayerOutcome struct {
+	PlayerID   int              `json:"player_id"`
+	Name       string           `json:"name"`
+	Status     ContestantStatus `json:"status"`
+	Day        int              `json:"day"`
+	ClockHours float64          `json:"clock_hours"`
+	Cause      string           `json:"cause,omitempty"`
+}
+
+// Active reports whether the player is still in the run. Saves from before outcomes have no status.
+func (p PlayerState) Active() bool {
+	return p.Status == "" || p.Status == ContestantActive
+}
+
+// StatusLabel is the player-facing wording for a contestant or player status.
+func StatusLabel(status ContestantStatus) string {
+	switch status {
+	case ContestantTappedOut:
+		return "tapped out"
+	case ContestantMedicallyExtracted:
+		return "medically extracted"
+	case ContestantDeceased:
+		return "died"
+	case ContestantRescued:
+		return "rescued"
+	default:
+		return "still in"
+	}
+}
+
+func (s *RunState) activePlayerCount() int {
+	count := 0
+	for _, p := range s.Players {
+		if p.Active() {
+			count++
+		}
+	}
+	return count
+}
+
+// criticalReasons lists what is currently failing for the player; empty means not critical.
+func criticalReasons(p PlayerState) []string {
+	reasons := make([]string, 0, 4)
+	if p.Hydration == 0 || p.Thirst >= 100 {
+		reasons = append(reasons, "dehydration")
+	}
+	if p.Hunger >= 100 {
+		reasons = append(reasons, "starvation")
+	}
+	if p.Energy == 0 || p.Fatigue >= 100 {
+		reasons = append(reasons, "exhaustion")
+	}
+	if severeAilments(p) && p.Energy < 20 {
+		reasons = append(reasons, "untreated illness")
+	}
+	if core := p.CoreTemperature(); core <= 32 {
+		reasons = append(reasons, "severe hypothermia")
+	} else if core >= 41 {
+		reasons = append(reasons, "heat stroke")
+	}
+	return reasons
+}
+
+func severeAilments(p PlayerState) bool {
+	if len(p.Ailments) >= 3 {
+		return true
+	}
+	load := 0
+	for _, ailment := range p.Ailments {
+		load += ailmentLoad(ailment)
+	}
+	return load >= 12
+}
+
+// removePlayer takes a player out of the run and records why.
+func (s *RunState) removePlayer(player *PlayerState, status ContestantStatus, cause string) {
+	if player == nil || !player.Active() {
+		return
+	}
+	player.Status = status
+	player.Task = nil
+	player.CurrentTask = StatusLabel(status)
+	outcome := PlayerOutcome{
+		PlayerID:   player.ID,
+		Name:       player.Name,
+		Status:     status,
+		Day:        s.Day,
+		ClockHours: s.ClockHours,
+		Cause:      cause,
+	}
+	s.Outcomes = append(s.Outcomes, outcome)
+	s.reports = append(s.reports, outcomeMessage(outcome))
+}
+
+func outcomeMessage(o PlayerOutcome) string {
+	switch o.Status {
+	case ContestantTappedOut:
+		return fmt.Sprintf("P%d %s has tapped out on day %d.", o.PlayerID, o.Name, o.Day)
+	case ContestantMedicallyExtracted:
+		return fmt.Sprintf("P%d %s has been medically extracted on day %d (%s).", o.PlayerID, o.Name, o.Day, o.Cause)
+	case ContestantDeceased:
+		return fmt.Sprintf("P%d %s did not survive day %d (%s).", o.PlayerID, o.Name, o.Day, o.Cause)
+	case ContestantRescued:
+		return fmt.Sprintf("P%d %s was picked up on day %d.", o.PlayerID, o.Name, o.Day)
+	}
+	return ""
+}
+
+// updatePlayerOutcomes spends hours of critical time for each player and extracts those who ran out.
+func (s *RunState) updatePlayerOutcomes(hours float64) {
+	if hours <= 0 {
+		return
+	}
+	for i := range s.Players {
+		player := &s.Players[i]
+		if !player.Active() {
+			continue
+		}
+		reasons := criticalReasons(*player)
+		if len(reasons) == 0 {
+			player.CriticalHours = clampFloat(player.CriticalHours-hours*2, 0, criticalHoursToExtract)
+			continue
+		}
+		wasCritical := player.CriticalHours > 0
+		player.CriticalHours += hours
+		if !wasCritical {
+			s.reports = append(s.reports, fmt.Sprintf("P%d %s is in critical condition (%s). Without help they'll be pulled within a day.",
+				player.ID, player.Name, strings.Join(reasons, ", ")))
+		}
+		if player.CriticalHours < criticalHoursToExtract {
+			continue
+		}
+		cause := strings.Join(reasons, ", ")
+		rng := seededRNG(seedFromLabel(s.Config.Seed, fmt.Sprintf("outcome:%d:%d", s.Day, player.ID)))
+		if len(reasons) >= 3 && rng.Float64() < criticalDeathChance {
+			s.removePlayer(player, ContestantDeceased, cause)
+			continue
+		}
+		s.removePlayer(player, ContestantMedicallyExtracted, cause)
+	}
+}
+
+// applyPredatorRisk rolls whether a predator encounter turns into an attack. It returns a log line when it does.
+func (s *RunState) applyPredatorRisk(player *PlayerState, event WildlifeEncounter, action string, rollIndex int) string {
+	if player == nil || !event.Predator || !player.Active() {
+		return ""
+	}
+	chance := predatorAttackChance
+	if s.CurrentTimeBlock() == TimeBlockNight {
+		chance += 0.02
+	}
+	if player.Energy < 25 {
+		chance += 0.02
+	}
+	rng := seededRNG(seedFromLabel(s.Config.Seed, fmt.Sprintf("predator:%s:%d:%d:%d:%.2f", action, s.Day, player.ID, rollIndex, s.ClockHours)))
+	if rng.Float64() >= chance {
+		return ""
+	}
+	cause := "mauled by " + strings.ToLower(event.Species)
+	if rng.Float64() < predatorFatalChance {
+		s.removePlayer(player, ContestantDeceased, cause)
+	} else {
+		s.removePlayer(player, ContestantMedicallyExtracted, cause)
+	}
+	return fmt.Sprintf("The %s attacks P%d %s!", strings.ToLower(event.Species), player.ID, player.Name)
+}
+
+// TapOut is the voluntary exit; it can't be undone.
+func (s *RunState) TapOut(playerID int) error {
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return fmt.Errorf("player %d not found", playerID)
+	}
+	if !player.Active() {
+		return fmt.Errorf("%s is already out (%s)", player.Name, StatusLabel(player.Status))
+	}
+	s.removePlayer(player, ContestantTappedOut, "voluntary")
+	return nil
+}
+
+func (s *RunState) executeTapCommand(args []string) RunCommandResult {
+	playerID, confirmed := 1, false
+	for _, arg := range args {
+		switch {
+		case arg == "out":
+		case arg == "confirm" || arg == "yes":
+			confirmed = true
+		case parsePlayerToken(arg) > 0:
+			playerID = parsePlayerToken(arg)
+		}
+	}
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Player %d not found.", playerID)}
+	}
+	if !confirmed {
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Tapping out ends P%d %s's run for good. Type 'tap out p%d confirm' to go home.", player.ID, player.Name, player.ID)}
+	}
+	if err := s.TapOut(playerID); err != nil {
+		return RunCommandResult{Handled: true, Message: capitalizeTaskLabel(err.Error()) + "."}
+	}
+	return RunCommandResult{Handled: true}
+}
+
+var playerActionVerbs = map[string]bool{
+	"hunt": true, "catch": true, "fish": true, "forage": true, "collect": true, "bark": true, "wood": true,
+	"gut": true, "cook": true, "preserve": true, "smoke": true, "dry": true, "salt": true, "eat": true,
+	"drink": true, "sip": true, "water": true, "sleep": true, "rest": true, "nap": true, "go": true,
+	"shelter": true, "camp": true, "craft": true, "use": true, "signal": true, "treat": true, "heal": true, "forecast": true,
+	"teach": true, "train": true,
+}
+
+// inactivePlayerRefusal stops players who are out of the run from acting; read-only commands still work.
+func (s *RunState) inactivePlayerRefusal(fields []string) (RunCommandResult, bool) {
+	if len(fields) == 0 || !playerActionVerbs[fields[0]] {
+		return RunCommandResult{}, false
+	}
+	playerID := 0
+	for _, field := range fields[1:] {
+		if id := parsePlayerToken(field); id > 0 {
+			playerID = id
+			break
+		}
+	}
+	if playerID == 0 {
+		// Without a p# sleep, rest and nap are for the whole camp, and Sleep leaves out whoever is gone.
+		switch fields[0] {
+		case "sleep", "rest", "nap":
+			return RunCommandResult{}, false
+		}
+		playerID = 1
+	}
+	player, ok := s.playerByID(playerID)
+	if !ok || player.Active() {
+		return RunCommandResult{}, false
+	}
+	message := fmt.Sprintf("P%d %s is out of the run (%s).", player.ID, player.Name, StatusLabel(player.Status))
+	if s.activePlayerCount() > 0 {
+		message += " Name an active player, e.g. " + fmt.Sprintf("p%d.", s.firstActivePlayerID())
+	}
+	return RunCommandResult{Handled: true, Message: message, Err: fmt.Errorf("P%d is out of the run", player.ID)}, true
+}
+
+func (s *RunState) firstActivePlayerID() int {
+	for _, p := range s.Players {
+		if p.Active() {
+			return p.ID
+		}
+	}
+	return 0
+}
+
+type RunSummary struct {
+	Mode        GameMode         `json:"mode"`
+	Scenario    string           `json:"scenario"`
+	Status      RunOutcomeStatus `json:"status"`
+	Message     string           `json:"message"`
+	Day         int              `json:"day"`
+	DaysPlanned int              `json:"days_planned,omitempty"`
+	Players     []PlayerOutcome  `json:"players"`
+	Remaining   int              `json:"remaining_contestants,omitempty"`
+}
+
+// Summary reports every player's standing: their exit record if they left, otherwise still in.
+func (s *RunState) Summary() RunSummary {
+	outcome := s.EvaluateRun()
+	summary := RunSummary{
+		Mode:     s.Config.Mode,
+		Scenario: s.Scenario.Name,
+		Status:   outcome.Status,
+		Message:  outcome.Message,
+		Day:      s.Day,
+	}
+	if !s.Config.RunLength.OpenEnded {
+		summary.DaysPlanned = s.Config.RunLength.Days
+	}
+	for _, p := range s.Players {
+		record := PlayerOutcome{PlayerID: p.ID, Name: p.Name, Status: ContestantActive, Day: s.Day, ClockHours: s.ClockHours}
+		for _, o := range s.Outcomes {
+			if o.PlayerID == p.ID {
+				record = o
+			}
+		}
+		summary.Players = append(summary.Players, record)
+	}
+	for _, c := range s.Contestants {
+		if c.Status == ContestantActive {
+			summary.Remaining++
+		}
+	}
+	return summary
+}
+
+// Lines renders the summary for the message log, the headless runner and the summary screen.
+func (r RunSummary) Lines() []string {
+	lines := []string{r.Message}
+	length := fmt.Sprintf("Day %d", r.Day)
+	if r.DaysPlanned > 0 {
+		length = fmt.Sprintf("Day %d of %d", r.Day, r.DaysPlanned)
+	}
+	lines = append(lines, fmt.Sprintf("%s | %s", r.Scenario, length))
+	for _, p := range r.Players {
+		line := fmt.Sprintf("P%d %s: %s", p.PlayerID, p.Name, StatusLabel(p.Status))
+		if p.Status != ContestantActive {
+			line += fmt.Sprintf(" on day %d at %02d:%02d", p.Day, int(p.ClockHours), int((p.ClockHours-float64(int(p.ClockHours)))*60))
+			if p.Cause != "" && p.Status != ContestantTappedOut {
+				line += " (" + p.Cause + ")"
+			}
+		}
+		lines = append(lines, line)
+	}
+	if r.Mode == ModeAlone && r.Remaining > 0 {
+		lines = append(lines, fmt.Sprintf("Contestants still out there: %d", r.Remaining))
+	}
+	return lines
+}
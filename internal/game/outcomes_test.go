@@ -0,0 +1,116 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+func newOutcomeRun(t *testing.T, mode GameMode, players int) RunState {
+	t.Helper()
+	run, err := NewRunState(RunConfig{
+		Mode:        mode,
+		ScenarioID:  ScenarioVancouverIslandID,
+		PlayerCount: players,
+		RunLength:   RunLength{Days: 21},
+		Seed:        2468,
+	})
+	if err != nil {
+		t.Fatalf("new run: %v", err)
+	}
+	return run
+}
+
+func TestTapOutNeedsConfirmationAndEndsSoloRun(t *testing.T) {
+	run := newOutcomeRun(t, ModeAlone, 1)
+
+	res := run.ExecuteRunCommand("tap out")
+	if !strings.Contains(res.Message, "confirm") || !run.Players[0].Active() {
+		t.Fatalf("expected a confirmation prompt, got %q", res.Message)
+	}
+	res = run.ExecuteRunCommand("tap out confirm")
+	if !strings.Contains(res.Message, "tapped out") {
+		t.Fatalf("expected tap out message, got %q", res.Message)
+	}
+	if run.Players[0].Status != ContestantTappedOut || len(run.Outcomes) != 1 {
+		t.Fatalf("expected a tapped_out record, got %+v / %+v", run.Players[0].Status, run.Outcomes)
+	}
+	if outcome := run.EvaluateRun(); outcome.Status != RunOutcomeEnded {
+		t.Fatalf("expected the run to end, got %+v", outcome)
+	}
+	if res := run.ExecuteRunCommand("forage any"); !strings.Contains(res.Message, "out of the run") {
+		t.Fatalf("expected an out player to be refused, got %q", res.Message)
+	}
+}
+
+func TestSustainedCriticalConditionLeadsToExtraction(t *testing.T) {
+	run := newOutcomeRun(t, ModeNakedAndAfraid, 2)
+	p2, _ := run.playerByID(2)
+	p2.Hydration = 0
+	p2.Thirst = 100
+
+	run.AdvanceMinutes(12 * 60)
+	if !p2.Active() {
+		t.Fatalf("expected p2 to hold on for the first 12 hours")
+	}
+	if outcome := run.EvaluateRun(); outcome.Status != RunOutcomeCritical {
+		t.Fatalf("expected critical outcome, got %+v", outcome)
+	}
+	p2.Hydration = 0
+	p2.Thirst = 100
+	run.AdvanceMinutes(13 * 60)
+	if p2.Active() {
+		t.Fatalf("expected p2 to be pulled after a day critical, critical hours %.1f", p2.CriticalHours)
+	}
+	if p2.Status != ContestantMedicallyExtracted {
+		t.Fatalf("expected medical extraction for a single failing bar, got %s", p2.Status)
+	}
+	if outcome := run.EvaluateRun(); outcome.Status == RunOutcomeEnded {
+		t.Fatalf("expected the run to continue with p1 active")
+	}
+
+	summary := strings.Join(run.Summary().Lines(), "\n")
+	if !strings.Contains(summary, "medically extracted") || !strings.Contains(summary, "dehydration") || !strings.Contains(summary, "still in") {
+		t.Fatalf("unexpected summary:\n%s", summary)
+	}
+}
+
+func TestPredatorAttackRemovesPlayer(t *testing.T) {
+	run := newOutcomeRun(t, ModeNakedAndAfraid, 2)
+	p1, _ := run.playerByID(1)
+	p1.Energy = 10
+	event := WildlifeEncounter{Channel: "mammal", Species: "Black Bear", Predator: true}
+
+	attacked := ""
+	for roll := 0; roll < 500 && attacked == ""; roll++ {
+		attacked = run.applyPredatorRisk(p1, event, "move", roll)
+	}
+	if attacked == "" {
+		t.Fatalf("expected an attack within 500 predator encounters")
+	}
+	if p1.Active() || len(run.Outcomes) != 1 || !strings.Contains(run.Outcomes[0].Cause, "black bear") {
+		t.Fatalf("expected the attack to remove p1, got %s / %+v", p1.Status, run.Outcomes)
+	}
+	if got := run.applyPredatorRisk(p1, event, "move", 0); got != "" {
+		t.Fatalf("expected no further attacks on a removed player")
+	}
+}
+
+func TestTeamSleepsOnAfterP1TapsOut(t *testing.T) {
+	run := newOutcomeRun(t, ModeNakedAndAfraidXL, 3)
+	run.ExecuteRunCommand("tap out p1 confirm")
+	if run.Players[0].Active() {
+		t.Fatalf("expected p1 to be out")
+	}
+	for _, command := range []string{"sleep all 2", "rest 1"} {
+		res := run.ExecuteRunCommand(command)
+		if strings.Contains(res.Message, "out of the run") || res.Err != nil {
+			t.Fatalf("expected %q to rest the remaining team, got %q", command, res.Message)
+		}
+		if strings.Contains(res.Message, "P1 ") || !strings.Contains(res.Message, "P2 ") || !strings.Contains(res.Message, "P3 ") {
+			t.Fatalf("expected only P2 and P3 to rest, got %q", res.Message)
+		}
+	}
+	if res := run.ExecuteRunCommand("sleep p1"); !strings.Contains(res.Message, "out of the run") {
+		t.Fatalf("expected naming p1 to still be refused, got %q", res.Message)
+	}
+}
@@ -1,6 +1,7 @@
 package game
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand/v2"
 	"strings"
@@ -67,39 +68,44 @@ var romanNumerals = []string{
 }
 
 type PlayerState struct {
-	ID             int             `json:"id"`
-	Name           string          `json:"name"`
-	Sex            Sex             `json:"sex"`
-	BodyType       BodyType        `json:"body_type"`
-	WeightKg       int             `json:"weight_kg"`
-	HeightFt       int             `json:"height_ft"`
-	HeightIn       int             `json:"height_in"`
-	Endurance      int             `json:"endurance"`
-	Bushcraft      int             `json:"bushcraft"`
-	Mental         int             `json:"mental"`
-	Strength       int             `json:"strength"`
-	MentalStrength int             `json:"mental_strength"`
-	Agility        int             `json:"agility"`
-	Hunting        int             `json:"hunting"`
-	Fishing        int             `json:"fishing"`
-	Foraging       int             `json:"foraging"`
-	Crafting       int             `json:"crafting"`
-	Gathering      int             `json:"gathering"`
-	Trapping       int             `json:"trapping"`
-	Firecraft      int             `json:"firecraft"`
-	Sheltercraft   int             `json:"sheltercraft"`
-	Cooking        int             `json:"cooking"`
-	Navigation     int             `json:"navigation"`
-	CurrentTask    string          `json:"current_task"`
-	MicroLocation  MicroLocation   `json:"micro_location"`
-	Traits         []TraitModifier `json:"traits,omitempty"`
-	KitLimit       int             `json:"kit_limit"`
-	Kit            []KitItem       `json:"kit"`
-	CarryLimitKg   float64         `json:"carry_limit_kg"`
-	PersonalItems  []InventoryItem `json:"personal_items,omitempty"`
-	Energy         int             `json:"energy"`
-	Hydration      int             `json:"hydration"`
-	Morale         int             `json:"morale"`
+	ID             int              `json:"id"`
+	Name           string           `json:"name"`
+	Sex            Sex              `json:"sex"`
+	BodyType       BodyType         `json:"body_type"`
+	WeightKg       int              `json:"weight_kg"`
+	HeightFt       int              `json:"height_ft"`
+	HeightIn       int              `json:"height_in"`
+	Endurance      int              `json:"endurance"`
+	Bushcraft      int              `json:"bushcraft"`
+	Mental         int              `json:"mental"`
+	Strength       int              `json:"strength"`
+	MentalStrength int              `json:"mental_strength"`
+	Agility        int              `json:"agility"`
+	Hunting        int              `json:"hunting"`
+	Fishing        int              `json:"fishing"`
+	Foraging       int              `json:"foraging"`
+	Crafting       int              `json:"crafting"`
+	Gathering      int              `json:"gathering"`
+	Trapping       int              `json:"trapping"`
+	Firecraft      int              `json:"firecraft"`
+	Sheltercraft   int              `json:"sheltercraft"`
+	Cooking        int              `json:"cooking"`
+	Navigation     int              `json:"navigation"`
+	CurrentTask    string           `json:"current_task"`
+	Task           *DelegatedTask   `json:"task,omitempty"`
+	Status         ContestantStatus `json:"status,omitempty"`
+	CriticalHours  float64          `json:"critical_hours,omitempty"`
+	MicroLocation  MicroLocation    `json:"micro_location"`
+	Travel         TravelState      `json:"travel"`
+	Fog            []byte           `json:"fog,omitempty"` // cells this player has seen, one bit per topology cell
+	Traits         []TraitModifier  `json:"traits,omitempty"`
+	KitLimit       int              `json:"kit_limit"`
+	Kit            []KitItem        `json:"kit"`
+	CarryLimitKg   float64          `json:"carry_limit_kg"`
+	PersonalItems  []InventoryItem  `json:"personal_items,omitempty"`
+	Energy         int              `json:"energy"`
+	Hydration      int              `json:"hydration"`
+	Morale         int              `json:"morale"`
 
 	// Runtime-only survival reserves and bars. These are not editable in setup.
 	CaloriesReserveKcal  int `json:"calories_reserve_kcal"`
@@ -112,6 +118,20 @@ type PlayerState struct {
 	NutritionDeficitDays int `json:"nutrition_deficit_days"`
 	DehydrationDays      int `json:"dehydration_days"`
 
+	// Hourly thermal state; see thermal.go.
+	CoreTempC float64 `json:"core_temp_c,omitempty"` // 0 reads as a normal 37C
+	Wetness   float64 `json:"wetness,omitempty"`     // 0 = dry, 100 = soaked through
+
+	// Day the player last practised reading the sky; see weather_hourly.go.
+	ForecastDay int `json:"forecast_day,omitempty"`
+
+	// Banked XP, last practice day and today's lesson per skill; see skill_progression.go.
+	SkillProgress map[SkillID]SkillProgress `json:"skill_progress,omitempty"`
+	Lesson        *SkillLesson              `json:"lesson,omitempty"`
+
+	// What the player has done this run, for their career; see career.go.
+	Tally map[RunEventKind]float64 `json:"tally,omitempty"`
+
 	Nutrition NutritionTotals `json:"nutrition"`
 	Ailments  []Ailment       `json:"ailments"`
 
@@ -127,6 +147,65 @@ type PlayerState struct {
 	physiologyCarryMorale    float64
 }
 
+// playerCarries persists the fractional metabolism/physiology remainders so a loaded run drains exactly
+// like the one that was saved.
+type playerCarries struct {
+	MetabolismCalories  float64 `json:"metabolism_calories,omitempty"`
+	MetabolismProtein   float64 `json:"metabolism_protein,omitempty"`
+	MetabolismFat       float64 `json:"metabolism_fat,omitempty"`
+	MetabolismSugar     float64 `json:"metabolism_sugar,omitempty"`
+	MetabolismEnergy    float64 `json:"metabolism_energy,omitempty"`
+	MetabolismHydration float64 `json:"metabolism_hydration,omitempty"`
+	MetabolismMorale    float64 `json:"metabolism_morale,omitempty"`
+	PhysiologyEnergy    float64 `json:"physiology_energy,omitempty"`
+	PhysiologyHydration float64 `json:"physiology_hydration,omitempty"`
+	PhysiologyMorale    float64 `json:"physiology_morale,omitempty"`
+}
+
+func (p PlayerState) MarshalJSON() ([]byte, error) {
+	type plain PlayerState
+	carry := playerCarries{
+		MetabolismCalories:  p.metabolismCarryCalories,
+		MetabolismProtein:   p.metabolismCarryProtein,
+		MetabolismFat:       p.metabolismCarryFat,
+		MetabolismSugar:     p.metabolismCarrySugar,
+		MetabolismEnergy:    p.metabolismCarryEnergy,
+		MetabolismHydration: p.metabolismCarryHydration,
+		MetabolismMorale:    p.metabolismCarryMorale,
+		PhysiologyEnergy:    p.physiologyCarryEnergy,
+		PhysiologyHydration: p.physiologyCarryHydration,
+		PhysiologyMorale:    p.physiologyCarryMorale,
+	}
+	return json.Marshal(struct {
+		plain
+		Carry playerCarries `json:"carry"`
+	}{plain: plain(p), Carry: carry})
+}
+
+func (p *PlayerState) UnmarshalJSON(data []byte) error {
+	type plain PlayerState
+	aux := struct {
+		*plain
+		Carry *playerCarries `json:"carry"`
+	}{plain: (*plain)(p)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if aux.Carry != nil {
+		p.metabolismCarryCalories = aux.Carry.MetabolismCalories
+		p.metabolismCarryProtein = aux.Carry.MetabolismProtein
+		p.metabolismCarryFat = aux.Carry.MetabolismFat
+		p.metabolismCarrySugar = aux.Carry.MetabolismSugar
+		p.metabolismCarryEnergy = aux.Carry.MetabolismEnergy
+		p.metabolismCarryHydration = aux.Carry.MetabolismHydration
+		p.metabolismCarryMorale = aux.Carry.MetabolismMorale
+		p.physiologyCarryEnergy = aux.Carry.PhysiologyEnergy
+		p.physiologyCarryHydration = aux.Carry.PhysiologyHydration
+		p.physiologyCarryMorale = aux.Carry.PhysiologyMorale
+	}
+	return nil
+}
+
 type PlayerConfig struct {
 	Name           string
 	Sex            Sex
@@ -150,6 +229,7 @@ type PlayerConfig struct {
 	Sheltercraft   int
 	Cooking        int
 	Navigation     int
+	SkillXP        map[SkillID]float64
 	CurrentTask    string
 	Traits         []TraitModifier
 	KitLimit       int
@@ -242,6 +322,7 @@ func CreatePlayers(cfg RunConfig) []PlayerState {
 			Hydration:      100,
 			Morale:         100,
 		}
+		players[i].SkillProgress = skillProgressFromXP(pc.SkillXP, players[i])
 		players[i].CarryLimitKg = deriveCarryLimitKg(players[i], false)
 		initializeRuntimeBars(&players[i])
 	}
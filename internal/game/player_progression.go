@@ -31,17 +31,3 @@ func negativeTraitModifier(traits []TraitModifier) int {
 	}
 	return total
 }
-
-func applySkillEffort(skill *int, effort int, success bool) {
-	if skill == nil {
-		return
-	}
-	if effort < 1 {
-		effort = 1
-	}
-	gain := 1 + effort/25
-	if success {
-		gain++
-	}
-	*skill = clampSkill(*skill + gain)
-}
@@ -0,0 +1,259 @@
+package game
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Discovery summary:
+//   - Topology cells only carry elevation/moisture/biome/flags, so every forest cell played the same; this adds
+//     a sparse, seeded layer of named spots that make one cell better than its neighbours for one job.
+//   - Placement reuses deterministicEncounterRoll keyed on the run seed and cell, so a save regenerates the same
+//     layer and only the discovered flags need to persist (RunState.PointsOfInterest, next to CellStates).
+//   - Effects hook into the existing actions on the acting player's own cell: hunting and fishing add to the
+//     catch bonus, forage/wood/collect scale the yield, the shelter site modifier and water sources read it.
+//   - A spot is found by looking closer at it, walking onto it or working it; finds are announced as reports.
+
+// POIKind is a type of point of interest on the map.
+type POIKind string
+
+const (
+	POIGameTrail    POIKind = "game_trail"
+	POIBerryPatch   POIKind = "berry_patch"
+	POIDeadwood     POIKind = "deadwood"
+	POIRockOverhang POIKind = "rock_overhang"
+	POISpring       POIKind = "spring"
+	POIClayBank     POIKind = "clay_bank"
+	POIBeaverDam    POIKind = "beaver_dam"
+	POIBirdRookery  POIKind = "bird_rookery"
+	POISaltLick     POIKind = "salt_lick"
+)
+
+// PointOfInterest is one spot on the map. The layer is sorted by cell, row by row.
+type PointOfInterest struct {
+	Kind       POIKind `json:"kind"`
+	X          int     `json:"x"`
+	Y          int     `json:"y"`
+	Discovered bool    `json:"discovered,omitempty"`
+}
+
+type poiSpec struct {
+	Kind   POIKind
+	Label  string
+	Effect string
+	Chance float64
+	Fits   func(s *RunState, x, y int, cell TopoCell) bool
+}
+
+// poiSpecs are tried in order on each land cell; the first that fits and rolls under its chance claims the cell.
+var poiSpecs = []poiSpec{
+	{Kind: POISaltLick, Label: "salt lick", Effect: "game comes to it, so land hunting is better here", Chance: 0.006,
+		Fits: func(_ *RunState, _, _ int, cell TopoCell) bool {
+			return topoBiomeIn(cell.Biome, TopoBiomeGrassland, TopoBiomeForest, TopoBiomeBoreal, TopoBiomeMountain, TopoBiomeDesert)
+		}},
+	{Kind: POISpring, Label: "spring", Effect: "clean running water you can collect here", Chance: 0.02,
+		Fits: func(s *RunState, x, y int, cell TopoCell) bool {
+			return cell.Elevation >= 10 && cell.Moisture >= 110 && !s.isNearWater(x, y)
+		}},
+	{Kind: POIBeaverDam, Label: "beaver dam", Effect: "a pond full of fish backs up behind it", Chance: 0.06,
+		Fits: func(s *RunState, x, y int, cell TopoCell) bool {
+			return s.regionHasAnimal("beaver") && s.bordersTopoFlag(x, y, TopoFlagRiver) &&
+				topoBiomeIn(cell.Biome, TopoBiomeForest, TopoBiomeBoreal, TopoBiomeWetland, TopoBiomeSwamp, TopoBiomeGrassland)
+		}},
+	{Kind: POIBirdRookery, Label: "bird rookery", Effect: "nesting birds make bird hunting easier here", Chance: 0.03,
+		Fits: func(s *RunState, x, y int, cell TopoCell) bool {
+			return cell.Flags&TopoFlagCoast != 0 || s.bordersTopoFlag(x, y, TopoFlagLake)
+		}},
+	{Kind: POIClayBank, Label: "clay bank", Effect: "clay and mud come out by the armful", Chance: 0.03,
+		Fits: func(s *RunState, x, y int, _ TopoCell) bool {
+			return s.bordersTopoFlag(x, y, TopoFlagRiver|TopoFlagLake)
+		}},
+	{Kind: POIRockOverhang, Label: "rock overhang", Effect: "a shelter built under it stays drier, calmer and safer", Chance: 0.03,
+		Fits: func(_ *RunState, _, _ int, cell TopoCell) bool {
+			return cell.Biome == TopoBiomeMountain || cell.Roughness >= 6
+		}},
+	{Kind: POIBerryPatch, Label: "berry patch", Effect: "foraging turns up far more berries here", Chance: 0.015,
+		Fits: func(_ *RunState, _, _ int, cell TopoCell) bool {
+			return cell.Moisture >= 90 && topoBiomeIn(cell.Biome, TopoBiomeForest, TopoBiomeBoreal, TopoBiomeGrassland, TopoBiomeWetland, TopoBiomeJungle, TopoBiomeTundra)
+		}},
+	{Kind: POIDeadwood, Label: "stand of deadwood", Effect: "seasoned fallen wood, more and drier per trip", Chance: 0.02,
+		Fits: func(_ *RunState, _, _ int, cell TopoCell) bool {
+			return topoBiomeIn(cell.Biome, TopoBiomeForest, TopoBiomeBoreal, TopoBiomeJungle, TopoBiomeSwamp)
+		}},
+	{Kind: POIGameTrail, Label: "game trail", Effect: "animals pass along it, so land hunting is better here", Chance: 0.015,
+		Fits: func(_ *RunState, _, _ int, cell TopoCell) bool {
+			return cell.Biome != TopoBiomeDesert
+		}},
+}
+
+func poiSpecFor(kind POIKind) (poiSpec, bool) {
+	for _, spec := range poiSpecs {
+		if spec.Kind == kind {
+			return spec, true
+		}
+	}
+	return poiSpec{}, false
+}
+
+// Label is the display name of the kind.
+func (k POIKind) Label() string {
+	if spec, ok := poiSpecFor(k); ok {
+		return spec.Label
+	}
+	return strings.ReplaceAll(string(k), "_", " ")
+}
+
+// generatePointsOfInterest seeds the layer for the current topology.
+func (s *RunState) generatePointsOfInterest() []PointOfInterest {
+	out := []PointOfInterest{}
+	for y := 0; y < s.Topology.Height; y++ {
+		for x := 0; x < s.Topology.Width; x++ {
+			cell, ok := s.TopologyCellAt(x, y)
+			if !ok || cell.Flags&TopoFlagWater != 0 {
+				continue
+			}
+			for _, spec := range poiSpecs {
+				if deterministicEncounterRoll(s.Config.Seed, x, y, 0, "", "poi", 0, string(spec.Kind)) >= spec.Chance {
+					continue
+				}
+				if spec.Fits(s, x, y, cell) {
+					out = append(out, PointOfInterest{Kind: spec.Kind, X: x, Y: y})
+					break
+				}
+			}
+		}
+	}
+	return out
+}
+
+func (s *RunState) poiIndex(x, y int) (int, bool) {
+	if s == nil {
+		return 0, false
+	}
+	return slices.BinarySearchFunc(s.PointsOfInterest, [2]int{x, y}, func(p PointOfInterest, at [2]int) int {
+		if p.Y != at[1] {
+			return p.Y - at[1]
+		}
+		return p.X - at[0]
+	})
+}
+
+// PointOfInterestAt returns the spot on a cell, if there is one.
+func (s *RunState) PointOfInterestAt(x, y int) (PointOfInterest, bool) {
+	idx, ok := s.poiIndex(x, y)
+	if !ok {
+		return PointOfInterest{}, false
+	}
+	return s.PointsOfInterest[idx], true
+}
+
+// KnownPointsOfInterest lists the spots the team has found.
+func (s *RunState) KnownPointsOfInterest() []PointOfInterest {
+	if s == nil {
+		return nil
+	}
+	out := []PointOfInterest{}
+	for _, poi := range s.PointsOfInterest {
+		if poi.Discovered {
+			out = append(out, poi)
+		}
+	}
+	return out
+}
+
+// markPointOfInterest marks the spot on a cell as found; found is true only the first time.
+func (s *RunState) markPointOfInterest(x, y int) (poi PointOfInterest, found, ok bool) {
+	idx, ok := s.poiIndex(x, y)
+	if !ok {
+		return PointOfInterest{}, false, false
+	}
+	found = !s.PointsOfInterest[idx].Discovered
+	s.PointsOfInterest[idx].Discovered = true
+	return s.PointsOfInterest[idx], found, true
+}
+
+// discoverPointOfInterest marks the spot on a cell as found and reports it the first time.
+func (s *RunState) discoverPointOfInterest(playerID, x, y int) {
+	if poi, found, _ := s.markPointOfInterest(x, y); found {
+		spec, _ := poiSpecFor(poi.Kind)
+		s.reports = append(s.reports, fmt.Sprintf("P%d found a %s at (%d,%d): %s.", playerID, spec.Label, x, y, spec.Effect))
+	}
+}
+
+// cellHasPOI checks the player's cell for a kind of spot without finding it.
+func (s *RunState) cellHasPOI(player *PlayerState, kind POIKind) bool {
+	if player == nil {
+		return false
+	}
+	poi, ok := s.PointOfInterestAt(player.Travel.PosX, player.Travel.PosY)
+	return ok && poi.Kind == kind
+}
+
+// playerAtPOI reports whether the player stands on one of the given kinds of spot, finding it if they had not yet.
+func (s *RunState) playerAtPOI(player *PlayerState, kinds ...POIKind) (POIKind, bool) {
+	if player == nil {
+		return "", false
+	}
+	poi, ok := s.PointOfInterestAt(player.Travel.PosX, player.Travel.PosY)
+	if !ok || !slices.Contains(kinds, poi.Kind) {
+		return "", false
+	}
+	s.discoverPointOfInterest(player.ID, poi.X, poi.Y)
+	return poi.Kind, true
+}
+
+// poiCatchBonusPct is the extra catch weight a spot gives hunting or fishing in a domain.
+func (s *RunState) poiCatchBonusPct(player *PlayerState, domain AnimalDomain) int {
+	switch domain {
+	case AnimalDomainLand:
+		if kind, ok := s.playerAtPOI(player, POIGameTrail, POISaltLick); ok {
+			if kind == POISaltLick {
+				return 35
+			}
+			return 25
+		}
+	case AnimalDomainWater:
+		if _, ok := s.playerAtPOI(player, POIBeaverDam); ok {
+			return 35
+		}
+	case AnimalDomainAir:
+		if _, ok := s.playerAtPOI(player, POIBirdRookery); ok {
+			return 40
+		}
+	}
+	return 0
+}
+
+// describePointOfInterest is the look closer line for a spot, finding it on the way.
+func (s *RunState) describePointOfInterest(x, y int) string {
+	poi, _, ok := s.markPointOfInterest(x, y)
+	if !ok {
+		return ""
+	}
+	spec, _ := poiSpecFor(poi.Kind)
+	return fmt.Sprintf(" There is a %s there: %s.", spec.Label, spec.Effect)
+}
+
+func (s *RunState) bordersTopoFlag(x, y int, flag uint8) bool {
+	for oy := -1; oy <= 1; oy++ {
+		for ox := -1; ox <= 1; ox++ {
+			if ox == 0 && oy == 0 {
+				continue
+			}
+			if cell, ok := s.TopologyCellAt(x+ox, y+oy); ok && cell.Flags&flag != 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// regionHasAnimal reports whether the scenario's land animals include the species.
+func (s *RunState) regionHasAnimal(id string) bool {
+	return slices.ContainsFunc(AnimalsForBiome(s.Scenario.Biome, AnimalDomainLand), func(a AnimalSpec) bool { return a.ID == id })
+}
+
+func topoBiomeIn(biome uint8, options ...uint8) bool {
+	return slices.Contains(options, biome)
+}
@@ -0,0 +1,139 @@
+package game
+
+import (
+	"encoding/json"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func newPOIRun(t *testing.T, pois ...PointOfInterest) *RunState {
+	t.Helper()
+	run, err := NewRunState(RunConfig{
+		Mode:        ModeAlone,
+		ScenarioID:  ScenarioVancouverIslandID,
+		PlayerCount: 1,
+		RunLength:   RunLength{Days: 21},
+		Seed:        4242,
+	})
+	if err != nil {
+		t.Fatalf("new run: %v", err)
+	}
+	// A dry 9x9 forest with no water anywhere, so every effect comes from the spots placed below.
+	cells := make([]TopoCell, 9*9)
+	for i := range cells {
+		cells[i] = TopoCell{Biome: TopoBiomeForest, Elevation: 12, Moisture: 80}
+	}
+	run.Topology = WorldTopology{Width: 9, Height: 9, Cells: cells}
+	run.CellStates = nil
+	run.FogMask = nil
+	run.FogSeenHour = nil
+	run.Players[0].Fog = nil
+	run.Players[0].Travel.PosX, run.Players[0].Travel.PosY = 4, 4
+	run.ClockHours = 12
+	run.Weather = WeatherState{Day: run.Day, Type: WeatherSunny, TemperatureC: 16}
+	run.EnsureTopology()
+	run.PointsOfInterest = pois
+	return &run
+}
+
+func TestPointsOfInterestAreSeededAndSaved(t *testing.T) {
+	cfg := RunConfig{Mode: ModeAlone, ScenarioID: ScenarioVancouverIslandID, PlayerCount: 1, RunLength: RunLength{Days: 30}, Seed: 77}
+	first, err := NewRunState(cfg)
+	if err != nil {
+		t.Fatalf("new run: %v", err)
+	}
+	second, _ := NewRunState(cfg)
+	if len(first.PointsOfInterest) == 0 || !slices.Equal(first.PointsOfInterest, second.PointsOfInterest) {
+		t.Fatalf("expected the same non-empty layer from the same seed, got %d and %d spots", len(first.PointsOfInterest), len(second.PointsOfInterest))
+	}
+	for _, poi := range first.PointsOfInterest {
+		if cell, _ := first.TopologyCellAt(poi.X, poi.Y); cell.Flags&TopoFlagWater != 0 {
+			t.Fatalf("expected spots on land only, got %s at (%d,%d)", poi.Kind, poi.X, poi.Y)
+		}
+	}
+
+	poi := first.PointsOfInterest[0]
+	first.discoverPointOfInterest(1, poi.X, poi.Y)
+	if reports := first.DrainReports(); len(reports) != 1 || !strings.Contains(reports[0], poi.Kind.Label()) {
+		t.Fatalf("expected one find report for the %s, got %v", poi.Kind.Label(), reports)
+	}
+	first.discoverPointOfInterest(1, poi.X, poi.Y)
+	if reports := first.DrainReports(); len(reports) != 0 {
+		t.Fatalf("expected a spot to be reported only once, got %v", reports)
+	}
+
+	data, err := json.Marshal(first)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var loaded RunState
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	loaded.EnsureTopology()
+	if known := loaded.KnownPointsOfInterest(); len(known) != 1 || known[0].X != poi.X || known[0].Y != poi.Y {
+		t.Fatalf("expected the found %s to survive a save, got %v", poi.Kind, known)
+	}
+}
+
+func TestLookCloserFindsAPointOfInterest(t *testing.T) {
+	run := newPOIRun(t)
+	tx, ty, ok := run.stepInDirection(4, 4, run.absoluteLookDirection(1, "front"))
+	if !ok {
+		t.Fatalf("expected a cell ahead of P1")
+	}
+	run.PointsOfInterest = []PointOfInterest{{Kind: POIRockOverhang, X: tx, Y: ty}}
+
+	if msg := run.ExecuteRunCommand("look").Message; strings.Contains(msg, "rock overhang") {
+		t.Fatalf("expected a plain look to miss the overhang, got %q", msg)
+	}
+	if msg := run.ExecuteRunCommand("look closer").Message; !strings.Contains(msg, "There is a rock overhang there") {
+		t.Fatalf("expected look closer to find the overhang, got %q", msg)
+	}
+	if msg := run.ExecuteRunCommand("look").Message; !strings.Contains(msg, "You know of a rock overhang there") {
+		t.Fatalf("expected the overhang to be remembered, got %q", msg)
+	}
+}
+
+func TestPointsOfInterestImproveTheWorkDoneOnThem(t *testing.T) {
+	plain := newPOIRun(t)
+	_, plainKg, err := plain.GatherWood(1, 2)
+	if err != nil {
+		t.Fatalf("gather wood: %v", err)
+	}
+	deadwood := newPOIRun(t, PointOfInterest{Kind: POIDeadwood, X: 4, Y: 4})
+	_, deadKg, err := deadwood.GatherWood(1, 2)
+	if err != nil {
+		t.Fatalf("gather wood: %v", err)
+	}
+	if math.Abs(deadKg-plainKg*1.5) > 0.01 {
+		t.Fatalf("expected deadwood to give half as much again as %.2fkg, got %.2fkg", plainKg, deadKg)
+	}
+	if len(deadwood.KnownPointsOfInterest()) != 1 {
+		t.Fatalf("expected gathering on the deadwood to find it")
+	}
+
+	trail := newPOIRun(t, PointOfInterest{Kind: POIGameTrail, X: 4, Y: 4})
+	if got := trail.poiCatchBonusPct(&trail.Players[0], AnimalDomainLand); got != 25 {
+		t.Fatalf("expected a game trail to add 25%% to land catches, got %d", got)
+	}
+	if got := trail.poiCatchBonusPct(&trail.Players[0], AnimalDomainWater); got != 0 {
+		t.Fatalf("expected a game trail to do nothing for fishing, got %d", got)
+	}
+
+	plain.Players[0].Kit = []KitItem{KitCanteen}
+	if _, err := plain.CollectWater(1, 1); err == nil || !strings.Contains(err.Error(), "no fresh water") {
+		t.Fatalf("expected no water in the dry forest, got %v", err)
+	}
+	spring := newPOIRun(t, PointOfInterest{Kind: POISpring, X: 4, Y: 4})
+	spring.Players[0].Kit = []KitItem{KitCanteen}
+	res, err := spring.CollectWater(1, 1)
+	if err != nil {
+		t.Fatalf("collect water at the spring: %v", err)
+	}
+	if res.Source != waterSourceSpring || len(spring.KnownPointsOfInterest()) != 1 {
+		t.Fatalf("expected to draw water from a newly found spring, got %+v", res)
+	}
+}
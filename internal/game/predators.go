@@ -0,0 +1,414 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Discovery summary:
+// - RollWildlifeEncounter already flags predators; most sightings stay as sign, but a close one now holds the run on a decision.
+// - Choices lean on traits (Agility/Strength), kit (whistle, bow, spear), the shelter's PredatorSafety and the camp fire.
+// - A confrontation left unanswered when the clock moves falls back to applyPredatorRisk, the old instant roll.
+type EncounterChoice string
+
+const (
+	EncounterBackAway  EncounterChoice = "back"
+	EncounterNoise     EncounterChoice = "noise"
+	EncounterWhistle   EncounterChoice = "whistle"
+	EncounterClimb     EncounterChoice = "climb"
+	EncounterFight     EncounterChoice = "fight"
+	EncounterShelter   EncounterChoice = "shelter"
+	confrontBaseChance                 = 0.3
+	encounterMinutes                   = 15
+)
+
+// PredatorEncounter is a confrontation waiting on the player's choice.
+type PredatorEncounter struct {
+	PlayerID   int     `json:"player_id"`
+	Species    string  `json:"species"`
+	Action     string  `json:"action"`
+	Day        int     `json:"day"`
+	ClockHours float64 `json:"clock_hours"`
+	Roll       int     `json:"roll"`
+}
+
+type EncounterOption struct {
+	Choice EncounterChoice
+	Label  string
+}
+
+func parseEncounterChoice(raw string) (EncounterChoice, bool) {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "back", "backaway", "retreat_slowly", "away":
+		return EncounterBackAway, true
+	case "noise", "shout", "yell", "big":
+		return EncounterNoise, true
+	case "whistle", "blow":
+		return EncounterWhistle, true
+	case "climb", "tree":
+		return EncounterClimb, true
+	case "fight", "shoot", "bow", "spear", "stab":
+		return EncounterFight, true
+	case "shelter", "retreat", "hide":
+		return EncounterShelter, true
+	default:
+		return "", false
+	}
+}
+
+// predatorDanger scales odds and injuries by how much the animal can hurt a person.
+func predatorDanger(species string) float64 {
+	name := strings.ToLower(species)
+	for _, big := range []string{"bear", "tiger", "jaguar", "lion", "leopard", "crocodile", "alligator"} {
+		if strings.Contains(name, big) {
+			return 1.3
+		}
+	}
+	for _, mid := range []string{"wolf", "cougar", "puma", "panther", "hyena"} {
+		if strings.Contains(name, mid) {
+			return 1.1
+		}
+	}
+	return 0.9
+}
+
+func carriedFood(player PlayerState) (InventoryItem, bool) {
+	best := InventoryItem{}
+	for _, item := range player.PersonalItems {
+		if item.Category == "food" && item.Qty*item.WeightKg > best.Qty*best.WeightKg {
+			best = item
+		}
+	}
+	return best, best.ID != ""
+}
+
+// predatorConfronts rolls whether a predator sighting comes close enough to force a choice.
+// Only large animals close in; sleepers are always confronted because the animal is already in camp.
+func (s *RunState) predatorConfronts(player *PlayerState, event WildlifeEncounter, action string, rollIndex int) bool {
+	if player == nil || !event.Predator || event.Channel != "mammal" || !player.Active() || s.Encounter != nil {
+		return false
+	}
+	if action == "sleep" {
+		return true
+	}
+	chance := confrontBaseChance
+	if s.CurrentTimeBlock() == TimeBlockNight {
+		chance += 0.15
+	}
+	if _, ok := carriedFood(*player); ok {
+		chance += 0.15
+	}
+	rng := seededRNG(seedFromLabel(s.Config.Seed, fmt.Sprintf("confront:%s:%d:%d:%d:%.2f", action, s.Day, player.ID, rollIndex, s.ClockHours)))
+	return rng.Float64() < chance
+}
+
+// startPredatorEncounter holds the run on the confrontation and returns the prompt.
+func (s *RunState) startPredatorEncounter(player *PlayerState, event WildlifeEncounter, action string, rollIndex int) string {
+	if player == nil || !player.Active() {
+		return ""
+	}
+	s.Encounter = &PredatorEncounter{
+		PlayerID:   player.ID,
+		Species:    event.Species,
+		Action:     action,
+		Day:        s.Day,
+		ClockHours: s.ClockHours,
+		Roll:       rollIndex,
+	}
+	return s.EncounterPrompt()
+}
+
+// openPredatorEncounter starts a confrontation when the predator closes in; it returns "" when it keeps its distance.
+func (s *RunState) openPredatorEncounter(player *PlayerState, event WildlifeEncounter, action string, rollIndex int) string {
+	if !s.predatorConfronts(player, event, action, rollIndex) {
+		return ""
+	}
+	return s.startPredatorEncounter(player, event, action, rollIndex)
+}
+
+// EncounterPrompt describes the pending confrontation and the choices open to the player.
+func (s *RunState) EncounterPrompt() string {
+	if s == nil || s.Encounter == nil {
+		return ""
+	}
+	name := fmt.Sprintf("P%d", s.Encounter.PlayerID)
+	if player, ok := s.playerByID(s.Encounter.PlayerID); ok {
+		name = fmt.Sprintf("P%d %s", player.ID, player.Name)
+	}
+	labels := make([]string, 0, 6)
+	for _, option := range s.EncounterOptions() {
+		labels = append(labels, fmt.Sprintf("%s (encounter %s)", option.Label, option.Choice))
+	}
+	return fmt.Sprintf("A %s is closing on %s! What do you do? %s.", strings.ToLower(s.Encounter.Species), name, strings.Join(labels, ", "))
+}
+
+// EncounterOptions lists the choices the player's kit, surroundings and camp allow right now.
+func (s *RunState) EncounterOptions() []EncounterOption {
+	if s == nil || s.Encounter == nil {
+		return nil
+	}
+	player, ok := s.playerByID(s.Encounter.PlayerID)
+	if !ok {
+		return nil
+	}
+	options := []EncounterOption{
+		{Choice: EncounterBackAway, Label: "Back away slowly"},
+		{Choice: EncounterNoise, Label: "Make noise and look big"},
+	}
+	if hasAnyKitItem(*player, s.Config.IssuedKit, KitWhistle) {
+		options = append(options, EncounterOption{Choice: EncounterWhistle, Label: "Blast the whistle"})
+	}
+	if s.treesAtPlayer(player) && player.Energy >= 20 {
+		options = append(options, EncounterOption{Choice: EncounterClimb, Label: "Climb a tree"})
+	}
+	if weapon := encounterWeapon(*player, s.Config.IssuedKit); weapon != "" {
+		options = append(options, EncounterOption{Choice: EncounterFight, Label: "Stand your ground with the " + strings.ToLower(string(weapon))})
+	}
+	if s.atShelter(player) {
+		options = append(options, EncounterOption{Choice: EncounterShelter, Label: "Retreat into the shelter"})
+	}
+	return options
+}
+
+func encounterWeapon(player PlayerState, issued []KitItem) KitItem {
+	switch {
+	case hasAnyKitItem(player, issued, KitBowArrows):
+		return KitBowArrows
+	case hasAnyKitItem(player, issued, KitSpear):
+		return KitSpear
+	default:
+		return ""
+	}
+}
+
+func (s *RunState) treesAtPlayer(player *PlayerState) bool {
+	cell, ok := s.TopologyCellAt(player.Travel.PosX, player.Travel.PosY)
+	if !ok {
+		return false
+	}
+	switch cell.Biome {
+	case TopoBiomeForest, TopoBiomeJungle, TopoBiomeBoreal, TopoBiomeSwamp:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *RunState) atShelter(player *PlayerState) bool {
+	if _, ok := s.currentShelterMetrics(); !ok {
+		return false
+	}
+	return player.Travel.PosX == s.Shelter.SiteX && player.Travel.PosY == s.Shelter.SiteY
+}
+
+func (s *RunState) campFireGuarding(player *PlayerState) bool {
+	if !s.Fire.Lit || s.Fire.Intensity < 20 {
+		return false
+	}
+	return s.playerAtCamp(player)
+}
+
+// encounterSuccessChance is the odds the predator gives up for a given choice.
+func (s *RunState) encounterSuccessChance(player PlayerState, choice EncounterChoice, danger float64) float64 {
+	chance := 0.0
+	switch choice {
+	case EncounterBackAway:
+		chance = 0.55 + float64(player.Agility)*0.05
+	case EncounterNoise:
+		chance = 0.5 + float64(player.Strength)*0.04 + float64(player.MentalStrength)*0.03
+	case EncounterWhistle:
+		chance = 0.72
+	case EncounterClimb:
+		chance = 0.45 + float64(player.Agility)*0.08 + float64(player.Strength)*0.03
+	case EncounterFight:
+		chance = 0.35 + float64(player.Hunting)/250 + float64(player.Strength)*0.05
+		if encounterWeapon(player, s.Config.IssuedKit) == KitBowArrows {
+			chance += 0.12
+		}
+	case EncounterShelter:
+		chance = 0.3
+		if metrics, ok := s.currentShelterMetrics(); ok {
+			chance += float64(metrics.PredatorSafety) * 0.08
+		}
+	}
+	if s.campFireGuarding(&player) {
+		chance += 0.15
+	}
+	if s.CurrentTimeBlock() == TimeBlockNight {
+		chance -= 0.1
+	}
+	if player.Energy < 25 {
+		chance -= 0.1
+	}
+	return clampFloat(chance/danger, 0.05, 0.95)
+}
+
+// ResolveEncounter plays out the player's choice: the predator leaves, steals food, or attacks.
+func (s *RunState) ResolveEncounter(choice EncounterChoice) (string, error) {
+	if s == nil || s.Encounter == nil {
+		return "", fmt.Errorf("there is no predator to deal with")
+	}
+	allowed := false
+	for _, option := range s.EncounterOptions() {
+		if option.Choice == choice {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return "", fmt.Errorf("you can't %s here", choice)
+	}
+	encounter := *s.Encounter
+	player, ok := s.playerByID(encounter.PlayerID)
+	if !ok || !player.Active() {
+		s.Encounter = nil
+		return "", fmt.Errorf("the player facing the %s is no longer in the run", strings.ToLower(encounter.Species))
+	}
+	s.Encounter = nil
+	s.recordEvent(player, EventPredatorFaced, 1)
+
+	danger := predatorDanger(encounter.Species)
+	species := strings.ToLower(encounter.Species)
+	rng := seededRNG(seedFromLabel(s.Config.Seed, fmt.Sprintf("encounter:%s:%d:%d:%d:%.2f", choice, encounter.Day, player.ID, encounter.Roll, encounter.ClockHours)))
+	success := s.encounterSuccessChance(*player, choice, danger)
+	lines := make([]string, 0, 3)
+
+	switch {
+	case rng.Float64() < success:
+		lines = append(lines, encounterSuccessLine(choice, species))
+		s.recordEvent(player, EventPredatorWon, 1)
+		if choice == EncounterFight {
+			s.trainSkill(player, SkillHunting, 6, true)
+			player.Morale = clamp(player.Morale+4, 0, 100)
+		} else {
+			player.Morale = clamp(player.Morale+1, 0, 100)
+		}
+	default:
+		food, carrying := carriedFood(*player)
+		if carrying && choice != EncounterFight && rng.Float64() < 0.6 {
+			if _, err := s.removePersonalInventoryItem(player.ID, food.ID, food.Qty); err == nil {
+				lines = append(lines, fmt.Sprintf("The %s charges, snatches your %s and is gone.", species, strings.ToLower(food.Name)))
+				player.Morale = clamp(player.Morale-6, 0, 100)
+				break
+			}
+		}
+		lines = append(lines, s.predatorInjury(player, choice, species, danger, rng.Float64()))
+	}
+	player.Energy = clamp(player.Energy-2, 0, 100)
+	refreshEffectBars(player)
+	if player.Active() {
+		s.AdvanceMinutes(encounterMinutes)
+	}
+	return strings.Join(lines, " "), nil
+}
+
+func encounterSuccessLine(choice EncounterChoice, species string) string {
+	switch choice {
+	case EncounterNoise:
+		return fmt.Sprintf("You roar and spread your arms. The %s hesitates, then turns away.", species)
+	case EncounterWhistle:
+		return fmt.Sprintf("The whistle shrieks. The %s flinches and bolts.", species)
+	case EncounterClimb:
+		return fmt.Sprintf("You scramble up a trunk. The %s circles below, then drifts off.", species)
+	case EncounterFight:
+		return fmt.Sprintf("You hold your ground and strike first. The wounded %s flees.", species)
+	case EncounterShelter:
+		return fmt.Sprintf("You get inside and brace the entrance. The %s sniffs around and leaves.", species)
+	default:
+		return fmt.Sprintf("You back away without turning. The %s watches, then loses interest.", species)
+	}
+}
+
+// predatorInjury applies the cost of a failed choice: a wound as an ailment, or removal from the run for the worst maulings.
+func (s *RunState) predatorInjury(player *PlayerState, choice EncounterChoice, species string, danger, roll float64) string {
+	cause := "mauled by " + species
+	severe := 0.08 * danger
+	if choice == EncounterFight {
+		severe *= 1.5
+	}
+	if roll < severe {
+		if roll < severe*predatorFatalChance {
+			s.removePlayer(player, ContestantDeceased, cause)
+		} else {
+			s.removePlayer(player, ContestantMedicallyExtracted, cause)
+		}
+		return fmt.Sprintf("The %s attacks P%d %s!", species, player.ID, player.Name)
+	}
+	// Dangerous animals leave deeper wounds.
+	ailment := newInjury(AilmentInjury, int(danger+0.8))
+	line := fmt.Sprintf("The %s lunges and rakes P%d %s before breaking off.", species, player.ID, player.Name)
+	if choice == EncounterClimb {
+		ailment = newInjury(AilmentSprain, 2)
+		line = fmt.Sprintf("A branch gives way and P%d %s falls hard; the %s backs off from the commotion.", player.ID, player.Name, species)
+	}
+	player.applyAilment(ailment)
+	player.Energy = clamp(player.Energy-int(8*danger), 0, 100)
+	player.Morale = clamp(player.Morale-int(8*danger), 0, 100)
+	return line
+}
+
+// expireEncounter settles a confrontation nobody answered once the clock moves on.
+func (s *RunState) expireEncounter() {
+	if s.Encounter == nil {
+		return
+	}
+	encounter := *s.Encounter
+	s.Encounter = nil
+	player, ok := s.playerByID(encounter.PlayerID)
+	if !ok {
+		return
+	}
+	event := WildlifeEncounter{Channel: "mammal", Species: encounter.Species, Predator: true}
+	if attack := s.applyPredatorRisk(player, event, encounter.Action, encounter.Roll); attack != "" {
+		s.reports = append(s.reports, attack)
+		return
+	}
+	s.reports = append(s.reports, fmt.Sprintf("P%d %s froze; the %s eventually wandered off.", player.ID, player.Name, strings.ToLower(encounter.Species)))
+}
+
+// encounterBareWords are the choice words that answer an encounter typed on their own. Words that are also
+// commands or things ("shelter", "back", "tree", "bow") only count after "encounter".
+var encounterBareWords = map[string]bool{
+	"backaway": true, "noise": true, "shout": true, "yell": true, "whistle": true, "climb": true, "fight": true, "hide": true,
+}
+
+// encounterRefusal holds every other command while a predator is close; a lone choice word resolves it directly.
+func (s *RunState) encounterRefusal(fields []string) (RunCommandResult, bool) {
+	if s.Encounter == nil || len(fields) == 0 {
+		return RunCommandResult{}, false
+	}
+	switch fields[0] {
+	case "encounter", "confront", "look", "inspect", "examine", "inventory", "help", "commands", "producer", "report":
+		return RunCommandResult{}, false
+	}
+	if len(fields) == 1 && encounterBareWords[fields[0]] {
+		return s.executeEncounterCommand(fields), true
+	}
+	choices := make([]string, 0, 6)
+	for _, option := range s.EncounterOptions() {
+		choices = append(choices, string(option.Choice))
+	}
+	return RunCommandResult{Handled: true, Message: fmt.Sprintf("Not with a %s closing in. Choose: encounter %s.", strings.ToLower(s.Encounter.Species), strings.Join(choices, "|")),
+		Err: fmt.Errorf("a %s is closing in", strings.ToLower(s.Encounter.Species))}, true
+}
+
+func (s *RunState) executeEncounterCommand(args []string) RunCommandResult {
+	if s.Encounter == nil {
+		return RunCommandResult{Handled: true, Message: "Nothing is threatening you right now."}
+	}
+	if len(args) == 0 {
+		return RunCommandResult{Handled: true, Message: s.EncounterPrompt()}
+	}
+	choice, ok := parseEncounterChoice(args[0])
+	if !ok {
+		return RunCommandResult{Handled: true, Message: s.EncounterPrompt()}
+	}
+	before := s.ClockHours
+	beforeDay := s.Day
+	msg, err := s.ResolveEncounter(choice)
+	if err != nil {
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("%s. %s", capitalizeTaskLabel(err.Error()), s.EncounterPrompt())}
+	}
+	hours := s.ClockHours - before + float64(s.Day-beforeDay)*24
+	return RunCommandResult{Handled: true, Message: msg, HoursAdvanced: hours}
+}
@@ -0,0 +1,134 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPredatorEncounterHoldsTheRunOnAChoice(t *testing.T) {
+	run := newOutcomeRun(t, ModeNakedAndAfraid, 1)
+	p1, _ := run.playerByID(1)
+	event := WildlifeEncounter{Channel: "mammal", Species: "Black Bear", Predator: true}
+
+	prompt := run.openPredatorEncounter(p1, event, "sleep", 0)
+	if prompt == "" || run.Encounter == nil || !strings.Contains(prompt, "black bear") {
+		t.Fatalf("expected a sleeping player to be confronted, got %q", prompt)
+	}
+	if again := run.openPredatorEncounter(p1, event, "sleep", 1); again != "" {
+		t.Fatalf("expected one confrontation at a time, got %q", again)
+	}
+
+	day, clock := run.Day, run.ClockHours
+	res := run.ExecuteRunCommand("forage berries")
+	if !strings.Contains(res.Message, "closing in") || run.Day != day || run.ClockHours != clock {
+		t.Fatalf("expected other actions to wait on the encounter, got %q", res.Message)
+	}
+	if res := run.ExecuteRunCommand("encounter whistle"); run.Encounter == nil || !strings.Contains(res.Message, "can't whistle") {
+		t.Fatalf("expected the whistle to need the kit, got %q", res.Message)
+	}
+
+	choices := map[EncounterChoice]bool{}
+	for _, option := range run.EncounterOptions() {
+		choices[option.Choice] = true
+	}
+	if !choices[EncounterBackAway] || !choices[EncounterNoise] || choices[EncounterWhistle] || choices[EncounterFight] {
+		t.Fatalf("unexpected options without kit: %+v", choices)
+	}
+	p1.Kit = append(p1.Kit, KitWhistle, KitSpear)
+	choices = map[EncounterChoice]bool{}
+	for _, option := range run.EncounterOptions() {
+		choices[option.Choice] = true
+	}
+	if !choices[EncounterWhistle] || !choices[EncounterFight] {
+		t.Fatalf("expected kit to unlock whistle and fight: %+v", choices)
+	}
+
+	res = run.ExecuteRunCommand("noise")
+	if run.Encounter != nil || res.HoursAdvanced <= 0 {
+		t.Fatalf("expected a bare choice word to resolve the encounter, got %+v", res)
+	}
+}
+
+func TestPredatorEncounterFailuresInjureOrTakeFood(t *testing.T) {
+	run := newOutcomeRun(t, ModeNakedAndAfraid, 1)
+	p1, _ := run.playerByID(1)
+	event := WildlifeEncounter{Channel: "mammal", Species: "Grizzly Bear", Predator: true}
+
+	successes, injuries, thefts := 0, 0, 0
+	for roll := 0; roll < 60 && p1.Active(); roll++ {
+		p1.Energy, p1.Hydration, p1.Morale = 80, 80, 80
+		p1.Ailments = nil
+		p1.PersonalItems = []InventoryItem{{ID: "cooked_meat", Name: "Cooked Meat", Unit: "kg", Qty: 0.5, WeightKg: 1, Category: "food"}}
+		run.startPredatorEncounter(p1, event, "sleep", roll)
+		msg, err := run.ResolveEncounter(EncounterBackAway)
+		if err != nil {
+			t.Fatalf("resolve: %v", err)
+		}
+		if run.Encounter != nil {
+			t.Fatalf("expected the encounter to close after a choice")
+		}
+		switch {
+		case len(p1.PersonalItems) == 0:
+			thefts++
+		case len(p1.Ailments) > 0:
+			if p1.Ailments[0].Type != AilmentInjury {
+				t.Fatalf("expected an injury ailment, got %+v", p1.Ailments[0])
+			}
+			injuries++
+		case p1.Active():
+			successes++
+		default:
+			if !strings.Contains(msg, "attacks") {
+				t.Fatalf("expected an attack line for a removal, got %q", msg)
+			}
+		}
+	}
+	if successes == 0 || injuries+thefts == 0 {
+		t.Fatalf("expected a mix of outcomes, got %d escapes, %d injuries, %d thefts", successes, injuries, thefts)
+	}
+}
+
+func TestCampFireAndShelterImproveEncounterOdds(t *testing.T) {
+	run := newOutcomeRun(t, ModeNakedAndAfraid, 1)
+	p1, _ := run.playerByID(1)
+	run.Encounter = &PredatorEncounter{PlayerID: 1, Species: "Gray Wolf"}
+
+	base := run.encounterSuccessChance(*p1, EncounterNoise, predatorDanger("Gray Wolf"))
+	run.Fire = FireState{Lit: true, Intensity: 60}
+	if lit := run.encounterSuccessChance(*p1, EncounterNoise, predatorDanger("Gray Wolf")); lit <= base {
+		t.Fatalf("expected a camp fire to help, %.2f vs %.2f", lit, base)
+	}
+	if predatorDanger("Grizzly Bear") <= predatorDanger("Coyote") {
+		t.Fatalf("expected bears to be more dangerous than coyotes")
+	}
+}
+
+func TestUnansweredEncounterFallsBackToAttackRoll(t *testing.T) {
+	run := newOutcomeRun(t, ModeNakedAndAfraid, 1)
+	p1, _ := run.playerByID(1)
+	run.openPredatorEncounter(p1, WildlifeEncounter{Channel: "mammal", Species: "Cougar", Predator: true}, "sleep", 0)
+
+	run.AdvanceMinutes(30)
+	if run.Encounter != nil {
+		t.Fatalf("expected the clock moving on to settle the encounter")
+	}
+	reports := strings.Join(run.DrainReports(), "\n")
+	if !strings.Contains(reports, "cougar") {
+		t.Fatalf("expected a report about the cougar, got %q", reports)
+	}
+}
+
+func TestOnlyLoneChoiceWordsAnswerAnEncounter(t *testing.T) {
+	run := newOutcomeRun(t, ModeNakedAndAfraid, 1)
+	p1, _ := run.playerByID(1)
+	run.openPredatorEncounter(p1, WildlifeEncounter{Channel: "mammal", Species: "Gray Wolf", Predator: true}, "sleep", 0)
+
+	for _, command := range []string{"shelter build lean_to", "back", "tree", "bow", "climb the tree"} {
+		if res := run.ExecuteRunCommand(command); run.Encounter == nil || !strings.Contains(res.Message, "closing in") {
+			t.Fatalf("expected %q to be held back, got %q", command, res.Message)
+		}
+	}
+	if res := run.ExecuteRunCommand("encounter back"); run.Encounter != nil || res.HoursAdvanced <= 0 {
+		t.Fatalf("expected encounter back to resolve it, got %+v", res)
+	}
+}
@@ -0,0 +1,435 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Discovery summary:
+// - The Signal Mirror and Whistle actions only nudged morale; rescue needs someone to signal to, so scenarios carry patrol windows.
+// - WeatherState has no fog type; morning low cloud or drizzle over wet ground stands in for fog when visibility is worked out.
+// - Openness comes from the TopoCell the party stands on (biome, water, elevation); smoke from FireState and the wood's TreeSpec.SmokeFactor.
+// - A patrol that sees a signal ends the run with RunOutcomeRescued, and everyone still in leaves as rescued.
+type PatrolKind string
+
+const (
+	PatrolAircraft PatrolKind = "aircraft"
+	PatrolBoat     PatrolKind = "boat"
+)
+
+// PatrolWindow is a recurring pass by an aircraft or boat that a signal can catch.
+type PatrolWindow struct {
+	Kind      PatrolKind `json:"kind"`
+	FromDay   int        `json:"from_day"`
+	EveryDays int        `json:"every_days"`
+	StartHour float64    `json:"start_hour"`
+	EndHour   float64    `json:"end_hour"`
+}
+
+type SignalMethod string
+
+const (
+	SignalMirror  SignalMethod = "mirror"
+	SignalWhistle SignalMethod = "whistle"
+	SignalFire    SignalMethod = "fire"
+)
+
+const (
+	signalMinutes     = 20
+	signalFireMinutes = 30
+	signalFireWoodKg  = 1.0
+	patrolLookAhead   = 14
+)
+
+// RescueRecord is how the run ended when a patrol picked the party up.
+type RescueRecord struct {
+	PlayerID   int          `json:"player_id"`
+	Method     SignalMethod `json:"method"`
+	Patrol     PatrolKind   `json:"patrol"`
+	Day        int          `json:"day"`
+	ClockHours float64      `json:"clock_hours"`
+}
+
+func (r RescueRecord) cause() string {
+	return fmt.Sprintf("flagged down a passing %s with the %s", r.Patrol, signalMethodLabel(r.Method))
+}
+
+func patrolArticle(kind PatrolKind) string {
+	if kind == PatrolAircraft {
+		return "an aircraft"
+	}
+	return "a " + string(kind)
+}
+
+func signalMethodLabel(method SignalMethod) string {
+	switch method {
+	case SignalMirror:
+		return "signal mirror"
+	case SignalFire:
+		return "signal fire"
+	default:
+		return string(method)
+	}
+}
+
+// defaultPatrolWindows gives a scenario without its own schedule a search plane, and boats where there is water to run them on.
+func defaultPatrolWindows(biome string) []PatrolWindow {
+	norm := normalizeBiome(biome)
+	aircraft := PatrolWindow{Kind: PatrolAircraft, FromDay: 3, EveryDays: 3, StartHour: 10, EndHour: 13}
+	if strings.Contains(norm, "desert") {
+		aircraft.EveryDays = 4
+	}
+	windows := []PatrolWindow{aircraft}
+	for _, tag := range []string{"coast", "lake", "delta", "island", "river", "swamp", "wetland"} {
+		if strings.Contains(norm, tag) {
+			windows = append(windows, PatrolWindow{Kind: PatrolBoat, FromDay: 2, EveryDays: 2, StartHour: 7, EndHour: 10})
+			break
+		}
+	}
+	return windows
+}
+
+var builtInPatrolsByScenarioID = map[ScenarioID][]PatrolWindow{
+	"naa_philippines": {
+		{Kind: PatrolBoat, FromDay: 2, EveryDays: 1, StartHour: 6, EndHour: 9},
+		{Kind: PatrolAircraft, FromDay: 5, EveryDays: 5, StartHour: 11, EndHour: 13},
+	},
+	"mackenzie_delta": {
+		{Kind: PatrolBoat, FromDay: 4, EveryDays: 4, StartHour: 8, EndHour: 12},
+		{Kind: PatrolAircraft, FromDay: 7, EveryDays: 7, StartHour: 12, EndHour: 14},
+	},
+}
+
+func builtInScenarioPatrols(id ScenarioID, biome string) []PatrolWindow {
+	if windows, ok := builtInPatrolsByScenarioID[id]; ok {
+		return append([]PatrolWindow(nil), windows...)
+	}
+	return defaultPatrolWindows(biome)
+}
+
+// patrolWindows is the scenario's schedule; custom scenarios without one fall back to the biome defaults.
+func (s *RunState) patrolWindows() []PatrolWindow {
+	if len(s.Scenario.Patrols) > 0 {
+		return s.Scenario.Patrols
+	}
+	return defaultPatrolWindows(s.Scenario.Biome)
+}
+
+func (w PatrolWindow) passes(day int, hour float64) bool {
+	every := w.EveryDays
+	if every < 1 {
+		every = 1
+	}
+	if day < w.FromDay || (day-w.FromDay)%every != 0 {
+		return false
+	}
+	return hour >= w.StartHour && hour < w.EndHour
+}
+
+// ActivePatrol returns the patrol passing right now, if any.
+func (s *RunState) ActivePatrol() (PatrolWindow, bool) {
+	for _, window := range s.patrolWindows() {
+		if window.passes(s.Day, s.ClockHours) {
+			return window, true
+		}
+	}
+	return PatrolWindow{}, false
+}
+
+// nextPatrol finds the next patrol to start after the current time.
+func (s *RunState) nextPatrol() (PatrolWindow, int, bool) {
+	for day := s.Day; day <= s.Day+patrolLookAhead; day++ {
+		var best PatrolWindow
+		found := false
+		for _, window := range s.patrolWindows() {
+			if !window.passes(day, window.StartHour) || (day == s.Day && window.StartHour <= s.ClockHours) {
+				continue
+			}
+			if !found || window.StartHour < best.StartHour {
+				best, found = window, true
+			}
+		}
+		if found {
+			return best, day, true
+		}
+	}
+	return PatrolWindow{}, 0, false
+}
+
+// announcePatrol reports a patrol coming into range once the clock has moved into its window.
+func (s *RunState) announcePatrol(wasPassing bool) {
+	window, ok := s.ActivePatrol()
+	if !ok || wasPassing || s.Rescue != nil || s.activePlayerCount() == 0 {
+		return
+	}
+	sound := "You hear an aircraft droning somewhere overhead."
+	if window.Kind == PatrolBoat {
+		sound = "An outboard motor carries across the water."
+	}
+	s.reports = append(s.reports, sound+" Signal now: signal mirror|whistle|fire.")
+}
+
+// foggy stands in for fog: morning low cloud or drizzle over wet ground.
+func (s *RunState) foggy(cell TopoCell) bool {
+	if s.ClockHours >= 11 || cell.Moisture < 180 {
+		return false
+	}
+	return s.Weather.Type == WeatherCloudy || s.Weather.Type == WeatherRain
+}
+
+// signalVisibility is how far a signal carries through the current weather, 1 being a clear day.
+func (s *RunState) signalVisibility(cell TopoCell) float64 {
+	if s.foggy(cell) {
+		return 0.15
+	}
+	switch s.Weather.Type {
+	case WeatherSunny, WeatherClear:
+		return 1.0
+	case WeatherHeatwave:
+		return 0.85
+	case WeatherWindy:
+		return 0.75
+	case WeatherCloudy:
+		return 0.65
+	case WeatherRain, WeatherSnow:
+		return 0.4
+	case WeatherHeavyRain:
+		return 0.2
+	default:
+		return 0.08
+	}
+}
+
+// signalOpenness is how exposed the party's position is: shorelines and open ground carry a signal, canopy hides it.
+func signalOpenness(cell TopoCell) float64 {
+	open := 0.7
+	switch {
+	case cell.Flags&(TopoFlagWater|TopoFlagCoast) != 0:
+		open = 1.0
+	case cell.Biome == TopoBiomeJungle:
+		open = 0.35
+	case cell.Biome == TopoBiomeForest || cell.Biome == TopoBiomeBoreal || cell.Biome == TopoBiomeSwamp:
+		open = 0.55
+	case cell.Biome == TopoBiomeWetland:
+		open = 0.8
+	case cell.Biome == TopoBiomeMountain:
+		open = 0.85
+	case cell.Biome == TopoBiomeGrassland || cell.Biome == TopoBiomeDesert || cell.Biome == TopoBiomeTundra:
+		open = 1.0
+	}
+	if cell.Elevation > 0 {
+		open += float64(cell.Elevation) / 250
+	}
+	return clampFloat(open, 0.2, 1.3)
+}
+
+func (s *RunState) signalCell(player *PlayerState) TopoCell {
+	x, y := player.Travel.PosX, player.Travel.PosY
+	if cell, ok := s.TopologyCellAt(x, y); ok {
+		return cell
+	}
+	return TopoCell{Biome: TopoBiomeUnknown, Moisture: 120}
+}
+
+// fireSmokeFactor is the smoke the burning wood gives off, from the trees of that wood type that grow here.
+func (s *RunState) fireSmokeFactor() float64 {
+	total, count := 0.0, 0
+	for _, tree := range TreesForBiome(s.Scenario.Biome) {
+		if tree.WoodType == s.Fire.WoodType && tree.SmokeFactor > 0 {
+			total += tree.SmokeFactor
+			count++
+		}
+	}
+	if count == 0 {
+		return 1.0
+	}
+	return total / float64(count)
+}
+
+// signalChance works out the odds that the passing patrol notices. It returns a reason when the method can't be used at all.
+func (s *RunState) signalChance(player *PlayerState, method SignalMethod, patrol PatrolWindow) (float64, string) {
+	cell := s.signalCell(player)
+	visibility := s.signalVisibility(cell)
+	openness := signalOpenness(cell)
+	switch method {
+	case SignalMirror:
+		if !s.kitUsable(player, KitSignalMirror) {
+			return 0, fmt.Sprintf("P%d has no working signal mirror", player.ID)
+		}
+		if s.CurrentTimeBlock() == TimeBlockNight {
+			return 0, "there's no sun to flash at night"
+		}
+		sun := 0.0
+		switch s.Weather.Type {
+		case WeatherSunny, WeatherClear, WeatherHeatwave:
+			sun = 1.0
+		case WeatherWindy:
+			sun = 0.8
+		case WeatherCloudy:
+			sun = 0.35
+		}
+		if sun == 0 || s.foggy(cell) {
+			return 0, "there's no sun to catch in the mirror"
+		}
+		return clampFloat(0.6*sun*visibility*openness+float64(player.Navigation)*0.02, 0, 0.9), ""
+	case SignalWhistle:
+		if !s.kitUsable(player, KitWhistle) {
+			return 0, fmt.Sprintf("P%d has no working whistle", player.ID)
+		}
+		if patrol.Kind != PatrolBoat {
+			return 0, "a whistle won't carry to an aircraft"
+		}
+		if cell.Flags&(TopoFlagWater|TopoFlagCoast) == 0 {
+			return 0, "you need to be at the water's edge for a boat to hear a whistle"
+		}
+		audible := 1.0
+		switch s.Weather.Type {
+		case WeatherWindy:
+			audible = 0.6
+		case WeatherHeavyRain, WeatherStorm, WeatherBlizzard:
+			audible = 0.3
+		}
+		return clampFloat(0.4*audible, 0, 0.9), ""
+	case SignalFire:
+		if !s.Fire.Lit {
+			return 0, "you need a lit fire to make signal smoke"
+		}
+		smoke := float64(s.Fire.Intensity) / 100
+		if s.craftedUsable("signal_fire") {
+			smoke += 1.2
+		}
+		if s.craftedUsable("signal_beacon") {
+			smoke += 0.4
+		}
+		smoke *= s.fireSmokeFactor()
+		if s.Weather.Type == WeatherWindy {
+			smoke *= 0.7
+		}
+		// Smoke climbs above the canopy, so cover matters less than for a mirror.
+		openness = clampFloat(openness, 0.6, 1.3)
+		return clampFloat((0.2+0.35*smoke)*visibility*openness+float64(player.Firecraft)*0.02, 0, 0.9), ""
+	}
+	return 0, fmt.Sprintf("unknown signal %q", method)
+}
+
+func parseSignalMethod(raw string) (SignalMethod, bool) {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "mirror", "flash":
+		return SignalMirror, true
+	case "whistle", "blow":
+		return SignalWhistle, true
+	case "fire", "smoke":
+		return SignalFire, true
+	}
+	return "", false
+}
+
+// Signal tries to catch a passing patrol's attention. A signal nobody sees still costs the time.
+func (s *RunState) Signal(playerID int, method SignalMethod) (string, error) {
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return "", fmt.Errorf("player %d not found", playerID)
+	}
+	if !player.Active() {
+		return "", fmt.Errorf("%s is out of the run", player.Name)
+	}
+	patrol, passing := s.ActivePatrol()
+	if !passing {
+		return "", fmt.Errorf("nobody is passing to see a signal (%s)", s.patrolHint())
+	}
+	chance, reason := s.signalChance(player, method, patrol)
+	if reason != "" {
+		return "", fmt.Errorf("%s", reason)
+	}
+	minutes := signalMinutes
+	if method == SignalFire {
+		minutes = signalFireMinutes
+		if s.craftedUsable("signal_fire") {
+			s.CraftedItems = removeCraftedID(s.CraftedItems, "signal_fire")
+			_, _ = s.removeCampInventoryItem("signal_fire", 1)
+		} else if _, _, ok := s.consumeAnyWoodPreferDry(signalFireWoodKg); !ok {
+			return "", fmt.Errorf("pile on wood for smoke: need %.1fkg in stock, or craft a signal fire", signalFireWoodKg)
+		}
+	}
+
+	rng := seededRNG(seedFromLabel(s.Config.Seed, fmt.Sprintf("signal:%s:%d:%d:%.2f", method, player.ID, s.Day, s.ClockHours)))
+	if rng.Float64() < chance {
+		s.Rescue = &RescueRecord{PlayerID: player.ID, Method: method, Patrol: patrol.Kind, Day: s.Day, ClockHours: s.ClockHours}
+		for i := range s.Players {
+			s.removePlayer(&s.Players[i], ContestantRescued, s.Rescue.cause())
+		}
+		return fmt.Sprintf("The %s turns toward the %s. You've been seen!", patrol.Kind, signalMethodLabel(method)), nil
+	}
+
+	player.Morale = clamp(player.Morale-2, 0, 100)
+	refreshEffectBars(player)
+	s.AdvanceMinutes(minutes)
+	return fmt.Sprintf("P%d works the %s, but the %s carries on without turning.", player.ID, signalMethodLabel(method), patrol.Kind), nil
+}
+
+func removeCraftedID(items []string, id string) []string {
+	for i, item := range items {
+		if item == id {
+			return append(items[:i:i], items[i+1:]...)
+		}
+	}
+	return items
+}
+
+// patrolHint tells the player when to be ready with a signal.
+func (s *RunState) patrolHint() string {
+	window, day, ok := s.nextPatrol()
+	if !ok {
+		return "no patrols expected"
+	}
+	when := fmt.Sprintf("day %d", day)
+	if day == s.Day {
+		when = "today"
+	}
+	return fmt.Sprintf("next patrol: %s %s around %s", patrolArticle(window.Kind), when, formatClockHours(window.StartHour))
+}
+
+// SignalStatus describes the patrol situation and how well each signal would carry right now.
+func (s *RunState) SignalStatus(playerID int) string {
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return fmt.Sprintf("Player %d not found.", playerID)
+	}
+	cell := s.signalCell(player)
+	parts := []string{fmt.Sprintf("Visibility %.0f%%, openness %.0f%%.", s.signalVisibility(cell)*100, signalOpenness(cell)*100)}
+	patrol, passing := s.ActivePatrol()
+	if !passing {
+		parts = append(parts, capitalizeTaskLabel(s.patrolHint())+".")
+		return strings.Join(parts, " ")
+	}
+	parts = append(parts, capitalizeTaskLabel(patrolArticle(patrol.Kind))+" is passing now.")
+	ready := make([]string, 0, 3)
+	for _, method := range []SignalMethod{SignalMirror, SignalWhistle, SignalFire} {
+		if _, reason := s.signalChance(player, method, patrol); reason == "" {
+			ready = append(ready, signalMethodLabel(method))
+		}
+	}
+	if len(ready) == 0 {
+		ready = append(ready, "nothing")
+	}
+	parts = append(parts, "Ready to signal: "+strings.Join(ready, ", ")+".")
+	return strings.Join(parts, " ")
+}
+
+func (s *RunState) executeSignalCommand(args []string) RunCommandResult {
+	playerID, tokens := extractPlayerID(args)
+	if len(tokens) == 0 {
+		return RunCommandResult{Handled: true, Message: s.SignalStatus(playerID)}
+	}
+	method, ok := parseSignalMethod(tokens[0])
+	if !ok {
+		return RunCommandResult{Handled: true, Message: "Usage: signal [mirror|whistle|fire] [p#]", Err: errCommandUsage}
+	}
+	before, beforeDay := s.ClockHours, s.Day
+	msg, err := s.Signal(playerID, method)
+	if err != nil {
+		return RunCommandResult{Handled: true, Message: capitalizeTaskLabel(err.Error()) + "."}
+	}
+	hours := s.ClockHours - before + float64(s.Day-beforeDay)*24
+	return RunCommandResult{Handled: true, Message: msg, HoursAdvanced: hours}
+}
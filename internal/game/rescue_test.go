@@ -0,0 +1,117 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPatrolWindowsComeFromTheScenario(t *testing.T) {
+	scenarios := BuiltInScenarios()
+	island, _ := GetScenario(scenarios, "naa_philippines")
+	desert, _ := GetScenario(scenarios, "naa_namibia")
+	hasBoat := func(windows []PatrolWindow) bool {
+		for _, w := range windows {
+			if w.Kind == PatrolBoat {
+				return true
+			}
+		}
+		return false
+	}
+	if !hasBoat(island.Patrols) || hasBoat(desert.Patrols) || len(desert.Patrols) == 0 {
+		t.Fatalf("expected boats off the island and only aircraft over the desert: %+v / %+v", island.Patrols, desert.Patrols)
+	}
+
+	run := newOutcomeRun(t, ModeNakedAndAfraid, 1)
+	run.Scenario.Patrols = []PatrolWindow{{Kind: PatrolAircraft, FromDay: 2, EveryDays: 2, StartHour: 10, EndHour: 12}}
+	run.Day, run.ClockHours = 3, 11
+	if _, ok := run.ActivePatrol(); ok {
+		t.Fatalf("expected no patrol on an off day")
+	}
+	run.Day = 4
+	if _, ok := run.ActivePatrol(); !ok {
+		t.Fatalf("expected the aircraft on day 4 at 11:00")
+	}
+	run.Scenario.Patrols = nil
+	if len(run.patrolWindows()) == 0 {
+		t.Fatalf("expected a custom scenario without patrols to fall back to the biome defaults")
+	}
+}
+
+func TestSignalsNeedAPatrolAndTheRightConditions(t *testing.T) {
+	run := newOutcomeRun(t, ModeNakedAndAfraid, 1)
+	p1, _ := run.playerByID(1)
+	p1.Kit = append(p1.Kit, KitSignalMirror)
+	run.Scenario.Patrols = []PatrolWindow{{Kind: PatrolAircraft, FromDay: 1, EveryDays: 1, StartHour: 8, EndHour: 16}}
+
+	run.ClockHours = 20
+	if res := run.ExecuteRunCommand("signal mirror"); !strings.Contains(res.Message, "next patrol") || res.HoursAdvanced != 0 {
+		t.Fatalf("expected a refusal with the next patrol, got %+v", res)
+	}
+
+	run.ClockHours = 12
+	run.Weather.Type = WeatherRain
+	if res := run.ExecuteRunCommand("signal mirror"); !strings.Contains(res.Message, "no sun") {
+		t.Fatalf("expected the mirror to need sun, got %q", res.Message)
+	}
+	if res := run.ExecuteRunCommand("signal whistle"); !strings.Contains(res.Message, "no working whistle") {
+		t.Fatalf("expected the whistle to need the kit, got %q", res.Message)
+	}
+
+	cell := TopoCell{Moisture: 220}
+	run.ClockHours = 8.5
+	run.Weather.Type = WeatherCloudy
+	foggy := run.signalVisibility(cell)
+	run.Weather.Type = WeatherClear
+	if clear := run.signalVisibility(cell); foggy >= clear {
+		t.Fatalf("expected morning fog to cut visibility: %.2f vs %.2f", foggy, clear)
+	}
+	if signalOpenness(TopoCell{Biome: TopoBiomeJungle}) >= signalOpenness(TopoCell{Biome: TopoBiomeForest, Flags: TopoFlagCoast}) {
+		t.Fatalf("expected a shoreline to be more open than jungle")
+	}
+}
+
+func TestSignalFireSmokeBeatsAPlainCampFire(t *testing.T) {
+	run := newOutcomeRun(t, ModeNakedAndAfraid, 1)
+	p1, _ := run.playerByID(1)
+	patrol := PatrolWindow{Kind: PatrolAircraft}
+	run.Weather.Type = WeatherClear
+
+	if _, reason := run.signalChance(p1, SignalFire, patrol); !strings.Contains(reason, "lit fire") {
+		t.Fatalf("expected smoke to need a fire, got %q", reason)
+	}
+	run.Fire = FireState{Lit: true, Intensity: 40, WoodType: WoodTypeResinous}
+	plain, _ := run.signalChance(p1, SignalFire, patrol)
+	run.CraftedItems = append(run.CraftedItems, "signal_fire")
+	prepared, _ := run.signalChance(p1, SignalFire, patrol)
+	if prepared <= plain {
+		t.Fatalf("expected a laid signal fire to smoke more: %.2f vs %.2f", prepared, plain)
+	}
+}
+
+func TestSeenSignalEndsTheRunWithEveryoneRescued(t *testing.T) {
+	run := newOutcomeRun(t, ModeNakedAndAfraid, 2)
+	p1, _ := run.playerByID(1)
+	p1.Kit = append(p1.Kit, KitSignalMirror)
+	run.Scenario.Patrols = []PatrolWindow{{Kind: PatrolAircraft, FromDay: 1, EveryDays: 1, StartHour: 9, EndHour: 17}}
+
+	for day := 0; day < 20 && run.Rescue == nil; day++ {
+		run.Day, run.ClockHours = 2+day, 9
+		for run.Rescue == nil && run.ClockHours < 16 {
+			run.Weather.Type = WeatherSunny
+			p1.Morale = 80
+			if _, err := run.Signal(1, SignalMirror); err != nil {
+				t.Fatalf("signal: %v", err)
+			}
+		}
+	}
+	if run.Rescue == nil {
+		t.Fatalf("expected a sunny aircraft pass to see the mirror eventually")
+	}
+	if outcome := run.EvaluateRun(); outcome.Status != RunOutcomeRescued {
+		t.Fatalf("expected a rescued outcome, got %+v", outcome)
+	}
+	lines := strings.Join(run.Summary().Lines(), "\n")
+	if strings.Count(lines, "rescued") < 2 || !strings.Contains(lines, "signal mirror") {
+		t.Fatalf("expected both players rescued in the summary:\n%s", lines)
+	}
+}
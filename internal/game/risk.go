@@ -53,7 +53,13 @@ func CalculateMovementRisk(player *PlayerState, weather WeatherState, clockHours
 		score += (weather.TemperatureC - 35) // Heat also risky
 	}
 
-	// TODO: Wetness - Not directly tracked on player yet, hook in when available.
+	// Wet clothes and a cooling or overheating core
+	score += int(player.Wetness / 4)
+	if core := player.CoreTemperature(); core < 36 {
+		score += int((36 - core) * 10)
+	} else if core > 38 {
+		score += int((core - 38) * 10)
+	}
 
 	// Daylight
 	// Assuming night is roughly 19:00 to 05:00.
@@ -1,8 +1,10 @@
 package game
 
 import (
+	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -16,8 +18,13 @@ type RunCommandResult struct {
 	Handled       bool
 	Message       string
 	HoursAdvanced float64
+	// Err is set when the command was understood but could not be carried out (errCommandUsage for bad
+	// arguments), so callers acting on the outcome don't depend on the message wording.
+	Err error
 }
 
+var errCommandUsage = errors.New("usage")
+
 type equipmentAction struct {
 	ID          string
 	Aliases     []string
@@ -31,9 +38,25 @@ type equipmentAction struct {
 
 const (
 	specialTreatAilment = "treat_ailment"
+	specialRepairHard   = "repair_hard"
+	specialRepairFabric = "repair_fabric"
+	specialPatchGear    = "patch_gear"
+	specialSignal       = "signal"
 )
 
+// ExecuteRunCommand runs a command and appends any delegated-task results that finished while it took time.
 func (s *RunState) ExecuteRunCommand(raw string) RunCommandResult {
+	res := s.dispatchRunCommand(raw)
+	if !res.Handled {
+		return res
+	}
+	if reports := s.DrainReports(); len(reports) > 0 {
+		res.Message = strings.TrimSpace(res.Message + "\n" + strings.Join(reports, "\n"))
+	}
+	return res
+}
+
+func (s *RunState) dispatchRunCommand(raw string) RunCommandResult {
 	command := strings.TrimSpace(strings.ToLower(raw))
 	if command == "" {
 		return RunCommandResult{Handled: false}
@@ -43,12 +66,18 @@ func (s *RunState) ExecuteRunCommand(raw string) RunCommandResult {
 	if len(fields) == 0 {
 		return RunCommandResult{Handled: false}
 	}
+	if refusal, refused := s.inactivePlayerRefusal(fields); refused {
+		return refusal
+	}
+	if refusal, refused := s.encounterRefusal(fields); refused {
+		return refusal
+	}
 
 	switch fields[0] {
 	case "commands", "help":
 		return RunCommandResult{
 			Handled: true,
-			Message: "Commands: look [left|right|front|back], look closer at <plants|trees|insects|water>, hunt land|fish|air [p#], fish [p#], forage [roots|berries|fruits|vegetables|any] [p#] [grams], trees, plants, wood gather|dry|stock [kg] [p#], resources, collect <resource|any> [qty] [p#], bark strip [tree|any] [qty] [p#], inventory camp|personal|stash|take|add|drop [..], trap list|set|status|check [..], gut <carcass> [kg] [p#], cook <raw_meat> [kg] [p#], preserve <smoke|dry|salt> <meat> [kg] [p#], eat <food_item> [grams|kg] [p#], go <n|s|e|w> [km] [p#], fire status|methods|prep|ember|ignite|build|tend|out, shelter list|build|status, craft list|make|inventory, actions [p#], use <item> <action> [p#], ask <player> <task>, next, save, load, menu.",
+			Message: "Commands: look [left|right|front|back], look closer at <plants|trees|insects|water>, hunt land|fish|air [p#], fish [p#], forage [roots|berries|fruits|vegetables|any] [p#] [grams], trees, plants, wood gather|dry|stock [kg] [p#], resources, collect <resource|any> [qty] [p#], bark strip [tree|any] [qty] [p#], inventory camp|personal|stash|take|add|drop [..], trap list|set|status|check [..], gut <carcass> [kg] [p#], cook <raw_meat> [kg] [p#], preserve <smoke|dry|salt> <meat> [kg] [p#], eat <food_item> [grams|kg] [p#], drink [boiled|treated|filtered|raw] [litres|ml] [p#], water status|collect|boil|filter|treat [litres] [p#], sleep|rest|nap [hours] [p#], go <n|s|e|w> [km] [p#], fire status|methods|prep|ember|ignite|build|tend|out, shelter list|build|status, camp [status|move], craft list|make|inventory, actions [p#], use <item> <action> [p#], ask <p#> <task>|status|stop, signal [mirror|whistle|fire] [p#], treat [clean|kit|herb [plant]] [p#], weather, forecast [p#], skills [p#], teach <p#> <skill> [by p#], tap out [p#], encounter back|noise|whistle|climb|fight|shelter, producer, next, save, load, menu.",
 		}
 	case "look", "inspect", "examine":
 		return s.executeLookCommand(fields[0], fields[1:])
@@ -63,11 +92,11 @@ func (s *RunState) ExecuteRunCommand(raw string) RunCommandResult {
 	case "exit", "leave":
 		return s.executeExitCommand(fields[1:])
 	case "trees":
-		return s.executeTreesCommand()
+		return s.executeTreesCommand(fields[1:])
 	case "plants":
-		return s.executePlantsCommand()
+		return s.executePlantsCommand(fields[1:])
 	case "resources":
-		return s.executeResourcesCommand()
+		return s.executeResourcesCommand(fields[1:])
 	case "collect":
 		return s.executeCollectCommand(fields[1:])
 	case "bark":
@@ -88,12 +117,20 @@ func (s *RunState) ExecuteRunCommand(raw string) RunCommandResult {
 		return s.executePreserveCommand(fields)
 	case "eat":
 		return s.executeEatCommand(fields[1:])
+	case "drink", "sip":
+		return s.executeDrinkCommand(fields[0], fields[1:])
+	case "water":
+		return s.executeWaterCommand(fields[1:])
+	case "sleep", "rest", "nap":
+		return s.executeSleepCommand(parseSleepMode(fields[0]), fields[1:])
 	case "go":
 		return s.executeGoCommand(fields[1:])
 	case "fire":
 		return s.executeFireCommand(fields[1:])
 	case "shelter":
 		return s.executeShelterCommand(fields[1:])
+	case "camp":
+		return s.executeCampCommand(fields[1:])
 	case "craft":
 		return s.executeCraftCommand(fields[1:])
 	case "actions":
@@ -108,29 +145,27 @@ func (s *RunState) ExecuteRunCommand(raw string) RunCommandResult {
 		return s.executeUseCommand(fields)
 	case "ask":
 		return s.executeAskCommand(fields[1:])
+	case "tap", "tapout":
+		return s.executeTapCommand(fields[1:])
+	case "encounter", "confront":
+		return s.executeEncounterCommand(fields[1:])
+	case "signal":
+		return s.executeSignalCommand(fields[1:])
+	case "treat", "heal":
+		return s.executeTreatCommand(fields[1:])
+	case "weather", "forecast":
+		return s.executeWeatherCommand(fields[0], fields[1:])
+	case "skills":
+		return s.executeSkillsCommand(fields[1:])
+	case "teach", "train":
+		return s.executeTeachCommand(fields[1:])
+	case "producer", "report":
+		return s.executeProducerCommand()
 	default:
 		return RunCommandResult{Handled: false}
 	}
 }
 
-func (s *RunState) executeAskCommand(args []string) RunCommandResult {
-	if len(args) < 2 {
-		return RunCommandResult{Handled: true, Message: "Usage: ask <player> <task>"}
-	}
-	target := strings.TrimSpace(args[0])
-	task := strings.TrimSpace(strings.Join(args[1:], " "))
-	if task == "" {
-		return RunCommandResult{Handled: true, Message: "Usage: ask <player> <task>"}
-	}
-	name := target
-	if pid := parsePlayerToken(target); pid > 0 {
-		if player, ok := s.playerByID(pid); ok {
-			name = player.Name
-		}
-	}
-	return RunCommandResult{Handled: true, Message: fmt.Sprintf("%s isn't ready for delegated tasks yet (%s).", name, task)}
-}
-
 func (s *RunState) listActionsForPlayer(playerID int) RunCommandResult {
 	player, ok := s.playerByID(playerID)
 	if !ok {
@@ -150,11 +185,16 @@ func (s *RunState) listActionsForPlayer(playerID int) RunCommandResult {
 		if len(actions) == 0 {
 			continue
 		}
+		condition := s.KitCondition(player, item)
+		if condition <= 0 {
+			parts = append(parts, fmt.Sprintf("%s: broken", itemCommandLabel(item)))
+			continue
+		}
 		actionNames := make([]string, 0, len(actions))
 		for _, action := range actions {
 			actionNames = append(actionNames, action.ID)
 		}
-		parts = append(parts, fmt.Sprintf("%s: %s", itemCommandLabel(item), strings.Join(actionNames, ",")))
+		parts = append(parts, fmt.Sprintf("%s%s: %s", itemCommandLabel(item), GearConditionLabel(condition), strings.Join(actionNames, ",")))
 	}
 
 	if len(parts) == 0 {
@@ -169,12 +209,12 @@ func (s *RunState) listActionsForPlayer(playerID int) RunCommandResult {
 
 func (s *RunState) executeUseCommand(fields []string) RunCommandResult {
 	if len(fields) < 3 {
-		return RunCommandResult{Handled: true, Message: "Usage: use <item> <action> [p#]"}
+		return RunCommandResult{Handled: true, Message: "Usage: use <item> <action> [p#]", Err: errCommandUsage}
 	}
 
 	playerID, tokens := extractPlayerID(fields[1:])
 	if len(tokens) < 2 {
-		return RunCommandResult{Handled: true, Message: "Usage: use <item> <action> [p#]"}
+		return RunCommandResult{Handled: true, Message: "Usage: use <item> <action> [p#]", Err: errCommandUsage}
 	}
 
 	item, actionInput, ok := parseItemAndAction(tokens)
@@ -194,6 +234,16 @@ func (s *RunState) executeUseCommand(fields []string) RunCommandResult {
 	if !ok {
 		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Unknown action for %s. Use: actions p%d", itemCommandLabel(item), playerID)}
 	}
+	if !s.kitUsable(player, item) {
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("%s is broken. Repair it first (multi-tool, sewing kit or duct tape).", item)}
+	}
+	if action.Special == specialSignal {
+		method := SignalMirror
+		if item == KitWhistle {
+			method = SignalWhistle
+		}
+		return s.executeSignalCommand([]string{string(method), fmt.Sprintf("p%d", playerID)})
+	}
 
 	player.Energy = clamp(player.Energy+action.EnergyDelta, 0, 100)
 	player.Hydration = clamp(player.Hydration+action.Hydration, 0, 100)
@@ -206,6 +256,7 @@ func (s *RunState) executeUseCommand(fields []string) RunCommandResult {
 	if action.Nutrition.CaloriesKcal > 0 || action.Nutrition.ProteinG > 0 || action.Nutrition.FatG > 0 || action.Nutrition.SugarG > 0 {
 		player.Nutrition = player.Nutrition.add(action.Nutrition)
 		applyMealNutritionReserves(player, action.Nutrition)
+		s.recordEvent(player, EventKcalEaten, float64(action.Nutrition.CaloriesKcal))
 		energyBonus, hydrationBonus, moraleBonus := nutritionToPlayerEffects(action.Nutrition)
 		player.Energy = clamp(player.Energy+energyBonus, 0, 100)
 		player.Hydration = clamp(player.Hydration+hydrationBonus, 0, 100)
@@ -218,15 +269,28 @@ func (s *RunState) executeUseCommand(fields []string) RunCommandResult {
 	specialMsg := ""
 	if action.Special == specialTreatAilment {
 		if len(player.Ailments) > 0 {
-			removed := player.Ailments[0]
-			player.Ailments = append([]Ailment{}, player.Ailments[1:]...)
+			treated, err := s.FirstAid(playerID)
+			if err != nil {
+				return RunCommandResult{Handled: true, Message: capitalizeTaskLabel(err.Error()) + "."}
+			}
 			player.Morale = clamp(player.Morale+1, 0, 100)
 			totalMoraleDelta++
-			specialMsg = fmt.Sprintf(" | treated: %s", removed.Name)
+			specialMsg = " | " + treated
 		} else {
 			specialMsg = " | no active ailments to treat"
 		}
 	}
+	switch action.Special {
+	case specialRepairHard:
+		specialMsg = " | " + s.repairGear(player, item, repairHard, gearRepairMultiTool)
+		s.wearKit(player, item, 3)
+	case specialRepairFabric:
+		specialMsg = " | " + s.repairGear(player, item, repairFabric, gearRepairSewing)
+		s.wearKit(player, item, 4)
+	case specialPatchGear:
+		specialMsg = " | " + s.repairGear(player, item, repairAny, gearRepairDuctTape)
+		s.wearKit(player, item, 10)
+	}
 
 	msg := fmt.Sprintf("P%d used %s -> %s. %+dE %+dH2O %+dM",
 		playerID, itemCommandLabel(item), action.ID, totalEnergyDelta, totalHydrationDelta, totalMoraleDelta)
@@ -239,7 +303,7 @@ func (s *RunState) executeUseCommand(fields []string) RunCommandResult {
 
 func (s *RunState) executeHuntCommand(fields []string) RunCommandResult {
 	if len(fields) == 0 {
-		return RunCommandResult{Handled: true, Message: "Usage: hunt <land|fish|air> [p#]"}
+		return RunCommandResult{Handled: true, Message: "Usage: hunt <land|fish|air> [p#]", Err: errCommandUsage}
 	}
 	playerID := 1
 	domain := AnimalDomainLand
@@ -270,7 +334,7 @@ func (s *RunState) executeHuntCommand(fields []string) RunCommandResult {
 		}
 	}
 	if !foundDomain {
-		return RunCommandResult{Handled: true, Message: "Usage: hunt <land|fish|air> [p#]"}
+		return RunCommandResult{Handled: true, Message: "Usage: hunt <land|fish|air> [p#]", Err: errCommandUsage}
 	}
 	actionType := "hunt"
 	if domain == AnimalDomainWater {
@@ -278,7 +342,7 @@ func (s *RunState) executeHuntCommand(fields []string) RunCommandResult {
 	}
 	result, err := s.HuntAndCollectCarcass(playerID, domain, actionType)
 	if err != nil {
-		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Hunt failed: %v", err)}
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Hunt failed: %v", err), Err: err}
 	}
 	encounterText := ""
 	if len(result.EncounterLogs) > 0 {
@@ -301,7 +365,7 @@ func (s *RunState) executeFishCommand(fields []string) RunCommandResult {
 	}
 	result, err := s.HuntAndCollectCarcass(playerID, AnimalDomainWater, "fish")
 	if err != nil {
-		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Fish failed: %v", err)}
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Fish failed: %v", err), Err: err}
 	}
 	encounterText := ""
 	if len(result.EncounterLogs) > 0 {
@@ -334,9 +398,9 @@ func (s *RunState) executeForageCommand(fields []string) RunCommandResult {
 
 	result, err := s.ForageAndConsume(playerID, category, grams)
 	if err != nil {
-		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Forage failed: %v", err)}
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Forage failed: %v", err), Err: err}
 	}
-	x, y := s.CurrentMapPosition()
+	x, y := s.PlayerMapPosition(playerID)
 	s.applyCellStateAction(x, y, "forage")
 	encounterMsg := ""
 	if event, ok := s.RollWildlifeEncounter(playerID, x, y, "forage", 0); ok {
@@ -345,6 +409,9 @@ func (s *RunState) executeForageCommand(fields []string) RunCommandResult {
 			player.Hydration = clamp(player.Hydration+event.HydrationDelta, 0, 100)
 			player.Morale = clamp(player.Morale+event.MoraleDelta, 0, 100)
 			refreshEffectBars(player)
+			if prompt := s.openPredatorEncounter(player, event, "forage", 0); prompt != "" {
+				event.Message += " " + prompt
+			}
 		}
 		encounterMsg = " | " + event.Message
 	}
@@ -363,8 +430,9 @@ func (s *RunState) executeForageCommand(fields []string) RunCommandResult {
 	}
 }
 
-func (s *RunState) executeTreesCommand() RunCommandResult {
-	biome := s.CurrentBiomeQuery()
+func (s *RunState) executeTreesCommand(fields []string) RunCommandResult {
+	playerID, _ := extractPlayerID(fields)
+	biome := s.PlayerBiomeQuery(playerID)
 	if strings.TrimSpace(biome) == "" {
 		biome = s.Scenario.Biome
 	}
@@ -379,8 +447,9 @@ func (s *RunState) executeTreesCommand() RunCommandResult {
 	return RunCommandResult{Handled: true, Message: "Trees -> " + strings.Join(parts, ", ")}
 }
 
-func (s *RunState) executePlantsCommand() RunCommandResult {
-	biome := s.CurrentBiomeQuery()
+func (s *RunState) executePlantsCommand(fields []string) RunCommandResult {
+	playerID, _ := extractPlayerID(fields)
+	biome := s.PlayerBiomeQuery(playerID)
 	if strings.TrimSpace(biome) == "" {
 		biome = s.Scenario.Biome
 	}
@@ -401,8 +470,9 @@ func (s *RunState) executePlantsCommand() RunCommandResult {
 	return RunCommandResult{Handled: true, Message: "Utility plants/materials -> " + strings.Join(parts, ", ")}
 }
 
-func (s *RunState) executeResourcesCommand() RunCommandResult {
-	biome := s.CurrentBiomeQuery()
+func (s *RunState) executeResourcesCommand(fields []string) RunCommandResult {
+	playerID, _ := extractPlayerID(fields)
+	biome := s.PlayerBiomeQuery(playerID)
 	if strings.TrimSpace(biome) == "" {
 		biome = s.Scenario.Biome
 	}
@@ -426,7 +496,7 @@ func (s *RunState) executeResourcesCommand() RunCommandResult {
 
 func (s *RunState) executeBarkCommand(fields []string) RunCommandResult {
 	if len(fields) == 0 || fields[0] != "strip" {
-		return RunCommandResult{Handled: true, Message: "Usage: bark strip [tree|any] [qty] [p#]"}
+		return RunCommandResult{Handled: true, Message: "Usage: bark strip [tree|any] [qty] [p#]", Err: errCommandUsage}
 	}
 	playerID, amount, hasAmount, rest := parseOptionalPlayerAndNumber(fields[1:])
 	if !hasAmount {
@@ -438,7 +508,7 @@ func (s *RunState) executeBarkCommand(fields []string) RunCommandResult {
 	}
 	result, err := s.StripBark(playerID, treeID, amount)
 	if err != nil {
-		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Bark stripping failed: %v", err)}
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Bark stripping failed: %v", err), Err: err}
 	}
 	s.AdvanceActionClock(result.HoursSpent)
 	return RunCommandResult{
@@ -466,43 +536,43 @@ func (s *RunState) executeInventoryCommand(fields []string) RunCommandResult {
 		return RunCommandResult{Handled: true, Message: s.PersonalInventorySummary(playerID)}
 	case "stash":
 		if len(fields) < 2 {
-			return RunCommandResult{Handled: true, Message: "Usage: inventory stash <item_id> [qty] [p#]"}
+			return RunCommandResult{Handled: true, Message: "Usage: inventory stash <item_id> [qty] [p#]", Err: errCommandUsage}
 		}
 		playerID, amount, hasAmount, rest := parseOptionalPlayerAndNumber(fields[1:])
 		if len(rest) == 0 {
-			return RunCommandResult{Handled: true, Message: "Usage: inventory stash <item_id> [qty] [p#]"}
+			return RunCommandResult{Handled: true, Message: "Usage: inventory stash <item_id> [qty] [p#]", Err: errCommandUsage}
 		}
 		if !hasAmount {
 			amount = 1
 		}
 		item, err := s.StashPersonalItem(playerID, rest[0], amount)
 		if err != nil {
-			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Inventory stash failed: %v", err)}
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Inventory stash failed: %v", err), Err: err}
 		}
 		return RunCommandResult{Handled: true, Message: fmt.Sprintf("P%d stashed %s %s. %s", playerID, item.ID, formatInventoryQty(item.Unit, item.Qty), s.CampInventorySummary())}
 	case "take":
 		if len(fields) < 2 {
-			return RunCommandResult{Handled: true, Message: "Usage: inventory take <item_id> [qty] [p#]"}
+			return RunCommandResult{Handled: true, Message: "Usage: inventory take <item_id> [qty] [p#]", Err: errCommandUsage}
 		}
 		playerID, amount, hasAmount, rest := parseOptionalPlayerAndNumber(fields[1:])
 		if len(rest) == 0 {
-			return RunCommandResult{Handled: true, Message: "Usage: inventory take <item_id> [qty] [p#]"}
+			return RunCommandResult{Handled: true, Message: "Usage: inventory take <item_id> [qty] [p#]", Err: errCommandUsage}
 		}
 		if !hasAmount {
 			amount = 1
 		}
 		item, err := s.TakeCampItem(playerID, rest[0], amount)
 		if err != nil {
-			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Inventory take failed: %v", err)}
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Inventory take failed: %v", err), Err: err}
 		}
 		return RunCommandResult{Handled: true, Message: fmt.Sprintf("P%d took %s %s. %s", playerID, item.ID, formatInventoryQty(item.Unit, item.Qty), s.PersonalInventorySummary(playerID))}
 	case "add":
 		if len(fields) < 2 {
-			return RunCommandResult{Handled: true, Message: "Usage: inventory add <item_id> [qty] [p#]"}
+			return RunCommandResult{Handled: true, Message: "Usage: inventory add <item_id> [qty] [p#]", Err: errCommandUsage}
 		}
 		playerID, amount, hasAmount, rest := parseOptionalPlayerAndNumber(fields[1:])
 		if len(rest) == 0 {
-			return RunCommandResult{Handled: true, Message: "Usage: inventory add <item_id> [qty] [p#]"}
+			return RunCommandResult{Handled: true, Message: "Usage: inventory add <item_id> [qty] [p#]", Err: errCommandUsage}
 		}
 		if !hasAmount {
 			amount = 1
@@ -523,27 +593,27 @@ func (s *RunState) executeInventoryCommand(fields []string) RunCommandResult {
 			Category: "field",
 		}
 		if err := s.AddPersonalInventoryItem(playerID, item); err != nil {
-			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Inventory add failed: %v", err)}
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Inventory add failed: %v", err), Err: err}
 		}
 		return RunCommandResult{Handled: true, Message: fmt.Sprintf("P%d added %s %s to personal inventory. %s", playerID, item.ID, formatInventoryQty(item.Unit, item.Qty), s.PersonalInventorySummary(playerID))}
 	case "drop":
 		if len(fields) < 2 {
-			return RunCommandResult{Handled: true, Message: "Usage: inventory drop <item_id> [qty] [p#]"}
+			return RunCommandResult{Handled: true, Message: "Usage: inventory drop <item_id> [qty] [p#]", Err: errCommandUsage}
 		}
 		playerID, amount, hasAmount, rest := parseOptionalPlayerAndNumber(fields[1:])
 		if len(rest) == 0 {
-			return RunCommandResult{Handled: true, Message: "Usage: inventory drop <item_id> [qty] [p#]"}
+			return RunCommandResult{Handled: true, Message: "Usage: inventory drop <item_id> [qty] [p#]", Err: errCommandUsage}
 		}
 		if !hasAmount {
 			amount = 1
 		}
 		item, err := s.removePersonalInventoryItem(playerID, rest[0], amount)
 		if err != nil {
-			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Inventory drop failed: %v", err)}
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Inventory drop failed: %v", err), Err: err}
 		}
 		return RunCommandResult{Handled: true, Message: fmt.Sprintf("P%d dropped %s %s. %s", playerID, item.ID, formatInventoryQty(item.Unit, item.Qty), s.PersonalInventorySummary(playerID))}
 	default:
-		return RunCommandResult{Handled: true, Message: "Usage: inventory camp|personal [p#] | inventory stash <item_id> [qty] [p#] | inventory take <item_id> [qty] [p#] | inventory add <item_id> [qty] [p#] | inventory drop <item_id> [qty] [p#]"}
+		return RunCommandResult{Handled: true, Message: "Usage: inventory camp|personal [p#] | inventory stash <item_id> [qty] [p#] | inventory take <item_id> [qty] [p#] | inventory add <item_id> [qty] [p#] | inventory drop <item_id> [qty] [p#]", Err: errCommandUsage}
 	}
 }
 
@@ -561,7 +631,7 @@ func (s *RunState) executeTrapCommand(fields []string) RunCommandResult {
 		return RunCommandResult{Handled: true, Message: "Trap options -> " + strings.Join(parts, ", ")}
 	case "set":
 		if len(fields) < 2 {
-			return RunCommandResult{Handled: true, Message: "Usage: trap set <id> [p#]"}
+			return RunCommandResult{Handled: true, Message: "Usage: trap set <id> [p#]", Err: errCommandUsage}
 		}
 		playerID := 1
 		for _, token := range fields[2:] {
@@ -571,7 +641,7 @@ func (s *RunState) executeTrapCommand(fields []string) RunCommandResult {
 		}
 		setResult, err := s.SetTrap(playerID, fields[1])
 		if err != nil {
-			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Trap set failed: %v", err)}
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Trap set failed: %v", err), Err: err}
 		}
 		s.AdvanceActionClock(setResult.Hours)
 		return RunCommandResult{
@@ -589,24 +659,24 @@ func (s *RunState) executeTrapCommand(fields []string) RunCommandResult {
 		msg += " " + s.CampInventorySummary()
 		return RunCommandResult{Handled: true, Message: msg}
 	default:
-		return RunCommandResult{Handled: true, Message: "Usage: trap list | trap set <id> [p#] | trap status | trap check"}
+		return RunCommandResult{Handled: true, Message: "Usage: trap list | trap set <id> [p#] | trap status | trap check", Err: errCommandUsage}
 	}
 }
 
 func (s *RunState) executeGutCommand(fields []string) RunCommandResult {
 	if len(fields) == 0 {
-		return RunCommandResult{Handled: true, Message: "Usage: gut <small_game_carcass|bird_carcass|fish_carcass> [kg] [p#]"}
+		return RunCommandResult{Handled: true, Message: "Usage: gut <small_game_carcass|bird_carcass|fish_carcass> [kg] [p#]", Err: errCommandUsage}
 	}
 	playerID, amount, hasAmount, rest := parseOptionalPlayerAndNumber(fields)
 	if len(rest) == 0 {
-		return RunCommandResult{Handled: true, Message: "Usage: gut <small_game_carcass|bird_carcass|fish_carcass> [kg] [p#]"}
+		return RunCommandResult{Handled: true, Message: "Usage: gut <small_game_carcass|bird_carcass|fish_carcass> [kg] [p#]", Err: errCommandUsage}
 	}
 	if !hasAmount {
 		amount = 0
 	}
 	result, err := s.GutCarcass(playerID, rest[0], amount)
 	if err != nil {
-		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Gutting failed: %v", err)}
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Gutting failed: %v", err), Err: err}
 	}
 	msg := fmt.Sprintf("P%d gutted %.2fkg %s -> %.2fkg %s, %.2fkg spoiled, %.2fkg inedible (%.1fh).",
 		playerID, result.ProcessedKg, result.CarcassID, result.MeatKg, result.MeatID, result.SpoiledKg, result.InedibleKg, result.HoursSpent)
@@ -622,18 +692,18 @@ func (s *RunState) executeGutCommand(fields []string) RunCommandResult {
 
 func (s *RunState) executeCookCommand(fields []string) RunCommandResult {
 	if len(fields) == 0 {
-		return RunCommandResult{Handled: true, Message: "Usage: cook <raw_small_game_meat|raw_bird_meat|raw_fish_meat> [kg] [p#]"}
+		return RunCommandResult{Handled: true, Message: "Usage: cook <raw_small_game_meat|raw_bird_meat|raw_fish_meat> [kg] [p#]", Err: errCommandUsage}
 	}
 	playerID, amount, hasAmount, rest := parseOptionalPlayerAndNumber(fields)
 	if len(rest) == 0 {
-		return RunCommandResult{Handled: true, Message: "Usage: cook <raw_small_game_meat|raw_bird_meat|raw_fish_meat> [kg] [p#]"}
+		return RunCommandResult{Handled: true, Message: "Usage: cook <raw_small_game_meat|raw_bird_meat|raw_fish_meat> [kg] [p#]", Err: errCommandUsage}
 	}
 	if !hasAmount {
 		amount = 0
 	}
 	result, err := s.CookFood(playerID, rest[0], amount)
 	if err != nil {
-		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Cook failed: %v", err)}
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Cook failed: %v", err), Err: err}
 	}
 	return RunCommandResult{
 		Handled:       true,
@@ -645,19 +715,19 @@ func (s *RunState) executeCookCommand(fields []string) RunCommandResult {
 
 func (s *RunState) executePreserveCommand(fields []string) RunCommandResult {
 	if len(fields) == 0 {
-		return RunCommandResult{Handled: true, Message: "Usage: preserve <smoke|dry|salt> <raw_small_game_meat|raw_bird_meat|raw_fish_meat|cooked_...> [kg] [p#]"}
+		return RunCommandResult{Handled: true, Message: "Usage: preserve <smoke|dry|salt> <raw_small_game_meat|raw_bird_meat|raw_fish_meat|cooked_...> [kg] [p#]", Err: errCommandUsage}
 	}
 	method := fields[0]
 	playerID, amount, hasAmount, rest := parseOptionalPlayerAndNumber(fields[1:])
 	if len(rest) == 0 {
-		return RunCommandResult{Handled: true, Message: "Usage: preserve <smoke|dry|salt> <raw_small_game_meat|raw_bird_meat|raw_fish_meat|cooked_...> [kg] [p#]"}
+		return RunCommandResult{Handled: true, Message: "Usage: preserve <smoke|dry|salt> <raw_small_game_meat|raw_bird_meat|raw_fish_meat|cooked_...> [kg] [p#]", Err: errCommandUsage}
 	}
 	if !hasAmount {
 		amount = 0
 	}
 	result, err := s.PreserveFood(playerID, method, rest[0], amount)
 	if err != nil {
-		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Preserve failed: %v", err)}
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Preserve failed: %v", err), Err: err}
 	}
 	return RunCommandResult{
 		Handled:       true,
@@ -669,18 +739,18 @@ func (s *RunState) executePreserveCommand(fields []string) RunCommandResult {
 
 func (s *RunState) executeEatCommand(fields []string) RunCommandResult {
 	if len(fields) == 0 {
-		return RunCommandResult{Handled: true, Message: "Usage: eat <food_item> [grams|kg] [p#]"}
+		return RunCommandResult{Handled: true, Message: "Usage: eat <food_item> [grams|kg] [p#]", Err: errCommandUsage}
 	}
 	playerID, amount, hasAmount, rest := parseOptionalPlayerAndNumber(fields)
 	if len(rest) == 0 {
-		return RunCommandResult{Handled: true, Message: "Usage: eat <food_item> [grams|kg] [p#]"}
+		return RunCommandResult{Handled: true, Message: "Usage: eat <food_item> [grams|kg] [p#]", Err: errCommandUsage}
 	}
 	if !hasAmount {
 		amount = 0
 	}
 	result, err := s.EatFood(playerID, rest[0], amount)
 	if err != nil {
-		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Eat failed: %v", err)}
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Eat failed: %v", err), Err: err}
 	}
 	msg := fmt.Sprintf("P%d ate %dg %s: %dkcal %dgP %dgF %dgS | %+dE %+dH2O %+dM",
 		playerID,
@@ -695,12 +765,191 @@ func (s *RunState) executeEatCommand(fields []string) RunCommandResult {
 	return RunCommandResult{Handled: true, Message: msg}
 }
 
+// litreUnits are the volume words drink accepts after or on an amount, longest first so "ml" isn't read as "l".
+var litreUnits = []string{"millilitres", "milliliters", "ml", "litres", "liters", "litre", "liter", "l"}
+
+// parseLitres reads "0.5", "0.5l", "500ml" or "1litre" as litres.
+func parseLitres(token string) (float64, bool) {
+	token = strings.ToLower(strings.TrimSpace(token))
+	scale := 1.0
+	for _, unit := range litreUnits {
+		if strings.HasSuffix(token, unit) {
+			token = strings.TrimSuffix(token, unit)
+			if strings.HasPrefix(unit, "m") {
+				scale = 0.001
+			}
+			break
+		}
+	}
+	n, err := strconv.ParseFloat(token, 64)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	return n * scale, true
+}
+
+func (s *RunState) executeDrinkCommand(verb string, fields []string) RunCommandResult {
+	playerID, amount, hasAmount := 1, 0.0, false
+	bare, unit := false, ""
+	rest := make([]string, 0, len(fields))
+	for _, token := range fields {
+		if parsed := parsePlayerToken(token); parsed > 0 {
+			playerID = parsed
+			continue
+		}
+		// A unit on its own ("250 ml", or after the parser moves the number to the end) applies to a bare amount.
+		if slices.Contains(litreUnits, strings.ToLower(token)) {
+			unit = strings.ToLower(token)
+			continue
+		}
+		if !hasAmount {
+			if litres, ok := parseLitres(token); ok {
+				amount, hasAmount = litres, true
+				_, err := strconv.ParseFloat(token, 64)
+				bare = err == nil
+				continue
+			}
+		}
+		rest = append(rest, token)
+	}
+	// A bare amount is millilitres when it says so, or when no unit is given and it is above 5, mirroring eat's grams/kg handling.
+	if bare && (strings.HasPrefix(unit, "m") || (unit == "" && amount > 5)) {
+		amount /= 1000
+	}
+	if !hasAmount {
+		amount = 0
+		if verb == "sip" {
+			amount = 0.15
+		}
+	}
+	quality := WaterQuality("")
+	for _, token := range rest {
+		if token == "water" {
+			continue
+		}
+		parsed, ok := parseWaterQuality(token)
+		if !ok {
+			return RunCommandResult{Handled: true, Message: "Usage: drink [boiled|treated|filtered|raw] [litres|ml] [p#]", Err: errCommandUsage}
+		}
+		quality = parsed
+	}
+	result, err := s.Drink(playerID, quality, amount)
+	if err != nil {
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Drink failed: %v", err), Err: err}
+	}
+	from := fmt.Sprintf("%s water", result.Quality)
+	if result.FromSource {
+		from = fmt.Sprintf("straight from the %s", result.Source)
+	}
+	msg := fmt.Sprintf("P%d drank %.2fL %s | %+dH2O %+dM", playerID, result.Litres, from, result.HydrationDelta, result.MoraleDelta)
+	if len(result.DiseaseEvents) > 0 {
+		names := make([]string, 0, len(result.DiseaseEvents))
+		for _, event := range result.DiseaseEvents {
+			names = append(names, event.Name)
+		}
+		msg += " | illness triggered (" + strings.Join(names, ", ") + ")"
+	}
+	return RunCommandResult{Handled: true, Message: msg}
+}
+
+func (s *RunState) executeSleepCommand(mode SleepMode, fields []string) RunCommandResult {
+	playerID := 0
+	hours := 0.0
+	for _, field := range fields {
+		if parsed := parsePlayerToken(field); parsed > 0 {
+			playerID = parsed
+			continue
+		}
+		if n, err := strconv.ParseFloat(strings.TrimSuffix(field, "h"), 64); err == nil {
+			hours = n
+		}
+	}
+	result, err := s.Sleep(mode, playerID, hours)
+	if err != nil {
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Sleep failed: %v", err), Err: err}
+	}
+
+	who := "Camp"
+	if playerID > 0 {
+		who = fmt.Sprintf("P%d", playerID)
+	}
+	verb := map[SleepMode]string{SleepModeSleep: "slept", SleepModeRest: "rested", SleepModeNap: "napped"}[mode]
+	gains := make([]string, 0, len(result.PlayerIDs))
+	for _, id := range result.PlayerIDs {
+		gains = append(gains, fmt.Sprintf("P%d %+dE", id, result.EnergyGained[id]))
+	}
+	msg := fmt.Sprintf("%s %s %.1fh (%s rest), woke at %s. %s",
+		who, verb, result.HoursSlept, sleepQualityLabel(result.Quality), formatClockHours(result.WokeClockHours), strings.Join(gains, ", "))
+	if len(result.Notes) > 0 {
+		msg += " | " + strings.Join(result.Notes, "; ")
+	}
+	if result.Interrupted {
+		msg += fmt.Sprintf(" | Woken early: %s nearby.", result.InterruptedBy)
+		if prompt := s.EncounterPrompt(); prompt != "" {
+			msg += " " + prompt
+		}
+	} else if len(result.EncounterLogs) > 0 {
+		msg += " | Overheard: " + strings.Join(result.EncounterLogs, " ")
+	}
+	return RunCommandResult{Handled: true, HoursAdvanced: result.HoursSlept, Message: msg}
+}
+
+func (s *RunState) executeWaterCommand(fields []string) RunCommandResult {
+	usage := "Usage: water status [p#] | water collect [litres] [p#] | water boil|filter|treat [litres] [p#]"
+	if len(fields) == 0 {
+		return RunCommandResult{Handled: true, Message: usage, Err: errCommandUsage}
+	}
+	playerID, amount, hasAmount, _ := parseOptionalPlayerAndNumber(fields[1:])
+	if !hasAmount {
+		amount = 0
+	}
+	switch fields[0] {
+	case "status", "check":
+		return RunCommandResult{Handled: true, Message: s.WaterSummary(playerID)}
+	case "collect", "fill", "fetch":
+		result, err := s.CollectWater(playerID, amount)
+		if err != nil {
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Water collect failed: %v", err), Err: err}
+		}
+		action := "collected"
+		if result.Melted {
+			action = "chipped and melted"
+		}
+		return RunCommandResult{
+			Handled:       true,
+			HoursAdvanced: result.HoursSpent,
+			Message: fmt.Sprintf("P%d %s %.1fL raw water from the %s (%.1fh). %s",
+				playerID, action, result.Litres, result.Source, result.HoursSpent, s.WaterSummary(playerID)),
+		}
+	case "boil", "filter", "treat", "purify":
+		method := WaterBoiled
+		switch fields[0] {
+		case "filter":
+			method = WaterFiltered
+		case "treat", "purify":
+			method = WaterTreated
+		}
+		result, err := s.TreatWater(playerID, method, amount)
+		if err != nil {
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Water %s failed: %v", fields[0], err), Err: err}
+		}
+		return RunCommandResult{
+			Handled:       true,
+			HoursAdvanced: result.HoursSpent,
+			Message: fmt.Sprintf("P%d %s %.1fL of water (%.1fh). %s",
+				playerID, result.Method, result.Litres, result.HoursSpent, s.WaterSummary(playerID)),
+		}
+	default:
+		return RunCommandResult{Handled: true, Message: usage, Err: errCommandUsage}
+	}
+}
+
 func (s *RunState) executeGoCommand(fields []string) RunCommandResult {
 	if len(fields) == 0 {
-		return RunCommandResult{Handled: true, Message: "Usage: go <north|south|east|west|n|s|e|w> <distance> [p#]"}
+		return RunCommandResult{Handled: true, Message: "Usage: go <north|south|east|west|n|s|e|w> <distance> [p# ...]", Err: errCommandUsage}
 	}
 
-	playerID := 1
+	partyIDs := []int{}
 	direction := ""
 	distanceTokens := make([]string, 0, 2)
 	for _, field := range fields {
@@ -709,7 +958,9 @@ func (s *RunState) executeGoCommand(fields []string) RunCommandResult {
 			continue
 		}
 		if parsed := parsePlayerToken(token); parsed > 0 {
-			playerID = parsed
+			if !slices.Contains(partyIDs, parsed) {
+				partyIDs = append(partyIDs, parsed)
+			}
 			continue
 		}
 		if direction == "" {
@@ -732,10 +983,35 @@ func (s *RunState) executeGoCommand(fields []string) RunCommandResult {
 		return RunCommandResult{Handled: true, Message: "Distance must be > 0. Use meters (m), kilometers (km), or tiles."}
 	}
 
-	result, err := s.TravelMove(playerID, direction, amount)
+	// Without p# the lead player walks off with everyone on their cell.
+	if len(partyIDs) == 0 {
+		if lead := s.leadPlayer(); lead != nil {
+			partyIDs = s.PlayersAt(lead.Travel.PosX, lead.Travel.PosY)
+			if len(partyIDs) == 0 {
+				partyIDs = []int{lead.ID}
+			}
+		}
+	}
+	if len(partyIDs) == 0 {
+		return RunCommandResult{Handled: true, Message: "Travel failed: no players to move"}
+	}
+	playerID := partyIDs[0]
+	startX, startY := s.PlayerMapPosition(playerID)
+	left := []int{}
+	for _, id := range s.PlayersAt(startX, startY) {
+		if !slices.Contains(partyIDs, id) {
+			left = append(left, id)
+		}
+	}
+	result, err := s.TravelParty(partyIDs, direction, amount)
 	if err != nil {
-		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Travel failed: %v", err)}
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Travel failed: %v", err), Err: err}
+	}
+	// Splitting up needs somewhere to come back to, so camp is pinned where the party left from.
+	if len(left) > 0 && result.StepsMoved > 0 && !s.Camp.Placed {
+		s.Camp.X, s.Camp.Y, s.Camp.Placed = startX, startY, true
 	}
+	player, _ := s.playerByID(playerID)
 	craftText := "on foot"
 	if result.WatercraftUsed != "" {
 		craftText = "using " + result.WatercraftUsed
@@ -752,19 +1028,35 @@ func (s *RunState) executeGoCommand(fields []string) RunCommandResult {
 	if result.BlocksCrossed > 0 {
 		blockText += fmt.Sprintf(" (crossed %d)", result.BlocksCrossed)
 	}
+	leftText := ""
+	if len(left) > 0 && result.StepsMoved > 0 {
+		leftText = fmt.Sprintf(" %s stayed at (%d,%d).", playerList(left), startX, startY)
+	}
 	return RunCommandResult{
 		Handled:       true,
 		HoursAdvanced: result.HoursSpent,
-		Message: fmt.Sprintf("Travelling %s %.1fkm... (%d steps). P%d traveled %.1fkm %s at %.1fkm/h (%.1fh). Cost: -%dE -%dH2O %+dM. Total travel %.1fkm.",
+		Message: fmt.Sprintf("Travelling %s %.1fkm... (%d steps). %s traveled %.1fkm %s at %.1fkm/h (%.1fh). Cost: -%dE -%dH2O %+dM. Total travel %.1fkm.",
 			result.Direction, result.RequestedKm, result.RequestedSteps,
-			playerID, result.DistanceKm, craftText, result.TravelSpeedKmph, result.HoursSpent, result.EnergyCost, result.HydrationCost, result.MoraleDelta, s.Travel.TotalKm) +
-			fmt.Sprintf(" Position: (%d,%d).", s.Travel.PosX, s.Travel.PosY) + blockText + "." + stopText + encounterText + " " + s.describeDirectionalView(playerID, "front", false, ""),
+			playerList(result.PartyIDs), result.DistanceKm, craftText, result.TravelSpeedKmph, result.HoursSpent, result.EnergyCost, result.HydrationCost, result.MoraleDelta, player.Travel.TotalKm) +
+			fmt.Sprintf(" Position: (%d,%d).", player.Travel.PosX, player.Travel.PosY) + leftText + blockText + "." + stopText + encounterText + " " + s.describeDirectionalView(playerID, "front", false, ""),
+	}
+}
+
+// playerList names players the way command output does: "P1", "P2 and P3", "P1, P2 and P4".
+func playerList(ids []int) string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		names = append(names, fmt.Sprintf("P%d", id))
+	}
+	if len(names) <= 1 {
+		return strings.Join(names, "")
 	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
 }
 
 func (s *RunState) executeCollectCommand(fields []string) RunCommandResult {
 	if len(fields) == 0 {
-		return RunCommandResult{Handled: true, Message: "Usage: collect <resource|any> [qty] [p#]"}
+		return RunCommandResult{Handled: true, Message: "Usage: collect <resource|any> [qty] [p#]", Err: errCommandUsage}
 	}
 
 	playerID, amount, hasAmount, rest := parseOptionalPlayerAndNumber(fields)
@@ -772,13 +1064,24 @@ func (s *RunState) executeCollectCommand(fields []string) RunCommandResult {
 	if len(rest) > 0 {
 		resourceID = rest[0]
 	}
+	if resourceID == "water" {
+		// Forward everything but the "water" word, wherever it sits among the amount and p#.
+		args := []string{"collect"}
+		for i, field := range fields {
+			if field == "water" {
+				args = append(append(args, fields[:i]...), fields[i+1:]...)
+				break
+			}
+		}
+		return s.executeWaterCommand(args)
+	}
 	if !hasAmount {
 		amount = 0
 	}
 
 	resource, qty, err := s.CollectResource(playerID, resourceID, amount)
 	if err != nil {
-		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Collect failed: %v", err)}
+		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Collect failed: %v", err), Err: err}
 	}
 	unit := resource.Unit
 	if unit == "kg" {
@@ -789,7 +1092,7 @@ func (s *RunState) executeCollectCommand(fields []string) RunCommandResult {
 
 func (s *RunState) executeWoodCommand(fields []string) RunCommandResult {
 	if len(fields) == 0 {
-		return RunCommandResult{Handled: true, Message: "Usage: wood gather [kg] [p#] | wood dry [kg] [p#] | wood stock"}
+		return RunCommandResult{Handled: true, Message: "Usage: wood gather [kg] [p#] | wood dry [kg] [p#] | wood stock", Err: errCommandUsage}
 	}
 
 	switch fields[0] {
@@ -802,7 +1105,7 @@ func (s *RunState) executeWoodCommand(fields []string) RunCommandResult {
 		}
 		tree, kg, err := s.GatherWood(playerID, amount)
 		if err != nil {
-			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Wood gather failed: %v", err)}
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Wood gather failed: %v", err), Err: err}
 		}
 		return RunCommandResult{
 			Handled: true,
@@ -816,11 +1119,11 @@ func (s *RunState) executeWoodCommand(fields []string) RunCommandResult {
 		}
 		dried, err := s.DryWood(playerID, amount)
 		if err != nil {
-			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Wood dry failed: %v", err)}
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Wood dry failed: %v", err), Err: err}
 		}
 		return RunCommandResult{Handled: true, Message: fmt.Sprintf("P%d dried %.1fkg wood. %s", playerID, dried, s.CampInventorySummary())}
 	default:
-		return RunCommandResult{Handled: true, Message: "Usage: wood gather [kg] [p#] | wood dry [kg] [p#] | wood stock"}
+		return RunCommandResult{Handled: true, Message: "Usage: wood gather [kg] [p#] | wood dry [kg] [p#] | wood stock", Err: errCommandUsage}
 	}
 }
 
@@ -841,7 +1144,7 @@ func (s *RunState) executeFireCommand(fields []string) RunCommandResult {
 		return RunCommandResult{Handled: true, Message: "Fire methods -> ferro, bow_drill, hand_drill"}
 	case "prep":
 		if len(fields) < 2 {
-			return RunCommandResult{Handled: true, Message: "Usage: fire prep tinder|kindling|feather [count] [p#]"}
+			return RunCommandResult{Handled: true, Message: "Usage: fire prep tinder|kindling|feather [count] [p#]", Err: errCommandUsage}
 		}
 		playerID, amount, hasAmount, _ := parseOptionalPlayerAndNumber(fields[2:])
 		count := 1
@@ -850,7 +1153,7 @@ func (s *RunState) executeFireCommand(fields []string) RunCommandResult {
 		}
 		created, err := s.PrepareFireMaterial(playerID, fields[1], count)
 		if err != nil {
-			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Fire prep failed: %v", err)}
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Fire prep failed: %v", err), Err: err}
 		}
 		return RunCommandResult{
 			Handled: true,
@@ -858,7 +1161,7 @@ func (s *RunState) executeFireCommand(fields []string) RunCommandResult {
 		}
 	case "ember":
 		if len(fields) < 2 {
-			return RunCommandResult{Handled: true, Message: "Usage: fire ember bow|hand [woodtype] [p#]"}
+			return RunCommandResult{Handled: true, Message: "Usage: fire ember bow|hand [woodtype] [p#]", Err: errCommandUsage}
 		}
 		method := ParseFireMethod(fields[1])
 		if method != FireMethodBowDrill && method != FireMethodHandDrill {
@@ -877,7 +1180,7 @@ func (s *RunState) executeFireCommand(fields []string) RunCommandResult {
 		}
 		chance, success, err := s.TryCreateEmber(playerID, method, woodType)
 		if err != nil {
-			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Fire ember failed: %v", err)}
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Fire ember failed: %v", err), Err: err}
 		}
 		if success {
 			return RunCommandResult{Handled: true, Message: fmt.Sprintf("P%d created ember with %s (chance %.0f%%). Prep: %s", playerID, method, chance*100, formatFirePrep(s.FirePrep))}
@@ -897,7 +1200,7 @@ func (s *RunState) executeFireCommand(fields []string) RunCommandResult {
 		}
 		chance, success, err := s.IgniteFromEmber(playerID, woodType, amount)
 		if err != nil {
-			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Ignite failed: %v", err)}
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Ignite failed: %v", err), Err: err}
 		}
 		if success {
 			return RunCommandResult{Handled: true, Message: fmt.Sprintf("P%d ignited fire from ember (chance %.0f%%). Fire heat %dC intensity %d.", playerID, chance*100, s.Fire.HeatC, s.Fire.Intensity)}
@@ -926,7 +1229,7 @@ func (s *RunState) executeFireCommand(fields []string) RunCommandResult {
 			}
 		}
 		if err := s.startFireWithMethod(playerID, woodType, amount, FireMethodFerro); err != nil {
-			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Fire start failed: %v", err)}
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Fire start failed: %v", err), Err: err}
 		}
 		return RunCommandResult{
 			Handled: true,
@@ -946,7 +1249,7 @@ func (s *RunState) executeFireCommand(fields []string) RunCommandResult {
 			}
 		}
 		if err := s.TendFire(playerID, amount, woodType); err != nil {
-			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Fire tend failed: %v", err)}
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Fire tend failed: %v", err), Err: err}
 		}
 		return RunCommandResult{
 			Handled: true,
@@ -954,7 +1257,7 @@ func (s *RunState) executeFireCommand(fields []string) RunCommandResult {
 				playerID, amount, woodType, s.Fire.Intensity, s.Fire.HeatC, s.Fire.FuelKg),
 		}
 	default:
-		return RunCommandResult{Handled: true, Message: "Usage: fire status | fire methods | fire prep tinder|kindling|feather [count] [p#] | fire ember bow|hand [woodtype] [p#] | fire ignite [woodtype] [kg] [p#] | fire build [woodtype] [kg] [p#] | fire tend [woodtype] [kg] [p#] | fire out"}
+		return RunCommandResult{Handled: true, Message: "Usage: fire status | fire methods | fire prep tinder|kindling|feather [count] [p#] | fire ember bow|hand [woodtype] [p#] | fire ignite [woodtype] [kg] [p#] | fire build [woodtype] [kg] [p#] | fire tend [woodtype] [kg] [p#] | fire out", Err: errCommandUsage}
 	}
 }
 
@@ -987,7 +1290,7 @@ func (s *RunState) executeShelterCommand(fields []string) RunCommandResult {
 		return RunCommandResult{Handled: true, Message: "Shelters -> " + strings.Join(parts, ", ")}
 	case "build":
 		if len(fields) < 2 {
-			return RunCommandResult{Handled: true, Message: "Usage: shelter build <id> [p#]"}
+			return RunCommandResult{Handled: true, Message: "Usage: shelter build <id> [p#]", Err: errCommandUsage}
 		}
 		playerID := 1
 		shelterID := fields[1]
@@ -1000,7 +1303,7 @@ func (s *RunState) executeShelterCommand(fields []string) RunCommandResult {
 		}
 		shelter, err := s.BuildShelter(playerID, shelterID)
 		if err != nil {
-			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Shelter build failed: %v", err)}
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Shelter build failed: %v", err), Err: err}
 		}
 		stageCount := len(shelter.Stages)
 		if stageCount == 0 {
@@ -1008,7 +1311,7 @@ func (s *RunState) executeShelterCommand(fields []string) RunCommandResult {
 		}
 		return RunCommandResult{Handled: true, Message: fmt.Sprintf("P%d built %s stage %d/%d. Durability %d%%.", playerID, shelter.Name, max(1, s.Shelter.Stage), stageCount, s.Shelter.Durability)}
 	default:
-		return RunCommandResult{Handled: true, Message: "Usage: shelter list | shelter build <id> [p#] | shelter status"}
+		return RunCommandResult{Handled: true, Message: "Usage: shelter list | shelter build <id> [p#] | shelter status", Err: errCommandUsage}
 	}
 }
 
@@ -1025,7 +1328,7 @@ func (s *RunState) executeEnterCommand(fields []string) RunCommandResult {
 		return RunCommandResult{Handled: true, Message: fmt.Sprintf("Player %d not found.", playerID)}
 	}
 
-	if s.Shelter.Type == "" || s.Shelter.Durability <= 0 {
+	if s.Shelter.Type == "" || s.Shelter.Durability <= 0 || !s.playerAtCamp(player) {
 		return RunCommandResult{Handled: true, Message: "There is no shelter built here."}
 	}
 
@@ -1065,14 +1368,18 @@ func (s *RunState) executeExitCommand(fields []string) RunCommandResult {
 
 func (s *RunState) executeCraftCommand(fields []string) RunCommandResult {
 	if len(fields) == 0 {
-		return RunCommandResult{Handled: true, Message: "Usage: craft list | craft make <id> [p#] | craft inventory"}
+		return RunCommandResult{Handled: true, Message: "Usage: craft list | craft make <id> [p#] | craft inventory", Err: errCommandUsage}
 	}
 	switch fields[0] {
 	case "inventory":
 		if len(s.CraftedItems) == 0 {
 			return RunCommandResult{Handled: true, Message: "Crafted: none"}
 		}
-		return RunCommandResult{Handled: true, Message: "Crafted: " + strings.Join(s.CraftedItems, ", ")}
+		parts := make([]string, 0, len(s.CraftedItems))
+		for _, id := range s.CraftedItems {
+			parts = append(parts, id+GearConditionLabel(s.CraftedCondition(id)))
+		}
+		return RunCommandResult{Handled: true, Message: "Crafted: " + strings.Join(parts, ", ")}
 	case "list":
 		options := CraftablesForBiome(s.Scenario.Biome)
 		parts := make([]string, 0, len(options))
@@ -1082,7 +1389,7 @@ func (s *RunState) executeCraftCommand(fields []string) RunCommandResult {
 		return RunCommandResult{Handled: true, Message: "Craftables -> " + strings.Join(parts, ", ")}
 	case "make":
 		if len(fields) < 2 {
-			return RunCommandResult{Handled: true, Message: "Usage: craft make <id> [p#]"}
+			return RunCommandResult{Handled: true, Message: "Usage: craft make <id> [p#]", Err: errCommandUsage}
 		}
 		playerID := 1
 		for _, token := range fields[2:] {
@@ -1092,7 +1399,14 @@ func (s *RunState) executeCraftCommand(fields []string) RunCommandResult {
 		}
 		outcome, err := s.CraftItem(playerID, fields[1])
 		if err != nil {
-			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Craft failed: %v", err)}
+			return RunCommandResult{Handled: true, Message: fmt.Sprintf("Craft failed: %v", err), Err: err}
+		}
+		if _, ok := campStructureSpecFor(outcome.Spec.ID); ok {
+			return RunCommandResult{
+				Handled:       true,
+				HoursAdvanced: outcome.HoursSpent,
+				Message:       fmt.Sprintf("P%d built %s at camp (%s, %.1fh).", playerID, outcome.Spec.Name, outcome.Quality, outcome.HoursSpent),
+			}
 		}
 		return RunCommandResult{
 			Handled:       true,
@@ -1101,7 +1415,7 @@ func (s *RunState) executeCraftCommand(fields []string) RunCommandResult {
 				playerID, outcome.Spec.Name, outcome.Quality, outcome.HoursSpent, outcome.StoredAt),
 		}
 	default:
-		return RunCommandResult{Handled: true, Message: "Usage: craft list | craft make <id> [p#] | craft inventory"}
+		return RunCommandResult{Handled: true, Message: "Usage: craft list | craft make <id> [p#] | craft inventory", Err: errCommandUsage}
 	}
 }
 
@@ -1446,19 +1760,19 @@ var equipmentActionLibrary = map[KitItem][]equipmentAction{
 		{ID: "night_task", Aliases: []string{"work at night", "night"}, Description: "Complete controlled tasks after dark.", EnergyDelta: -1, MoraleDelta: 1},
 	},
 	KitSignalMirror: {
-		{ID: "signal_pass", Aliases: []string{"signal", "flash mirror"}, Description: "Signal aircraft or boats in clear weather.", MoraleDelta: 2},
+		{ID: "signal_pass", Aliases: []string{"signal", "flash mirror"}, Description: "Signal aircraft or boats in clear weather.", Special: specialSignal},
 	},
 	KitWhistle: {
-		{ID: "emergency_signal", Aliases: []string{"blow whistle", "whistle signal"}, Description: "Issue loud emergency signal blasts.", MoraleDelta: 1},
+		{ID: "emergency_signal", Aliases: []string{"blow whistle", "whistle signal"}, Description: "Issue loud emergency signal blasts.", Special: specialSignal},
 	},
 	KitMultiTool: {
-		{ID: "repair_gear", Aliases: []string{"repair", "fix tool"}, Description: "Repair worn gear and fittings.", EnergyDelta: -1, MoraleDelta: 2},
+		{ID: "repair_gear", Aliases: []string{"repair", "fix tool"}, Description: "Repair worn gear and fittings.", EnergyDelta: -1, MoraleDelta: 2, Special: specialRepairHard},
 	},
 	KitDuctTape: {
-		{ID: "patch_shelter", Aliases: []string{"patch tarp", "patch gear"}, Description: "Seal leaks and reinforce stress points.", EnergyDelta: -1, MoraleDelta: 2},
+		{ID: "patch_shelter", Aliases: []string{"patch tarp", "patch gear"}, Description: "Seal leaks and reinforce stress points.", EnergyDelta: -1, MoraleDelta: 2, Special: specialPatchGear},
 	},
 	KitSewingKit: {
-		{ID: "mend_clothes", Aliases: []string{"stitch clothes", "mend"}, Description: "Mend tears to retain warmth and comfort.", EnergyDelta: -1, MoraleDelta: 2},
+		{ID: "mend_clothes", Aliases: []string{"stitch clothes", "mend"}, Description: "Mend tears to retain warmth and comfort.", EnergyDelta: -1, MoraleDelta: 2, Special: specialRepairFabric},
 	},
 	KitShovel: {
 		{ID: "dig_drainage", Aliases: []string{"dig trench", "drainage"}, Description: "Dig drainage and improve camp footing.", EnergyDelta: -2, Hydration: -1, MoraleDelta: 2},
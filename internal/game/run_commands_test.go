@@ -123,32 +123,32 @@ func TestRunCommandGoWithoutDistancePrompts(t *testing.T) {
 func TestRunCommandGoDistanceUnits(t *testing.T) {
 	run := newRunForCommands(t)
 
-	before := run.Travel.TotalKm
+	before := run.Players[0].Travel.TotalKm
 	beforeClock := run.ClockHours
 	res := run.ExecuteRunCommand("go north 500m")
 	if !res.Handled {
 		t.Fatalf("expected command to be handled")
 	}
-	if run.Travel.TotalKm <= before {
+	if run.Players[0].Travel.TotalKm <= before {
 		t.Fatalf("expected movement for 500m command")
 	}
 	if run.ClockHours <= beforeClock && run.Day == 1 {
 		t.Fatalf("expected clock/day to advance for travel command")
 	}
 
-	before = run.Travel.TotalKm
+	before = run.Players[0].Travel.TotalKm
 	res = run.ExecuteRunCommand("go north 2km")
 	if !res.Handled {
 		t.Fatalf("expected command to be handled")
 	}
-	if run.Travel.TotalKm <= before {
+	if run.Players[0].Travel.TotalKm <= before {
 		t.Fatalf("expected movement for 2km command")
 	}
 }
 
 func TestRunCommandLookLeftProvidesDirectionalInfo(t *testing.T) {
 	run := newRunForCommands(t)
-	run.Travel.Direction = "north"
+	run.Players[0].Travel.Direction = "north"
 
 	res := run.ExecuteRunCommand("look left")
 	if !res.Handled {
@@ -199,9 +199,9 @@ func TestGoCommandStopsAtShorelineAndReportsIt(t *testing.T) {
 	}
 	run.CellStates = make([]CellState, 3)
 	run.FogMask = []bool{true, true, true}
-	run.Travel.PosX = 0
-	run.Travel.PosY = 0
-	run.Travel.Direction = "east"
+	run.Players[0].Travel.PosX = 0
+	run.Players[0].Travel.PosY = 0
+	run.Players[0].Travel.Direction = "east"
 
 	res := run.ExecuteRunCommand("go east 500m")
 	if !res.Handled {
@@ -211,8 +211,8 @@ func TestGoCommandStopsAtShorelineAndReportsIt(t *testing.T) {
 	if !strings.Contains(msg, "shore") {
 		t.Fatalf("expected shoreline stop message, got: %s", res.Message)
 	}
-	if run.Travel.PosX != 0 || run.Travel.PosY != 0 {
-		t.Fatalf("expected player to remain on shore, pos=(%d,%d)", run.Travel.PosX, run.Travel.PosY)
+	if run.Players[0].Travel.PosX != 0 || run.Players[0].Travel.PosY != 0 {
+		t.Fatalf("expected player to remain on shore, pos=(%d,%d)", run.Players[0].Travel.PosX, run.Players[0].Travel.PosY)
 	}
 }
 
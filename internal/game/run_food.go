@@ -44,7 +44,7 @@ func (s *RunState) catchWithSkillBonus(playerID int, domain AnimalDomain) (Catch
 	if !ok {
 		return CatchResult{}, nil, fmt.Errorf("player %d not found", playerID)
 	}
-	biome := s.CurrentBiomeQuery()
+	biome := s.PlayerBiomeQuery(playerID)
 	if strings.TrimSpace(biome) == "" {
 		biome = s.Scenario.Biome
 	}
@@ -61,11 +61,11 @@ func (s *RunState) catchWithSkillBonus(playerID int, domain AnimalDomain) (Catch
 
 	switch domain {
 	case AnimalDomainLand:
-		applySkillEffort(&player.Hunting, 18, true)
+		s.trainSkill(player, SkillHunting, 18, true)
 	case AnimalDomainWater:
-		applySkillEffort(&player.Fishing, 18, true)
+		s.trainSkill(player, SkillFishing, 18, true)
 	default:
-		applySkillEffort(&player.Hunting, 12, true)
+		s.trainSkill(player, SkillHunting, 12, true)
 	}
 
 	bonusPct := 0
@@ -77,6 +77,7 @@ func (s *RunState) catchWithSkillBonus(playerID int, domain AnimalDomain) (Catch
 	default:
 		bonusPct = player.Hunting/10 + player.Agility + positiveTraitModifier(player.Traits)/2
 	}
+	bonusPct += s.poiCatchBonusPct(player, domain)
 	if bonusPct != 0 {
 		adjusted := catch.WeightGrams + (catch.WeightGrams*bonusPct)/100
 		catch.WeightGrams = max(80, adjusted)
@@ -160,7 +161,8 @@ func (s *RunState) HuntAndCollectCarcass(playerID int, domain AnimalDomain, acti
 	if strings.TrimSpace(action) == "" {
 		action = "hunt"
 	}
-	x, y := s.CurrentMapPosition()
+	s.wearHuntingGear(player, domain)
+	x, y := player.Travel.PosX, player.Travel.PosY
 	s.applyCellStateAction(x, y, action)
 
 	carcassID := carcassIDForDomain(domain)
@@ -180,15 +182,17 @@ func (s *RunState) HuntAndCollectCarcass(playerID int, domain AnimalDomain, acti
 	}
 
 	storedAt := ""
+	// Away from camp only what fits in the pack comes back.
+	atCamp := s.playerAtCamp(player)
 	if err := s.AddPersonalInventoryItem(playerID, item); err == nil {
 		storedAt = "personal"
-	} else if err := s.addCampInventoryItem(item); err == nil {
+	} else if atCamp && s.addCampInventoryItem(item) == nil {
 		storedAt = "camp"
 	} else {
 		// If full-carcass storage fails, keep a partial field-dressed yield that fits available space.
 		tryStorePartial := func() bool {
 			maxCampQty := math.Floor((s.campFreeKg()/item.WeightKg)*10) / 10
-			if maxCampQty >= 0.1 {
+			if atCamp && maxCampQty >= 0.1 {
 				partial := item
 				partial.Qty = maxCampQty
 				if err := s.addCampInventoryItem(partial); err == nil {
@@ -214,7 +218,16 @@ func (s *RunState) HuntAndCollectCarcass(playerID int, domain AnimalDomain, acti
 			return HuntResult{}, fmt.Errorf("caught %s (%.1fkg), but no storage space", catch.Animal.Name, kg)
 		}
 	}
+	edibleKcal := float64(catch.EdibleGrams) * float64(catch.Animal.NutritionPer100g.CaloriesKcal) / 100
+	if full := float64(catch.WeightGrams) / 1000; item.Qty < full {
+		edibleKcal *= item.Qty / full
+	}
 	kg = item.Qty
+	if domain == AnimalDomainWater {
+		s.recordEvent(player, EventFishKcal, edibleKcal)
+	} else {
+		s.recordEvent(player, EventHuntKcal, edibleKcal)
+	}
 
 	baseHours := 1.8
 	switch domain {
@@ -241,6 +254,9 @@ func (s *RunState) HuntAndCollectCarcass(playerID int, domain AnimalDomain, acti
 		player.Energy = clamp(player.Energy+event.EnergyDelta, 0, 100)
 		player.Hydration = clamp(player.Hydration+event.HydrationDelta, 0, 100)
 		player.Morale = clamp(player.Morale+event.MoraleDelta, 0, 100)
+		if prompt := s.openPredatorEncounter(player, event, action, 0); prompt != "" {
+			encounterLogs = append(encounterLogs, prompt)
+		}
 	}
 	refreshEffectBars(player)
 
@@ -264,6 +280,12 @@ func (s *RunState) CatchAndConsume(playerID int, domain AnimalDomain, choice Mea
 		return CatchResult{}, MealOutcome{}, err
 	}
 	outcome := ConsumeCatch(s.Config.Seed, s.Day, player, catch, choice)
+	s.recordEvent(player, EventKcalEaten, float64(outcome.Nutrition.CaloriesKcal))
+	if domain == AnimalDomainWater {
+		s.recordEvent(player, EventFishKcal, float64(outcome.Nutrition.CaloriesKcal))
+	} else {
+		s.recordEvent(player, EventHuntKcal, float64(outcome.Nutrition.CaloriesKcal))
+	}
 	return catch, outcome, nil
 }
 
@@ -274,11 +296,7 @@ func (s *RunState) ActiveAilmentNames(playerID int) []string {
 		}
 		out := make([]string, 0, len(s.Players[i].Ailments))
 		for _, ailment := range s.Players[i].Ailments {
-			name := ailment.Name
-			if name == "" {
-				name = string(ailment.Type)
-			}
-			out = append(out, name)
+			out = append(out, ailment.Label())
 		}
 		return out
 	}
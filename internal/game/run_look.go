@@ -9,6 +9,8 @@ import (
 // - Look/inspect text was built from Scenario.Biome, which could diverge from actual topo cell biome.
 // - Insect/flora snippets were not temperature-aware, causing warm-season text in freezing conditions.
 // - This file now derives descriptions from the viewed cell + season/weather/climate filters.
+// - The view starts from the looking player's own cell and facing, since team members can be apart.
+// - Looking closer at a cell also turns up any point of interest on it (see poi.go).
 
 func (s *RunState) executeLookCommand(command string, fields []string) RunCommandResult {
 	playerID, relative, detailed, subject := parseLookRequest(fields, command == "inspect" || command == "examine")
@@ -55,8 +57,8 @@ func parseLookRequest(fields []string, defaultDetailed bool) (playerID int, rela
 
 func (s *RunState) describeDirectionalView(playerID int, relative string, detailed bool, subject string) string {
 	s.EnsureTopology()
-	dir := s.absoluteLookDirection(relative)
-	x, y := s.CurrentMapPosition()
+	dir := s.absoluteLookDirection(playerID, relative)
+	x, y := s.PlayerMapPosition(playerID)
 	tx, ty, inBounds := s.stepInDirection(x, y, dir)
 	cell, ok := s.TopologyCellAt(x, y)
 	if inBounds {
@@ -69,9 +71,17 @@ func (s *RunState) describeDirectionalView(playerID int, relative string, detail
 		return "You scan the area but cannot make out details from here."
 	}
 	if detailed {
-		return s.describeLookCloser(playerID, relative, dir, subject, cell, tx, ty, inBounds)
+		msg := s.describeLookCloser(playerID, relative, dir, subject, cell, tx, ty, inBounds)
+		if inBounds {
+			msg += s.describePointOfInterest(tx, ty)
+		}
+		return msg
+	}
+	msg := s.describeLookOverview(relative, dir, cell, tx, ty, inBounds)
+	if poi, ok := s.PointOfInterestAt(tx, ty); ok && inBounds && poi.Discovered {
+		msg += fmt.Sprintf(" You know of a %s there.", poi.Kind.Label())
 	}
-	return s.describeLookOverview(relative, dir, cell, tx, ty, inBounds)
+	return msg
 }
 
 func (s *RunState) describeLookOverview(relative, dir string, cell TopoCell, tx, ty int, inBounds bool) string {
@@ -194,8 +204,12 @@ func (s *RunState) lookInsectsForCell(cell TopoCell, season SeasonID) []string {
 	return filterInsectsForClimate(insects, s.ActiveClimateProfile(), season, s.Weather.TemperatureC, cell.Biome)
 }
 
-func (s *RunState) absoluteLookDirection(relative string) string {
-	facing := normalizeDirection(s.Travel.Direction)
+// absoluteLookDirection turns left/right/back into a compass direction from the way the player last walked.
+func (s *RunState) absoluteLookDirection(playerID int, relative string) string {
+	facing := ""
+	if player, ok := s.playerByID(playerID); ok {
+		facing = normalizeDirection(player.Travel.Direction)
+	}
 	if facing == "" {
 		facing = "north"
 	}
@@ -29,9 +29,9 @@ func TestTundraLookDescriptionIsColdCoherent(t *testing.T) {
 	for i := range run.FogMask {
 		run.FogMask[i] = true
 	}
-	run.Travel.PosX = 1
-	run.Travel.PosY = 1
-	run.Travel.Direction = "north"
+	run.Players[0].Travel.PosX = 1
+	run.Players[0].Travel.PosY = 1
+	run.Players[0].Travel.Direction = "north"
 	run.Day = 2
 	run.Weather = WeatherState{Day: 2, Type: WeatherSnow, TemperatureC: -18}
 
@@ -72,9 +72,9 @@ func TestLookCloserPlantsRespectsColdSeasonFiltering(t *testing.T) {
 	for i := range run.FogMask {
 		run.FogMask[i] = true
 	}
-	run.Travel.PosX = 1
-	run.Travel.PosY = 1
-	run.Travel.Direction = "north"
+	run.Players[0].Travel.PosX = 1
+	run.Players[0].Travel.PosY = 1
+	run.Players[0].Travel.Direction = "north"
 	run.Day = 5
 	run.Weather = WeatherState{Day: 5, Type: WeatherCloudy, TemperatureC: -15}
 
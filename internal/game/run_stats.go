@@ -0,0 +1,299 @@
+package game
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Discovery summary:
+//   - career.go already counted what each player did, but only as run totals; a post-run report needs it by day and in order.
+//   - recordEvent now also feeds RunState.Stats: each event folds into that day's DayStats and discrete ones join the event log.
+//   - Calories burned and fire uptime accrue every clock step, so they fold into the day without filling the log.
+//   - Weather is stamped when a day opens and ailments are compared when it closes; no other system has to report them.
+//   - The report and its JSON/CSV export live here so the GUI summary screen and the headless runner share them.
+const runEventLogLimit = 5000
+
+const (
+	EventKcalEaten      RunEventKind = "kcal_eaten"
+	EventKcalBurned     RunEventKind = "kcal_burned"
+	EventFireHours      RunEventKind = "fire_hours"
+	EventAilmentOnset   RunEventKind = "ailment_onset"
+	EventAilmentCleared RunEventKind = "ailment_cleared"
+)
+
+// RunEvent is one logged event; PlayerID 0 means the party or camp.
+type RunEvent struct {
+	Day      int          `json:"day"`
+	Clock    float64      `json:"clock"`
+	PlayerID int          `json:"player_id,omitempty"`
+	Kind     RunEventKind `json:"kind"`
+	Value    float64      `json:"value"`
+	Detail   string       `json:"detail,omitempty"`
+}
+
+// StatAilment is an ailment a player carried at the close of a day.
+type StatAilment struct {
+	PlayerID int    `json:"player_id"`
+	Name     string `json:"name"`
+}
+
+// DayStats is everything the run recorded about one day, across the party.
+type DayStats struct {
+	Day         int           `json:"day"`
+	KcalEaten   float64       `json:"kcal_eaten"`
+	KcalBurned  float64       `json:"kcal_burned"`
+	KcalHunted  float64       `json:"kcal_hunted"`
+	KcalForaged float64       `json:"kcal_foraged"`
+	Hunts       int           `json:"hunts"`
+	Forages     int           `json:"forages"`
+	TrapCatches int           `json:"trap_catches"`
+	Km          float64       `json:"km"`
+	FireHours   float64       `json:"fire_hours"`
+	Weather     WeatherType   `json:"weather,omitempty"`
+	LowC        int           `json:"low_c"`
+	HighC       int           `json:"high_c"`
+	PrecipMM    float64       `json:"precip_mm"`
+	Ailments    []StatAilment `json:"ailments,omitempty"`
+}
+
+// RunStats is the run's statistics: one row per day and the event log.
+type RunStats struct {
+	Days []DayStats `json:"days,omitempty"`
+	Log  []RunEvent `json:"log,omitempty"`
+}
+
+// continuousEvent kinds accrue every clock step; they are totalled per day but not logged one by one.
+func continuousEvent(kind RunEventKind) bool {
+	return kind == EventKcalBurned || kind == EventFireHours
+}
+
+// logRunEvent folds an event into today's stats and, unless it is continuous, appends it to the log.
+func (s *RunState) logRunEvent(playerID int, kind RunEventKind, value float64, detail string) {
+	if s == nil {
+		return
+	}
+	day := s.statsDay()
+	switch kind {
+	case EventKcalEaten:
+		day.KcalEaten += value
+	case EventKcalBurned:
+		day.KcalBurned += value
+	case EventHuntKcal, EventFishKcal:
+		day.KcalHunted += value
+		day.Hunts++
+	case EventForageKcal:
+		day.KcalForaged += value
+		day.Forages++
+	case EventTrapCatch:
+		day.TrapCatches += int(value)
+	case EventKmTravelled:
+		day.Km += value
+	case EventFireHours:
+		day.FireHours += value
+	}
+	if continuousEvent(kind) {
+		return
+	}
+	s.Stats.Log = append(s.Stats.Log, RunEvent{Day: s.Day, Clock: s.ClockHours, PlayerID: playerID, Kind: kind, Value: value, Detail: detail})
+	if over := len(s.Stats.Log) - runEventLogLimit; over > 0 {
+		s.Stats.Log = append([]RunEvent(nil), s.Stats.Log[over:]...)
+	}
+}
+
+// statsDay returns today's row, opening it (with the day's weather) if needed.
+func (s *RunState) statsDay() *DayStats {
+	if n := len(s.Stats.Days); n > 0 && s.Stats.Days[n-1].Day == s.Day {
+		return &s.Stats.Days[n-1]
+	}
+	day := DayStats{Day: s.Day}
+	if s.Weather.Day == s.Day {
+		day.Weather = s.Weather.DayWeather()
+		day.LowC, day.HighC = s.Weather.LowC, s.Weather.HighC
+		for _, hour := range s.Weather.Hourly {
+			day.PrecipMM += hour.PrecipMM
+		}
+	}
+	s.Stats.Days = append(s.Stats.Days, day)
+	return &s.Stats.Days[len(s.Stats.Days)-1]
+}
+
+// recordKcalBurned counts what a player's metabolism used over a fraction of a day.
+func (s *RunState) recordKcalBurned(player *PlayerState, fraction float64) {
+	if player == nil || fraction <= 0 {
+		return
+	}
+	s.recordEvent(player, EventKcalBurned, float64(DailyNutritionNeedsForPlayer(*player).CaloriesKcal)*fraction)
+}
+
+// closeDayStats notes the ailments everyone carries at the end of the day and logs any that began or cleared.
+func (s *RunState) closeDayStats() {
+	day := s.statsDay()
+	var before []StatAilment
+	if n := len(s.Stats.Days); n > 1 {
+		before = s.Stats.Days[n-2].Ailments
+	}
+	day.Ailments = nil
+	seen := map[StatAilment]bool{}
+	for _, p := range s.Players {
+		for _, a := range p.Ailments {
+			mark := StatAilment{PlayerID: p.ID, Name: a.Name}
+			if seen[mark] {
+				continue
+			}
+			seen[mark] = true
+			day.Ailments = append(day.Ailments, mark)
+		}
+	}
+	had := map[StatAilment]bool{}
+	for _, mark := range before {
+		had[mark] = true
+		if !seen[mark] {
+			s.logRunEvent(mark.PlayerID, EventAilmentCleared, 1, mark.Name)
+		}
+	}
+	for _, mark := range day.Ailments {
+		if !had[mark] {
+			s.logRunEvent(mark.PlayerID, EventAilmentOnset, 1, mark.Name)
+		}
+	}
+}
+
+// RunReport is the post-run report: the summary, a row per day, the event log and the run's totals.
+// Totals sums every day, with Day holding the number of days recorded.
+type RunReport struct {
+	Summary RunSummary `json:"summary"`
+	Seed    int64      `json:"seed"`
+	Totals  DayStats   `json:"totals"`
+	Days    []DayStats `json:"days"`
+	Events  []RunEvent `json:"events"`
+}
+
+// Report gathers the run's statistics into a RunReport.
+func (s *RunState) Report() RunReport {
+	report := RunReport{
+		Summary: s.Summary(),
+		Seed:    s.Config.Seed,
+		Days:    append([]DayStats(nil), s.Stats.Days...),
+		Events:  append([]RunEvent(nil), s.Stats.Log...),
+	}
+	for _, day := range report.Days {
+		t := &report.Totals
+		t.KcalEaten += day.KcalEaten
+		t.KcalBurned += day.KcalBurned
+		t.KcalHunted += day.KcalHunted
+		t.KcalForaged += day.KcalForaged
+		t.Hunts += day.Hunts
+		t.Forages += day.Forages
+		t.TrapCatches += day.TrapCatches
+		t.Km += day.Km
+		t.FireHours += day.FireHours
+		t.PrecipMM += day.PrecipMM
+		if t.Day == 0 || day.LowC < t.LowC {
+			t.LowC = day.LowC
+		}
+		if t.Day == 0 || day.HighC > t.HighC {
+			t.HighC = day.HighC
+		}
+		t.Day++
+	}
+	return report
+}
+
+// Lines renders the report's totals for the summary screen and the headless runner.
+func (r RunReport) Lines() []string {
+	t := r.Totals
+	if t.Day == 0 {
+		return []string{"No statistics recorded."}
+	}
+	lines := []string{
+		fmt.Sprintf("Calories: %.0f eaten, %.0f burned (%+.0f)", t.KcalEaten, t.KcalBurned, t.KcalEaten-t.KcalBurned),
+		fmt.Sprintf("Food: %d hunts/fish (%.0f kcal), %d forages (%.0f kcal), %d trap catches", t.Hunts, t.KcalHunted, t.Forages, t.KcalForaged, t.TrapCatches),
+		fmt.Sprintf("Travelled %.1f km | fire lit %.0f h over %d days", t.Km, t.FireHours, t.Day),
+		fmt.Sprintf("Weather: %d..%dC, %.0f mm of rain", t.LowC, t.HighC, t.PrecipMM),
+	}
+	onsets := 0
+	for _, e := range r.Events {
+		if e.Kind == EventAilmentOnset {
+			onsets++
+		}
+	}
+	if onsets > 0 {
+		lines = append(lines, fmt.Sprintf("Ailments picked up: %d", onsets))
+	}
+	return lines
+}
+
+// WriteJSON writes the whole report as indented JSON.
+func (r RunReport) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteDaysCSV writes one CSV row per day.
+func (r RunReport) WriteDaysCSV(w io.Writer) error {
+	out := csv.NewWriter(w)
+	_ = out.Write([]string{"day", "kcal_eaten", "kcal_burned", "kcal_hunted", "kcal_foraged", "hunts", "forages", "trap_catches", "km", "fire_hours", "weather", "low_c", "high_c", "precip_mm", "ailments"})
+	for _, d := range r.Days {
+		ailments := make([]string, 0, len(d.Ailments))
+		for _, a := range d.Ailments {
+			ailments = append(ailments, fmt.Sprintf("P%d %s", a.PlayerID, a.Name))
+		}
+		_ = out.Write([]string{
+			strconv.Itoa(d.Day), csvFloat(d.KcalEaten), csvFloat(d.KcalBurned), csvFloat(d.KcalHunted), csvFloat(d.KcalForaged),
+			strconv.Itoa(d.Hunts), strconv.Itoa(d.Forages), strconv.Itoa(d.TrapCatches), csvFloat(d.Km), csvFloat(d.FireHours),
+			string(d.Weather), strconv.Itoa(d.LowC), strconv.Itoa(d.HighC), csvFloat(d.PrecipMM), strings.Join(ailments, "; "),
+		})
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// WriteEventsCSV writes the event log as CSV.
+func (r RunReport) WriteEventsCSV(w io.Writer) error {
+	out := csv.NewWriter(w)
+	_ = out.Write([]string{"day", "clock", "player", "kind", "value", "detail"})
+	for _, e := range r.Events {
+		_ = out.Write([]string{strconv.Itoa(e.Day), csvFloat(e.Clock), strconv.Itoa(e.PlayerID), string(e.Kind), csvFloat(e.Value), e.Detail})
+	}
+	out.Flush()
+	return out.Error()
+}
+
+func csvFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
+
+// ExportRunReport writes base.json, base-days.csv and base-events.csv and returns the paths written.
+func ExportRunReport(base string, r RunReport) ([]string, error) {
+	base = strings.TrimSuffix(base, ".json")
+	files := []struct {
+		path  string
+		write func(io.Writer) error
+	}{
+		{base + ".json", r.WriteJSON},
+		{base + "-days.csv", r.WriteDaysCSV},
+		{base + "-events.csv", r.WriteEventsCSV},
+	}
+	var written []string
+	for _, f := range files {
+		file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+		if err != nil {
+			return written, fmt.Errorf("write report: %w", err)
+		}
+		err = f.write(file)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return written, fmt.Errorf("write report %s: %w", f.path, err)
+		}
+		written = append(written, f.path)
+	}
+	return written, nil
+}
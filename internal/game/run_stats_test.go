@@ -0,0 +1,85 @@
+package game
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunStatsFoldEventsByDay(t *testing.T) {
+	run := newRunForCommands(t)
+	if _, err := run.ForageAndConsume(1, PlantCategoryAny, 0); err != nil {
+		t.Fatalf("forage: %v", err)
+	}
+	run.Fire.Lit = true
+	run.AdvanceMinutes(120)
+	run.Fire.Lit = false
+	run.AdvanceMinutes(20 * 60)
+
+	if len(run.Stats.Days) != 2 || run.Stats.Days[1].Day != 2 {
+		t.Fatalf("expected a row for days 1 and 2, got %+v", run.Stats.Days)
+	}
+	day := run.Stats.Days[0]
+	if day.Forages != 1 || day.KcalForaged <= 0 || day.KcalEaten != day.KcalForaged {
+		t.Fatalf("expected the forage to be counted as food found and eaten: %+v", day)
+	}
+	if day.FireHours != 2 || day.KcalBurned <= 0 || day.Weather == "" || day.HighC < day.LowC {
+		t.Fatalf("expected fire uptime, calories burned and weather on day 1: %+v", day)
+	}
+	for _, e := range run.Stats.Log {
+		if continuousEvent(e.Kind) {
+			t.Fatalf("expected continuous events to stay out of the log, got %+v", e)
+		}
+	}
+}
+
+func TestRunStatsTrackAilmentTimeline(t *testing.T) {
+	run := newRunForCommands(t)
+	run.Players[0].Ailments = []Ailment{newInjury(AilmentCut, 2)}
+	run.AdvanceDay()
+	run.Players[0].Ailments = nil
+	run.AdvanceDay()
+
+	if got := run.Stats.Days[0].Ailments; len(got) != 1 || got[0].PlayerID != 1 {
+		t.Fatalf("expected the cut on day 1's row, got %+v", got)
+	}
+	var kinds []string
+	for _, e := range run.Stats.Log {
+		if e.Kind == EventAilmentOnset || e.Kind == EventAilmentCleared {
+			kinds = append(kinds, string(e.Kind)+"@"+e.Detail)
+		}
+	}
+	if len(kinds) != 2 || !strings.HasPrefix(kinds[0], "ailment_onset@") || !strings.HasPrefix(kinds[1], "ailment_cleared@") {
+		t.Fatalf("expected onset then cleared, got %v", kinds)
+	}
+}
+
+func TestExportRunReportWritesJSONAndCSV(t *testing.T) {
+	run := newRunForCommands(t)
+	if _, err := run.ForageAndConsume(1, PlantCategoryAny, 0); err != nil {
+		t.Fatalf("forage: %v", err)
+	}
+	run.AdvanceMinutes(24 * 60)
+	report := run.Report()
+	if report.Totals.Day != 2 || report.Totals.Forages != 1 || len(report.Lines()) < 4 {
+		t.Fatalf("unexpected report totals: %+v", report.Totals)
+	}
+
+	paths, err := ExportRunReport(filepath.Join(t.TempDir(), "report.json"), report)
+	if err != nil || len(paths) != 3 {
+		t.Fatalf("export: %v %v", paths, err)
+	}
+	days, err := os.ReadFile(paths[1])
+	if err != nil {
+		t.Fatalf("read days csv: %v", err)
+	}
+	rows := strings.Split(strings.TrimSpace(string(days)), "\n")
+	if len(rows) != 3 || !strings.HasPrefix(rows[0], "day,kcal_eaten,kcal_burned") {
+		t.Fatalf("expected a header and two day rows, got %q", rows)
+	}
+	events, err := os.ReadFile(paths[2])
+	if err != nil || !strings.Contains(string(events), "forage_kcal") {
+		t.Fatalf("expected the forage in the events csv: %v", err)
+	}
+}
@@ -22,6 +22,7 @@ type Scenario struct {
 	IssuedKit          IssuedKit
 	SeasonSets         []SeasonSet
 	DefaultSeasonSetID SeasonSetID
+	Patrols            []PatrolWindow `json:"patrols,omitempty"`
 }
 
 type ScenarioLocation struct {
@@ -64,6 +64,7 @@ func BuiltInScenarios() []Scenario {
 			IssuedKit:          kit,
 			SeasonSets:         []SeasonSet{set},
 			DefaultSeasonSetID: set.ID,
+			Patrols:            builtInScenarioPatrols(id, biome),
 		}
 	}
 
@@ -0,0 +1,137 @@
+package game
+
+import "math"
+
+// Discovery summary:
+//   - Fog used to be an isolation-only feature with a flat one-cell reveal; every mode now starts in fog and
+//     what a player uncovers depends on how far they can actually see from where they stand.
+//   - Sight starts at two cells and is shaped by how far the cell stands above its surroundings, the weather
+//     (reusing rescue.go's morning fog test), the time block, a working map or compass and Navigation.
+//   - FogMask stays the "ever seen" layer; FogSeenHour stamps when each cell was last in view so the map can
+//     tell cells in view now from ones the team only remembers.
+//   - Sight is refreshed around every active player as the clock runs, so waiting on a hilltop at dawn pays off.
+
+// FogLevel is how much the team knows about a map cell.
+type FogLevel uint8
+
+const (
+	FogUnseen     FogLevel = iota // nobody has seen it
+	FogRemembered                 // seen before, but not for a while
+	FogVisible                    // seen within the last fogFreshHours
+)
+
+const (
+	baseSightRadius = 2
+	maxSightRadius  = 7
+	// fogFreshHours is how long a cell counts as current after someone last had it in view.
+	fogFreshHours = 6
+)
+
+func (l FogLevel) String() string {
+	switch l {
+	case FogVisible:
+		return "visible"
+	case FogRemembered:
+		return "remembered"
+	default:
+		return "unseen"
+	}
+}
+
+// SightRadius is how many cells this player can make out from where they stand right now.
+func (s *RunState) SightRadius(playerID int) int {
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return 0
+	}
+	return s.sightRadiusAt(player, player.Travel.PosX, player.Travel.PosY)
+}
+
+func (s *RunState) sightRadiusAt(player *PlayerState, x, y int) int {
+	cell, ok := s.TopologyCellAt(x, y)
+	if !ok || player == nil {
+		return 0
+	}
+	radius := float64(baseSightRadius)
+	radius += clampFloat(float64(s.cellProminence(x, y))/10, 0, 3)
+
+	switch {
+	case s.foggy(cell):
+		radius -= 2
+	case s.Weather.Type == WeatherHeavyRain || s.Weather.Type == WeatherStorm || s.Weather.Type == WeatherBlizzard:
+		radius -= 1.5
+	case s.Weather.Type == WeatherRain || s.Weather.Type == WeatherSnow:
+		radius -= 1
+	}
+	switch s.CurrentTimeBlock() {
+	case TimeBlockNight:
+		radius -= 1.5
+	case TimeBlockDawn, TimeBlockDusk:
+		radius -= 0.5
+	}
+
+	// A map lets you place landmarks further out; a compass keeps your bearings when the view is poor.
+	if s.kitUsable(player, KitMap) {
+		radius++
+	}
+	if s.kitUsable(player, KitCompass) {
+		radius += 0.5
+	}
+	radius += float64(clamp(player.Navigation, 0, 100)) / 40
+	return clamp(int(math.Round(radius)), 0, maxSightRadius)
+}
+
+// cellProminence is how far a cell stands above the ring of cells two steps out, in elevation units.
+func (s *RunState) cellProminence(x, y int) int {
+	cell, ok := s.TopologyCellAt(x, y)
+	if !ok {
+		return 0
+	}
+	total, count := 0, 0
+	for oy := -2; oy <= 2; oy++ {
+		for ox := -2; ox <= 2; ox++ {
+			if ox > -2 && ox < 2 && oy > -2 && oy < 2 {
+				continue
+			}
+			if ring, ok := s.TopologyCellAt(x+ox, y+oy); ok {
+				total += int(ring.Elevation)
+				count++
+			}
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return int(cell.Elevation) - total/count
+}
+
+// FogLevelAt reports whether the team sees a cell now, only remembers it, or has never seen it.
+func (s *RunState) FogLevelAt(x, y int) FogLevel {
+	if !s.IsRevealed(x, y) {
+		return FogUnseen
+	}
+	idx, _ := s.topoIndex(x, y)
+	if idx < len(s.FogSeenHour) && s.FogSeenHour[idx] > 0 && s.fogHour()-int(s.FogSeenHour[idx]) < fogFreshHours {
+		return FogVisible
+	}
+	return FogRemembered
+}
+
+// fogHour numbers the hours of the run from 1, so a zero stamp means never seen.
+func (s *RunState) fogHour() int {
+	return clamp((s.Day-1)*24+int(s.ClockHours)+1, 1, math.MaxUint16)
+}
+
+// refreshSight reveals what every active player can see from where they stand.
+func (s *RunState) refreshSight() {
+	if s == nil || len(s.FogMask) != len(s.Topology.Cells) {
+		return
+	}
+	for i := range s.Players {
+		player := &s.Players[i]
+		if !player.Active() {
+			continue
+		}
+		s.revealFogFor(player, player.Travel.PosX, player.Travel.PosY, s.sightRadiusAt(player, player.Travel.PosX, player.Travel.PosY))
+	}
+}
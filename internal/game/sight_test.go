@@ -0,0 +1,104 @@
+package game
+
+import "testing"
+
+func newSightRun(t *testing.T) *RunState {
+	t.Helper()
+	run, err := NewRunState(RunConfig{
+		Mode:        ModeNakedAndAfraid,
+		ScenarioID:  ScenarioVancouverIslandID,
+		PlayerCount: 2,
+		RunLength:   RunLength{Days: 21},
+		Seed:        5150,
+	})
+	if err != nil {
+		t.Fatalf("new run: %v", err)
+	}
+	// A flat 15x15 plain with a hill in the middle.
+	cells := make([]TopoCell, 15*15)
+	for i := range cells {
+		cells[i] = TopoCell{Biome: TopoBiomeGrassland, Elevation: 10, Moisture: 90}
+	}
+	cells[7*15+7].Elevation = 50
+	run.Topology = WorldTopology{Width: 15, Height: 15, Cells: cells}
+	run.CellStates = nil
+	run.FogMask = nil
+	run.FogSeenHour = nil
+	for i := range run.Players {
+		run.Players[i].Fog = nil
+		run.Players[i].Kit = nil
+		run.Players[i].Navigation = 0
+		run.Players[i].Travel.PosX, run.Players[i].Travel.PosY = 2, 2
+	}
+	run.Config.IssuedKit = nil
+	run.ClockHours = 12
+	run.Weather = WeatherState{Day: run.Day, Type: WeatherSunny, TemperatureC: 18}
+	run.EnsureTopology()
+	return &run
+}
+
+func TestSightRadiusFollowsTerrainWeatherLightAndKit(t *testing.T) {
+	run := newSightRun(t)
+	flat := run.SightRadius(1)
+	if flat != baseSightRadius {
+		t.Fatalf("expected a flat midday view of %d cells, got %d", baseSightRadius, flat)
+	}
+
+	run.Players[0].Travel.PosX, run.Players[0].Travel.PosY = 7, 7
+	if hill := run.SightRadius(1); hill <= flat {
+		t.Fatalf("expected the hilltop to see further than %d, got %d", flat, hill)
+	}
+	run.Players[0].Travel.PosX, run.Players[0].Travel.PosY = 2, 2
+
+	run.Weather.Type = WeatherHeavyRain
+	if wet := run.SightRadius(1); wet >= flat {
+		t.Fatalf("expected heavy rain to cut the view below %d, got %d", flat, wet)
+	}
+	run.Weather.Type = WeatherSunny
+	run.ClockHours = 23
+	if dark := run.SightRadius(1); dark >= flat {
+		t.Fatalf("expected night to cut the view below %d, got %d", flat, dark)
+	}
+	run.ClockHours = 12
+
+	run.Players[0].Kit = []KitItem{KitMap, KitCompass}
+	run.Players[0].Navigation = 80
+	if equipped := run.SightRadius(1); equipped < flat+3 {
+		t.Fatalf("expected a map, compass and good navigation to add at least 3 cells, got %d", equipped)
+	}
+	if other := run.SightRadius(2); other != flat {
+		t.Fatalf("expected P2's view to stay at %d without the kit, got %d", flat, other)
+	}
+}
+
+func TestFogCoversEveryModeAndGoesStale(t *testing.T) {
+	run := newSightRun(t)
+	if run.FogLevelAt(2, 2) != FogVisible {
+		t.Fatalf("expected the start cell in view, got %s", run.FogLevelAt(2, 2))
+	}
+	if run.FogLevelAt(12, 12) != FogUnseen {
+		t.Fatalf("expected the far corner unexplored in paired mode, got %s", run.FogLevelAt(12, 12))
+	}
+
+	if _, err := run.TravelMove(1, "east", 0.4); err != nil {
+		t.Fatalf("travel: %v", err)
+	}
+	x, y := run.Players[0].Travel.PosX, run.Players[0].Travel.PosY
+	if x != 6 || !run.PlayerHasSeen(1, x+1, y) || run.PlayerHasSeen(2, x+1, y) {
+		t.Fatalf("expected only the walker to have seen the ground ahead of (%d,%d)", x, y)
+	}
+
+	// P2 keeps watch on the start cell; the ground only P1 saw fades once they move on.
+	run.Encounter = nil
+	run.Players[0].Travel.PosX, run.Players[0].Travel.PosY = 12, 12
+	run.AdvanceMinutes((fogFreshHours + 1) * 60)
+	if got := run.FogLevelAt(2, 2); got != FogVisible {
+		t.Fatalf("expected P2 to keep their own cell in view, got %s", got)
+	}
+	if got := run.FogLevelAt(x+1, y); got != FogRemembered {
+		t.Fatalf("expected the ground P1 left behind to be remembered but stale, got %s", got)
+	}
+	if got := run.FogLevelAt(12, 12); got != FogVisible {
+		t.Fatalf("expected P1's new cell in view, got %s", got)
+	}
+}
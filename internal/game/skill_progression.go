@@ -0,0 +1,394 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Discovery summary:
+//   - The ten skills are plain 0-100 ints on PlayerState and every action that exercises one raised it through applySkillEffort.
+//   - Practice now banks XP per skill; each level costs more than the last, so the first levels come quickly and the last slowly.
+//   - The Mental trait speeds or slows learning; a teammate who knows a skill better can teach it and give a same-day lesson bonus.
+//   - Skills left unused for long stretches slip a level every few days, reported with the morning's news.
+//   - A few techniques (hand-drill fire, the harder deadfalls and fences, fish weirs) stay locked below a skill threshold.
+//   - PlayerConfig carries the banked XP so a profile's part-earned levels survive between runs.
+const (
+	skillLevelCostPerLevel = 0.05
+	skillMentalXPFactor    = 0.08
+	lessonXPFactor         = 1.5
+	teachHours             = 1.0
+	teachMinLead           = 10
+	skillDecayGraceDays    = 10
+	skillDecayEveryDays    = 4
+)
+
+// SkillID names one of the player's trained skills.
+type SkillID string
+
+const (
+	SkillHunting      SkillID = "hunting"
+	SkillFishing      SkillID = "fishing"
+	SkillForaging     SkillID = "foraging"
+	SkillCrafting     SkillID = "crafting"
+	SkillGathering    SkillID = "gathering"
+	SkillTrapping     SkillID = "trapping"
+	SkillFirecraft    SkillID = "firecraft"
+	SkillSheltercraft SkillID = "sheltercraft"
+	SkillCooking      SkillID = "cooking"
+	SkillNavigation   SkillID = "navigation"
+)
+
+// AllSkills lists the skills in display order.
+func AllSkills() []SkillID {
+	return []SkillID{SkillHunting, SkillFishing, SkillForaging, SkillCrafting, SkillGathering, SkillTrapping, SkillFirecraft, SkillSheltercraft, SkillCooking, SkillNavigation}
+}
+
+var skillAliases = map[string]SkillID{
+	"hunt": SkillHunting, "fish": SkillFishing, "forage": SkillForaging, "craft": SkillCrafting, "gather": SkillGathering,
+	"trap": SkillTrapping, "traps": SkillTrapping, "fire": SkillFirecraft, "shelter": SkillSheltercraft, "cook": SkillCooking,
+	"nav": SkillNavigation, "navigate": SkillNavigation,
+}
+
+func parseSkillID(raw string) (SkillID, bool) {
+	value := strings.ToLower(strings.TrimSpace(raw))
+	if id, ok := skillAliases[value]; ok {
+		return id, true
+	}
+	for _, id := range AllSkills() {
+		if string(id) == value {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// SkillProgress is the XP banked toward a skill's next level and the day it was last practised.
+type SkillProgress struct {
+	XP       float64 `json:"xp,omitempty"`
+	LastUsed int     `json:"last_used,omitempty"`
+}
+
+// SkillLesson is a lesson taken today; practising the same skill before the day is out learns faster.
+type SkillLesson struct {
+	Skill     SkillID `json:"skill"`
+	Day       int     `json:"day"`
+	TeacherID int     `json:"teacher_id"`
+}
+
+// skillLevel points at the player's level for the skill.
+func (p *PlayerState) skillLevel(id SkillID) *int {
+	switch id {
+	case SkillHunting:
+		return &p.Hunting
+	case SkillFishing:
+		return &p.Fishing
+	case SkillForaging:
+		return &p.Foraging
+	case SkillCrafting:
+		return &p.Crafting
+	case SkillGathering:
+		return &p.Gathering
+	case SkillTrapping:
+		return &p.Trapping
+	case SkillFirecraft:
+		return &p.Firecraft
+	case SkillSheltercraft:
+		return &p.Sheltercraft
+	case SkillCooking:
+		return &p.Cooking
+	case SkillNavigation:
+		return &p.Navigation
+	}
+	return nil
+}
+
+// SkillLevel is the player's level in the skill, 0-100.
+func (p PlayerState) SkillLevel(id SkillID) int {
+	if level := p.skillLevel(id); level != nil {
+		return *level
+	}
+	return 0
+}
+
+// skillLevelCost is the XP it takes to go from level to level+1.
+func skillLevelCost(level int) float64 {
+	return 1 + float64(level)*skillLevelCostPerLevel
+}
+
+// skillXPGain is the XP one bout of practice earns: more for longer work and for getting it right, scaled by Mental.
+func skillXPGain(p PlayerState, effort int, success bool) float64 {
+	gain := float64(1 + max(1, effort)/25)
+	if success {
+		gain++
+	}
+	return gain * clampFloat(1+skillMentalXPFactor*float64(p.Mental), 0.7, 1.3)
+}
+
+// addSkillXP banks XP on a skill and spends it on as many levels as it covers.
+func (p *PlayerState) addSkillXP(id SkillID, xp float64) {
+	level := p.skillLevel(id)
+	if level == nil || xp <= 0 {
+		return
+	}
+	if p.SkillProgress == nil {
+		p.SkillProgress = map[SkillID]SkillProgress{}
+	}
+	progress := p.SkillProgress[id]
+	progress.XP += xp
+	for *level < 100 && progress.XP >= skillLevelCost(*level) {
+		progress.XP -= skillLevelCost(*level)
+		*level++
+	}
+	if *level >= 100 {
+		progress.XP = 0
+	}
+	p.SkillProgress[id] = progress
+}
+
+func (p *PlayerState) markSkillUsed(id SkillID, day int) {
+	if p.SkillProgress == nil {
+		p.SkillProgress = map[SkillID]SkillProgress{}
+	}
+	progress := p.SkillProgress[id]
+	progress.LastUsed = day
+	p.SkillProgress[id] = progress
+}
+
+// trainSkill is one bout of practice at a skill.
+func (s *RunState) trainSkill(p *PlayerState, id SkillID, effort int, success bool) {
+	level := p.skillLevel(id)
+	if level == nil {
+		return
+	}
+	xp := skillXPGain(*p, effort, success)
+	if p.Lesson != nil && p.Lesson.Skill == id && p.Lesson.Day == s.Day {
+		xp *= lessonXPFactor
+	}
+	before := *level
+	p.addSkillXP(id, xp)
+	p.markSkillUsed(id, s.Day)
+	s.reportUnlockedTechniques(p, id, before)
+}
+
+// decayUnusedSkills lets a skill slip a level every few days once it has gone unused past the grace period.
+func (s *RunState) decayUnusedSkills(p *PlayerState) {
+	grace := skillDecayGraceDays + 2*clamp(p.Mental, -3, 3)
+	rusty := []string{}
+	for _, id := range AllSkills() {
+		level := p.skillLevel(id)
+		if *level <= 0 {
+			continue
+		}
+		progress := p.SkillProgress[id]
+		idle := s.Day - max(1, progress.LastUsed)
+		if idle <= grace || (idle-grace)%skillDecayEveryDays != 0 {
+			continue
+		}
+		*level--
+		progress.XP = 0
+		if p.SkillProgress == nil {
+			p.SkillProgress = map[SkillID]SkillProgress{}
+		}
+		p.SkillProgress[id] = progress
+		rusty = append(rusty, fmt.Sprintf("%s %d", id, *level))
+	}
+	if len(rusty) > 0 {
+		s.reports = append(s.reports, fmt.Sprintf("P%d %s is getting rusty: %s.", p.ID, p.Name, strings.Join(rusty, ", ")))
+	}
+}
+
+// SkillTechnique is a method a player can't use until a skill reaches a threshold.
+type SkillTechnique struct {
+	ID       string
+	Name     string
+	Skill    SkillID
+	MinLevel int
+}
+
+// skillTechniques are keyed by the fire method or trap they gate.
+var skillTechniques = []SkillTechnique{
+	{ID: string(FireMethodHandDrill), Name: "hand-drill fire", Skill: SkillFirecraft, MinLevel: 30},
+	{ID: "paiute_deadfall", Name: "Paiute deadfall", Skill: SkillTrapping, MinLevel: 20},
+	{ID: "rolling_log_deadfall", Name: "rolling log deadfall", Skill: SkillTrapping, MinLevel: 30},
+	{ID: "snare_fence", Name: "snare fence", Skill: SkillTrapping, MinLevel: 35},
+	{ID: "fish_weir", Name: "fish weir", Skill: SkillFishing, MinLevel: 30},
+}
+
+func techniqueByID(id string) (SkillTechnique, bool) {
+	for _, technique := range skillTechniques {
+		if technique.ID == id {
+			return technique, true
+		}
+	}
+	return SkillTechnique{}, false
+}
+
+// requireTechnique refuses a gated method until the player's skill reaches it.
+func requireTechnique(p PlayerState, id string) error {
+	technique, ok := techniqueByID(id)
+	if !ok {
+		return nil
+	}
+	if level := p.SkillLevel(technique.Skill); level < technique.MinLevel {
+		return fmt.Errorf("%s needs %s %d (P%d has %d)", technique.Name, technique.Skill, technique.MinLevel, p.ID, level)
+	}
+	return nil
+}
+
+// reportUnlockedTechniques tells a party member about techniques the last level-up opened.
+func (s *RunState) reportUnlockedTechniques(p *PlayerState, id SkillID, before int) {
+	member := false
+	for i := range s.Players {
+		member = member || &s.Players[i] == p
+	}
+	if !member {
+		return
+	}
+	now := p.SkillLevel(id)
+	for _, technique := range skillTechniques {
+		if technique.Skill == id && before < technique.MinLevel && now >= technique.MinLevel {
+			s.reports = append(s.reports, fmt.Sprintf("P%d %s can now manage a %s (%s %d).", p.ID, p.Name, technique.Name, id, now))
+		}
+	}
+}
+
+// TeachSkill has one player spend an hour showing another a skill they know better.
+func (s *RunState) TeachSkill(teacherID, learnerID int, id SkillID) (string, float64, error) {
+	teacher, ok := s.playerByID(teacherID)
+	if !ok {
+		return "", 0, fmt.Errorf("player %d not found", teacherID)
+	}
+	learner, ok := s.playerByID(learnerID)
+	if !ok {
+		return "", 0, fmt.Errorf("player %d not found", learnerID)
+	}
+	if teacherID == learnerID {
+		return "", 0, fmt.Errorf("P%d can't teach themselves", teacherID)
+	}
+	for _, p := range []*PlayerState{teacher, learner} {
+		if !p.Active() {
+			return "", 0, fmt.Errorf("P%d is out of the run", p.ID)
+		}
+	}
+	lead := teacher.SkillLevel(id) - learner.SkillLevel(id)
+	if lead < teachMinLead {
+		return "", 0, fmt.Errorf("P%d doesn't know enough more %s than P%d to teach it (%d vs %d)", teacherID, id, learnerID, teacher.SkillLevel(id), learner.SkillLevel(id))
+	}
+	before := learner.SkillLevel(id)
+	learner.addSkillXP(id, (2+float64(lead)/10)*clampFloat(1+skillMentalXPFactor*float64(learner.Mental), 0.7, 1.3))
+	learner.markSkillUsed(id, s.Day)
+	learner.Lesson = &SkillLesson{Skill: id, Day: s.Day, TeacherID: teacherID}
+	teacher.markSkillUsed(id, s.Day)
+	s.recordEvent(teacher, EventLessonGiven, 1)
+	s.reportUnlockedTechniques(learner, id, before)
+
+	_ = s.AdvanceActionClock(teachHours)
+	for _, p := range []*PlayerState{teacher, learner} {
+		p.Energy = clamp(p.Energy-2, 0, 100)
+		p.Morale = clamp(p.Morale+1, 0, 100)
+		refreshEffectBars(p)
+	}
+	return fmt.Sprintf("P%d teaches P%d %s (%.1fh): %d -> %d. P%d learns it faster for the rest of today.", teacherID, learnerID, id, teachHours, before, learner.SkillLevel(id), learnerID), teachHours, nil
+}
+
+// SkillsSummary lists a player's levels, progress to the next level and the techniques still locked.
+func (s *RunState) SkillsSummary(playerID int) (string, error) {
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return "", fmt.Errorf("player %d not found", playerID)
+	}
+	parts := make([]string, 0, len(AllSkills()))
+	for _, id := range AllSkills() {
+		level := player.SkillLevel(id)
+		pct := 0
+		if level < 100 {
+			pct = int(math.Floor(player.SkillProgress[id].XP / skillLevelCost(level) * 100))
+		}
+		parts = append(parts, fmt.Sprintf("%s %d (%d%%)", id, level, pct))
+	}
+	msg := fmt.Sprintf("P%d %s skills: %s.", playerID, player.Name, strings.Join(parts, ", "))
+	locked := []string{}
+	for _, technique := range skillTechniques {
+		if player.SkillLevel(technique.Skill) < technique.MinLevel {
+			locked = append(locked, fmt.Sprintf("%s at %s %d", technique.Name, technique.Skill, technique.MinLevel))
+		}
+	}
+	if len(locked) > 0 {
+		msg += " Locked: " + strings.Join(locked, ", ") + "."
+	}
+	return msg, nil
+}
+
+// SkillXP is the banked XP per skill, for carrying into a profile.
+func (p PlayerState) SkillXP() map[SkillID]float64 {
+	out := map[SkillID]float64{}
+	for id, progress := range p.SkillProgress {
+		if progress.XP > 0 {
+			out[id] = progress.XP
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// skillProgressFromXP seeds a new run's progress from a profile, dropping unknown skills and XP that would already be a level.
+func skillProgressFromXP(xp map[SkillID]float64, levels PlayerState) map[SkillID]SkillProgress {
+	if len(xp) == 0 {
+		return nil
+	}
+	ids := make([]SkillID, 0, len(xp))
+	for id := range xp {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	out := map[SkillID]SkillProgress{}
+	for _, id := range ids {
+		level := levels.skillLevel(id)
+		if level == nil || math.IsNaN(xp[id]) || xp[id] <= 0 {
+			continue
+		}
+		out[id] = SkillProgress{XP: math.Min(xp[id], skillLevelCost(*level)*0.99)}
+	}
+	return out
+}
+
+func (s *RunState) executeSkillsCommand(fields []string) RunCommandResult {
+	playerID, _ := extractPlayerID(fields)
+	msg, err := s.SkillsSummary(playerID)
+	if err != nil {
+		return RunCommandResult{Handled: true, Message: err.Error()}
+	}
+	return RunCommandResult{Handled: true, Message: msg}
+}
+
+// executeTeachCommand handles "teach <p#> <skill> [p#]": the first player learns, the second (default P1) teaches.
+func (s *RunState) executeTeachCommand(fields []string) RunCommandResult {
+	usage := RunCommandResult{Handled: true, Message: "Usage: teach <p#> <skill> [by p#]", Err: errCommandUsage}
+	players := []int{}
+	skill := SkillID("")
+	for _, field := range fields {
+		if id := parsePlayerToken(field); id > 0 {
+			players = append(players, id)
+			continue
+		}
+		if id, ok := parseSkillID(field); ok {
+			skill = id
+		}
+	}
+	if len(players) == 0 || skill == "" {
+		return usage
+	}
+	teacherID := 1
+	if len(players) > 1 {
+		teacherID = players[1]
+	}
+	msg, hours, err := s.TeachSkill(teacherID, players[0], skill)
+	if err != nil {
+		return RunCommandResult{Handled: true, Message: err.Error()}
+	}
+	return RunCommandResult{Handled: true, Message: msg, HoursAdvanced: hours}
+}
@@ -0,0 +1,105 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSkillLevelsCostMoreAsTheyRise(t *testing.T) {
+	run := newRunForCommands(t)
+	novice := &run.Players[0]
+	novice.Mental = 0
+	novice.Firecraft = 5
+	expert := *novice
+	expert.Firecraft = 80
+	expert.SkillProgress = nil
+
+	for i := 0; i < 10; i++ {
+		run.trainSkill(novice, SkillFirecraft, 8, true)
+		run.trainSkill(&expert, SkillFirecraft, 8, true)
+	}
+	if gained, expertGained := novice.Firecraft-5, expert.Firecraft-80; gained <= expertGained*2 || expertGained <= 0 {
+		t.Fatalf("expected diminishing returns: novice +%d, expert +%d", gained, expertGained)
+	}
+
+	sharp, dull := PlayerState{Mental: 3}, PlayerState{Mental: -3}
+	if skillXPGain(sharp, 8, true) <= skillXPGain(dull, 8, true) {
+		t.Fatalf("expected a strong Mental trait to learn faster")
+	}
+}
+
+func TestTeachingGivesTheLearnerAHeadStart(t *testing.T) {
+	run, err := NewRunState(RunConfig{Mode: ModeAlone, ScenarioID: ScenarioVancouverIslandID, PlayerCount: 2, RunLength: RunLength{Days: 30}, Seed: 77})
+	if err != nil {
+		t.Fatalf("new run state: %v", err)
+	}
+	run.Players[0].Trapping = 40
+	run.Players[1].Trapping = 10
+	run.Players[1].Mental = 0
+
+	res := run.ExecuteRunCommand("teach p2 trapping")
+	if res.HoursAdvanced != teachHours || run.Players[1].Trapping <= 10 {
+		t.Fatalf("expected a lesson to raise trapping: %q", res.Message)
+	}
+	if res := run.ExecuteRunCommand("teach p1 trapping by p2"); !strings.Contains(res.Message, "doesn't know enough") {
+		t.Fatalf("expected the weaker player to be unable to teach, got %q", res.Message)
+	}
+
+	taught := run.Players[1]
+	plain := run.Players[1]
+	plain.Lesson = nil
+	plain.SkillProgress = nil
+	taught.SkillProgress = nil
+	run.trainSkill(&taught, SkillTrapping, 25, true)
+	run.trainSkill(&plain, SkillTrapping, 25, true)
+	if taught.SkillProgress[SkillTrapping].XP+float64(taught.Trapping) <= plain.SkillProgress[SkillTrapping].XP+float64(plain.Trapping) {
+		t.Fatalf("expected the same-day lesson to boost practice")
+	}
+}
+
+func TestUnusedSkillsDecayInLongRuns(t *testing.T) {
+	run := newRunForCommands(t)
+	player := &run.Players[0]
+	player.Mental = 0
+	player.Hunting = 30
+	player.Fishing = 30
+	for day := 2; day <= 21; day++ {
+		player.markSkillUsed(SkillFishing, run.Day)
+		run.Day = day
+		run.decayUnusedSkills(player)
+	}
+	if !strings.Contains(strings.Join(run.DrainReports(), " "), "getting rusty: hunting") {
+		t.Fatalf("expected the decay to be reported")
+	}
+	if player.Hunting >= 30 || player.Fishing != 30 {
+		t.Fatalf("expected only the unused skill to slip: hunting %d, fishing %d", player.Hunting, player.Fishing)
+	}
+}
+
+func TestHandDrillIsLockedBelowFirecraftThreshold(t *testing.T) {
+	run := newRunForCommands(t)
+	run.CraftedItems = append(run.CraftedItems, "hand_drill_spindle", "hand_drill_hearth_board")
+	run.Players[0].Firecraft = 10
+	if _, _, err := run.TryCreateEmber(1, FireMethodHandDrill, ""); err == nil || !strings.Contains(err.Error(), "firecraft 30") {
+		t.Fatalf("expected hand drill to be locked, got %v", err)
+	}
+	run.Players[0].Firecraft = 29
+	run.Players[0].SkillProgress = map[SkillID]SkillProgress{SkillFirecraft: {XP: skillLevelCost(29) - 0.1}}
+	run.trainSkill(&run.Players[0], SkillFirecraft, 8, true)
+	if !strings.Contains(strings.Join(run.DrainReports(), " "), "hand-drill fire") {
+		t.Fatalf("expected a report when hand drill unlocks")
+	}
+	if _, _, err := run.TryCreateEmber(1, FireMethodHandDrill, ""); err != nil && strings.Contains(err.Error(), "firecraft") {
+		t.Fatalf("expected hand drill to be unlocked at firecraft %d, got %v", run.Players[0].Firecraft, err)
+	}
+}
+
+func TestSkillXPCarriesIntoTheNextRun(t *testing.T) {
+	players := CreatePlayers(RunConfig{PlayerCount: 1, Seed: 5, Players: []PlayerConfig{{Name: "Ash", Cooking: 20, SkillXP: map[SkillID]float64{SkillCooking: 1.2, "juggling": 3}}}})
+	if got := players[0].SkillProgress[SkillCooking].XP; got != 1.2 {
+		t.Fatalf("expected banked cooking xp 1.2, got %v", got)
+	}
+	if xp := players[0].SkillXP(); len(xp) != 1 {
+		t.Fatalf("expected unknown skills dropped, got %v", xp)
+	}
+}
@@ -0,0 +1,374 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Discovery summary:
+//   - Fatigue is derived from Energy in refreshEffectBars, so rest recovers Energy and lets the bar follow.
+//   - Shelter metrics, fire heat, sleeping kit, micro-location and weather already exist; this file only scores them per hour.
+//   - Night encounters reuse RollWildlifeEncounter with a "sleep" action so predator pressure can cut the night short;
+//     sleepers on different cells are rolled and protected as separate groups.
+type SleepMode string
+
+const (
+	SleepModeSleep SleepMode = "sleep"
+	SleepModeRest  SleepMode = "rest"
+	SleepModeNap   SleepMode = "nap"
+)
+
+const (
+	sleepWakeHour      = 6.0
+	sleepMaxHours      = 12.0
+	sleepDaytimeHours  = 8.0
+	restDefaultHours   = 2.0
+	napDefaultHours    = 1.0
+	sleepEncounterSalt = 7000
+)
+
+type SleepResult struct {
+	Mode           SleepMode
+	PlayerIDs      []int
+	HoursPlanned   float64
+	HoursSlept     float64
+	Quality        float64
+	EnergyGained   map[int]int
+	Interrupted    bool
+	InterruptedBy  string
+	Notes          []string
+	EncounterLogs  []string
+	DaysAdvanced   int
+	WokeClockHours float64
+}
+
+func parseSleepMode(raw string) SleepMode {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "rest":
+		return SleepModeRest
+	case "nap":
+		return SleepModeNap
+	default:
+		return SleepModeSleep
+	}
+}
+
+// defaultSleepHours sleeps through to dawn at night and falls back to a fixed block during the day.
+func (s *RunState) defaultSleepHours(mode SleepMode) float64 {
+	switch mode {
+	case SleepModeRest:
+		return restDefaultHours
+	case SleepModeNap:
+		return napDefaultHours
+	}
+	if s.ClockHours >= 18 || s.ClockHours < sleepWakeHour {
+		hours := sleepWakeHour - s.ClockHours
+		if hours <= 0 {
+			hours += 24
+		}
+		return clampFloat(hours, 1, sleepMaxHours)
+	}
+	return sleepDaytimeHours
+}
+
+func sleepRecoveryPerHour(mode SleepMode) float64 {
+	switch mode {
+	case SleepModeRest:
+		return 2.4
+	case SleepModeNap:
+		return 4.8
+	default:
+		return 6.5
+	}
+}
+
+// sleepQualityForPlayer scores rest conditions in roughly 0.15..1.5, with notes explaining the big factors.
+func (s *RunState) sleepQualityForPlayer(player *PlayerState) (float64, []string) {
+	quality := 0.6
+	notes := make([]string, 0, 4)
+
+	sheltered := false
+	if metrics, ok := s.currentShelterMetrics(); ok && player.MicroLocation == LocationInsideShelter && s.playerAtCamp(player) {
+		sheltered = true
+		quality += float64(metrics.Insulation)*0.03 + float64(metrics.Comfort)*0.035
+		if spec, ok := shelterByID(s.Shelter.Type); ok && spec.SleepShelter {
+			quality += 0.12
+		}
+		if isRainyWeather(s.Weather.Type) || isSevereWeather(s.Weather.Type) {
+			quality += float64(metrics.RainProtection)*0.025 - 0.12
+		}
+		if s.Weather.TemperatureC <= 2 {
+			quality += float64(metrics.Insulation)*0.02 - 0.1
+		}
+		if s.Shelter.Durability < 30 {
+			quality -= 0.08
+			notes = append(notes, "shelter is failing")
+		}
+	} else {
+		if s.Shelter.Type != "" && s.Shelter.Durability > 0 {
+			notes = append(notes, "sleeping outside the shelter")
+		}
+		if isRainyWeather(s.Weather.Type) {
+			quality -= 0.22
+			notes = append(notes, "rain soaks the bedding")
+		}
+		if isSevereWeather(s.Weather.Type) {
+			quality -= 0.25
+		}
+		if s.Weather.TemperatureC <= 2 {
+			quality -= 0.2
+		}
+	}
+
+	if s.kitUsable(player, KitSleepingBag) {
+		quality += 0.22
+		if s.Weather.TemperatureC <= 5 {
+			quality += 0.12
+		}
+	} else if hasAnyKitItem(*player, s.Config.IssuedKit, KitWoolBlanket) {
+		quality += 0.12
+		if s.Weather.TemperatureC <= 5 {
+			quality += 0.06
+		}
+	}
+	if hasAnyKitItem(*player, s.Config.IssuedKit, KitMosquitoNet) && biomeIsTropicalWet(s.Scenario.Biome) {
+		quality += 0.08
+	}
+	if s.campHasHere(player, CampStructureRaisedBed) {
+		quality += 0.06
+	}
+
+	if s.Fire.Lit {
+		if s.Weather.TemperatureC <= 10 {
+			quality += clampFloat(float64(s.Fire.HeatC)/220.0, 0.05, 0.25)
+			notes = append(notes, "fire warmth")
+		} else if s.Weather.TemperatureC >= 28 {
+			quality -= 0.05
+		}
+	} else if s.Weather.TemperatureC <= 0 && !sheltered {
+		quality -= 0.15
+		notes = append(notes, "no fire against the cold")
+	}
+
+	if s.Weather.TemperatureC >= 30 {
+		quality -= 0.12
+	}
+	if player.Hunger >= 70 {
+		quality -= 0.1
+	}
+	if player.Thirst >= 70 {
+		quality -= 0.1
+	}
+	if len(player.Ailments) > 0 {
+		quality -= 0.05 * float64(min(len(player.Ailments), 3))
+	}
+
+	return clampFloat(quality, 0.15, 1.5), notes
+}
+
+// sleepPredatorDeterred reports whether shelter or fire keeps a predator from waking a group sleeping on one cell.
+// Both only count for a group bedded down at camp.
+func (s *RunState) sleepPredatorDeterred(group []*PlayerState) bool {
+	if !s.playerAtCamp(group[0]) {
+		return false
+	}
+	safety := 0
+	if metrics, ok := s.currentShelterMetrics(); ok {
+		for _, player := range group {
+			if player.MicroLocation == LocationInsideShelter {
+				safety = metrics.PredatorSafety
+				break
+			}
+		}
+	}
+	if s.Fire.Lit && s.Fire.Intensity >= 20 {
+		safety += 3
+	}
+	return safety >= 6
+}
+
+// sleepGroups splits sleepers by the cell they bed down on, keeping their order.
+func sleepGroups(sleepers []*PlayerState) [][]*PlayerState {
+	groups := [][]*PlayerState{}
+	for _, player := range sleepers {
+		placed := false
+		for i, group := range groups {
+			if group[0].Travel.PosX == player.Travel.PosX && group[0].Travel.PosY == player.Travel.PosY {
+				groups[i] = append(groups[i], player)
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			groups = append(groups, []*PlayerState{player})
+		}
+	}
+	return groups
+}
+
+func (s *RunState) Sleep(mode SleepMode, playerID int, hours float64) (SleepResult, error) {
+	if s == nil {
+		return SleepResult{}, fmt.Errorf("run state is nil")
+	}
+	s.EnsurePlayerRuntimeStats()
+
+	sleepers := make([]*PlayerState, 0, len(s.Players))
+	if playerID > 0 {
+		player, ok := s.playerByID(playerID)
+		if !ok {
+			return SleepResult{}, fmt.Errorf("player %d not found", playerID)
+		}
+		sleepers = append(sleepers, player)
+	} else {
+		for i := range s.Players {
+			if s.Players[i].Active() {
+				sleepers = append(sleepers, &s.Players[i])
+			}
+		}
+	}
+	if len(sleepers) == 0 {
+		return SleepResult{}, fmt.Errorf("no players to rest")
+	}
+
+	if hours <= 0 {
+		hours = s.defaultSleepHours(mode)
+	}
+	hours = clampFloat(hours, 0.25, sleepMaxHours)
+
+	result := SleepResult{
+		Mode:         mode,
+		HoursPlanned: hours,
+		EnergyGained: map[int]int{},
+	}
+	for _, player := range sleepers {
+		result.PlayerIDs = append(result.PlayerIDs, player.ID)
+	}
+
+	energyCarry := make([]float64, len(sleepers))
+	qualityTotal := 0.0
+	qualitySamples := 0
+	noteSeen := map[string]bool{}
+	remaining := hours
+	step := 0
+	groups := sleepGroups(sleepers)
+	for remaining > 1e-6 {
+		chunk := math.Min(1, remaining)
+
+		// Sleep and naps can be interrupted; resting awake just logs what passes by.
+		if mode != SleepModeRest || s.CurrentTimeBlock() == TimeBlockNight {
+			for _, group := range groups {
+				if s.rollSleepEncounter(&result, mode, group, step) {
+					break
+				}
+			}
+			if result.Interrupted {
+				break
+			}
+		}
+
+		qualities := make([]float64, len(sleepers))
+		for i, player := range sleepers {
+			quality, notes := s.sleepQualityForPlayer(player)
+			qualities[i] = quality
+			qualityTotal += quality
+			qualitySamples++
+			for _, note := range notes {
+				if !noteSeen[note] {
+					noteSeen[note] = true
+					result.Notes = append(result.Notes, note)
+				}
+			}
+		}
+
+		result.DaysAdvanced += s.AdvanceMinutes(int(math.Round(chunk * 60)))
+
+		for i, player := range sleepers {
+			gain := sleepRecoveryPerHour(mode)*qualities[i]*chunk + energyCarry[i]
+			whole := math.Floor(gain)
+			energyCarry[i] = gain - whole
+			before := player.Energy
+			player.Energy = clamp(player.Energy+int(whole), 0, 100)
+			result.EnergyGained[player.ID] += player.Energy - before
+		}
+
+		result.HoursSlept += chunk
+		remaining -= chunk
+		step++
+	}
+
+	if qualitySamples > 0 {
+		result.Quality = qualityTotal / float64(qualitySamples)
+	}
+	for _, player := range sleepers {
+		if mode == SleepModeSleep && !result.Interrupted && result.HoursSlept >= 6 {
+			switch {
+			case result.Quality >= 1:
+				player.Morale = clamp(player.Morale+3, 0, 100)
+			case result.Quality >= 0.7:
+				player.Morale = clamp(player.Morale+1, 0, 100)
+			case result.Quality < 0.4:
+				player.Morale = clamp(player.Morale-2, 0, 100)
+			}
+		}
+		clampPlayer(player)
+		refreshEffectBars(player)
+	}
+	result.WokeClockHours = s.ClockHours
+	return result, nil
+}
+
+// rollSleepEncounter rolls one hour of wildlife at a sleeping group's cell and reports whether a predator woke it.
+func (s *RunState) rollSleepEncounter(result *SleepResult, mode SleepMode, group []*PlayerState, step int) bool {
+	x, y := group[0].Travel.PosX, group[0].Travel.PosY
+	event, ok := s.RollWildlifeEncounter(group[0].ID, x, y, "sleep", sleepEncounterSalt+step)
+	if !ok || event.Channel == "ambient" {
+		return false
+	}
+	if event.Predator {
+		if s.sleepPredatorDeterred(group) {
+			result.EncounterLogs = append(result.EncounterLogs, fmt.Sprintf("%s circles camp but keeps its distance.", event.Species))
+			return false
+		}
+		for _, player := range group {
+			player.Morale = clamp(player.Morale+event.MoraleDelta, 0, 100)
+		}
+		result.Interrupted = true
+		result.InterruptedBy = event.Species
+		result.EncounterLogs = append(result.EncounterLogs, event.Message)
+		if prompt := s.openPredatorEncounter(group[0], event, "sleep", step); prompt != "" {
+			result.EncounterLogs = append(result.EncounterLogs, prompt)
+		}
+		return true
+	}
+	if event.Channel == "insect" && mode != SleepModeRest {
+		for _, player := range group {
+			if hasAnyKitItem(*player, s.Config.IssuedKit, KitMosquitoNet) {
+				continue
+			}
+			player.Energy = clamp(player.Energy+event.EnergyDelta, 0, 100)
+			player.Morale = clamp(player.Morale+event.MoraleDelta, 0, 100)
+		}
+		result.EncounterLogs = append(result.EncounterLogs, event.Message)
+	}
+	return false
+}
+
+func sleepQualityLabel(quality float64) string {
+	switch {
+	case quality >= 1.1:
+		return "deep"
+	case quality >= 0.8:
+		return "solid"
+	case quality >= 0.5:
+		return "broken"
+	default:
+		return "miserable"
+	}
+}
+
+func formatClockHours(clock float64) string {
+	total := int(math.Round(clock * 60))
+	total = ((total % 1440) + 1440) % 1440
+	return fmt.Sprintf("%02d:%02d", total/60, total%60)
+}
@@ -0,0 +1,94 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSleepAtNightWakesAtDawnAndRecoversEnergy(t *testing.T) {
+	run := newRunForCommands(t)
+	run.ClockHours = 22
+	run.Weather = WeatherState{Day: run.Day, Type: WeatherClear, TemperatureC: 12}
+	run.Players[0].Energy = 40
+	refreshEffectBars(&run.Players[0])
+	beforeFatigue := run.Players[0].Fatigue
+
+	res := run.ExecuteRunCommand("sleep")
+	if !res.Handled {
+		t.Fatalf("expected sleep to be handled")
+	}
+	if res.HoursAdvanced <= 0 {
+		t.Fatalf("expected sleep to advance time, got: %s", res.Message)
+	}
+	if !strings.Contains(res.Message, "slept") {
+		t.Fatalf("expected sleep summary, got: %s", res.Message)
+	}
+	if !strings.Contains(res.Message, "Woken early") {
+		if run.Day != 2 || run.ClockHours < 5.9 || run.ClockHours > 6.1 {
+			t.Fatalf("expected to wake on day 2 around 06:00, got day %d %.2f", run.Day, run.ClockHours)
+		}
+	}
+	if run.Players[0].Energy <= 40 {
+		t.Fatalf("expected energy recovery, got %d", run.Players[0].Energy)
+	}
+	if run.Players[0].Fatigue >= beforeFatigue {
+		t.Fatalf("expected fatigue to fall, before=%d after=%d", beforeFatigue, run.Players[0].Fatigue)
+	}
+}
+
+func TestSleepQualityImprovesWithShelterAndBedding(t *testing.T) {
+	run := newRunForCommands(t)
+	run.Weather = WeatherState{Day: run.Day, Type: WeatherRain, TemperatureC: 1}
+	player := &run.Players[0]
+
+	exposed, _ := run.sleepQualityForPlayer(player)
+
+	run.Shelter = ShelterState{Type: ShelterDebrisHut, Durability: 90, BuiltDay: run.Day}
+	player.MicroLocation = LocationInsideShelter
+	player.Kit = append(player.Kit, KitSleepingBag)
+	run.Fire = FireState{Lit: true, Intensity: 40, HeatC: 60, FuelKg: 4}
+	sheltered, _ := run.sleepQualityForPlayer(player)
+
+	if sheltered <= exposed {
+		t.Fatalf("expected shelter, bag and fire to improve sleep quality: exposed=%.2f sheltered=%.2f", exposed, sheltered)
+	}
+}
+
+func TestRestDefaultsToShortBlockForOnePlayer(t *testing.T) {
+	run := newRunForCommands(t)
+	run.ClockHours = 12
+
+	result, err := run.Sleep(SleepModeRest, 1, 0)
+	if err != nil {
+		t.Fatalf("rest: %v", err)
+	}
+	if result.HoursSlept != restDefaultHours {
+		t.Fatalf("expected %.1fh rest, got %.2f", restDefaultHours, result.HoursSlept)
+	}
+	if len(result.PlayerIDs) != 1 || result.PlayerIDs[0] != 1 {
+		t.Fatalf("expected only P1 to rest, got %v", result.PlayerIDs)
+	}
+}
+
+func TestSleepersAwayFromCampGetNoCampProtection(t *testing.T) {
+	run := newOutcomeRun(t, ModeNakedAndAfraid, 2)
+	p1, _ := run.playerByID(1)
+	p2, _ := run.playerByID(2)
+	run.Shelter = ShelterState{Type: ShelterLogCabin, Durability: 90, BuiltDay: run.Day, SiteX: p1.Travel.PosX, SiteY: p1.Travel.PosY}
+	run.placeCamp()
+	run.Fire = FireState{Lit: true, Intensity: 60, HeatC: 60, FuelKg: 4}
+	p1.MicroLocation = LocationInsideShelter
+	p2.Travel.PosX += 2
+
+	groups := sleepGroups([]*PlayerState{p1, p2})
+	if len(groups) != 2 || groups[0][0].ID != 1 || groups[1][0].ID != 2 {
+		t.Fatalf("expected one sleeping group per cell, got %d", len(groups))
+	}
+	if !run.sleepPredatorDeterred(groups[0]) {
+		t.Fatalf("expected the shelter and fire to guard P1 at camp")
+	}
+	p2.MicroLocation = LocationInsideShelter
+	if run.sleepPredatorDeterred(groups[1]) {
+		t.Fatalf("expected P2 away from camp to get nothing from the camp shelter or fire")
+	}
+}
@@ -2,6 +2,7 @@ package game
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"time"
 )
@@ -16,27 +17,45 @@ type RunState struct {
 	Contestants []ContestantState `json:"contestants,omitempty"`
 	Weather     WeatherState
 
-	MetabolismProgress  float64         `json:"metabolism_progress"`
-	WoodStock           []WoodStock     `json:"wood_stock,omitempty"`
-	ResourceStock       []ResourceStock `json:"resource_stock,omitempty"`
-	CampInventory       []InventoryItem `json:"camp_inventory,omitempty"`
-	Travel              TravelState     `json:"travel"`
-	Fire                FireState       `json:"fire"`
-	FirePrep            FirePrepState   `json:"fire_prep"`
-	Shelter             ShelterState    `json:"shelter"`
-	CraftedItems        []string        `json:"crafted_items,omitempty"`
-	PlacedTraps         []PlacedTrap    `json:"placed_traps,omitempty"`
-	FireAttemptCount    int             `json:"fire_attempt_count"`
-	ProcessAttemptCount int             `json:"process_attempt_count"`
-	Topology            WorldTopology   `json:"topology"`
-	FogMask             []bool          `json:"fog_mask,omitempty"`
-	CellStates          []CellState     `json:"cell_states,omitempty"`
+	MetabolismProgress  float64            `json:"metabolism_progress"`
+	WoodStock           []WoodStock        `json:"wood_stock,omitempty"`
+	ResourceStock       []ResourceStock    `json:"resource_stock,omitempty"`
+	CampInventory       []InventoryItem    `json:"camp_inventory,omitempty"`
+	Fire                FireState          `json:"fire"`
+	FirePrep            FirePrepState      `json:"fire_prep"`
+	Shelter             ShelterState       `json:"shelter"`
+	Camp                CampState          `json:"camp,omitempty"`
+	CraftedItems        []string           `json:"crafted_items,omitempty"`
+	PlacedTraps         []PlacedTrap       `json:"placed_traps,omitempty"`
+	FireAttemptCount    int                `json:"fire_attempt_count"`
+	ProcessAttemptCount int                `json:"process_attempt_count"`
+	ContestantTicks     int                `json:"contestant_ticks"`
+	Topology            WorldTopology      `json:"topology"`
+	FogMask             []bool             `json:"fog_mask,omitempty"`      // every cell anyone on the team has seen
+	FogSeenHour         []uint16           `json:"fog_seen_hour,omitempty"` // run hour (from 1) each cell was last in view
+	CellStates          []CellState        `json:"cell_states,omitempty"`
+	PointsOfInterest    []PointOfInterest  `json:"points_of_interest,omitempty"`
+	Outcomes            []PlayerOutcome    `json:"outcomes,omitempty"`
+	Encounter           *PredatorEncounter `json:"encounter,omitempty"`
+	Gear                []GearCondition    `json:"gear,omitempty"`
+	Rescue              *RescueRecord      `json:"rescue,omitempty"`
+	Stats               RunStats           `json:"stats"`
+
+	// Background reports and clock bookkeeping; transient, so it stays out of saves and state hashes.
+	reports        []string
+	clockHeld      bool
+	advancingClock bool
 }
 
 func NewRunState(config RunConfig) (RunState, error) {
+	return newRunStateFrom(config, AllScenarios())
+}
+
+// newRunStateFrom builds a run picking its scenario from the given list rather than the registered ones.
+func newRunStateFrom(config RunConfig, scenarios []Scenario) (RunState, error) {
 	resolvedConfig := config
 
-	if err := resolvedConfig.Validate(); err != nil {
+	if err := resolvedConfig.validateWith(scenarios); err != nil {
 		return RunState{}, err
 	}
 
@@ -44,8 +63,6 @@ func NewRunState(config RunConfig) (RunState, error) {
 		resolvedConfig.Seed = time.Now().UnixNano()
 	}
 
-	scenarios := AllScenarios()
-
 	if resolvedConfig.ScenarioID == ScenarioRandomID {
 		rng := seededRNG(resolvedConfig.Seed)
 		resolvedConfig.ScenarioID = scenarios[rng.IntN(len(scenarios))].ID
@@ -73,8 +90,11 @@ func NewRunState(config RunConfig) (RunState, error) {
 		}
 	}
 	state.EnsureWeather()
+	state.statsDay()
+	state.syncWeatherHour()
 	state.EnsurePlayerRuntimeStats()
 	state.initTopology()
+	state.ensureContestants()
 
 	return state, nil
 }
@@ -89,16 +109,28 @@ func GetScenario(scenarios []Scenario, id ScenarioID) (Scenario, bool) {
 	return Scenario{}, false
 }
 
+// ContestantDayTick is one in-game day of Alone rivals; clients simulate it each time the run crosses midnight.
+const ContestantDayTick = 24 * time.Hour
+
+// ProcessContestantSimulation runs Alone rivals through delta of in-game time, an hour at a time,
+// and closes with the producer's report when anyone is still out there.
 func (s *RunState) ProcessContestantSimulation(delta time.Duration) []string {
 	if s.Config.Mode != ModeAlone || len(s.Contestants) == 0 {
 		return nil
 	}
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	s.ensureContestants()
+	// Seeded from the run so journals replay contestant outcomes exactly.
+	s.ContestantTicks++
+	r := rand.New(rand.NewSource(seedFromLabel(s.Config.Seed, fmt.Sprintf("contestants:%d:%d", s.Day, s.ContestantTicks))))
+	hours := int(math.Round(delta.Hours()))
 	var messages []string
 	for i := range s.Contestants {
-		if hasEvent, msg := s.Contestants[i].processMacroTick(delta, s.Weather, r); hasEvent {
-			messages = append(messages, msg)
+		for h := 0; h < hours; h++ {
+			if msg := s.simulateContestantHour(&s.Contestants[i], r); msg != "" {
+				messages = append(messages, msg)
+				break
+			}
 		}
 	}
-	return messages
+	return append(messages, s.ProducersReport())
 }
@@ -0,0 +1,288 @@
+package game
+
+import (
+	"fmt"
+	"math"
+)
+
+// Discovery summary:
+// - Cold and heat only reached players through the daily temperatureStressImpact buckets; contestants still use them.
+// - Players now carry a core temperature and a clothing wetness that move every time the clock advances.
+// - The air a player feels combines WeatherState, wind, night and sun, the camp fire (FireState.HeatC) and shelter insulation when at camp.
+// - Clothing, kit like KitThermalLayer and crafted jackets insulate; wet clothes lose most of that and chill as they dry.
+// - Rain soaks clothes unless a rain jacket or shelter keeps it off; wading a river in TravelMove soaks them at once.
+// - Core temperature drifting out of range becomes the hypothermia or heat exhaustion ailment.
+// - Time that passes outside AdvanceMinutes (a skipped day, GUI realtime) runs through the same model an hour at a time.
+const (
+	normalCoreTempC       = 37.0
+	coreDriftHours        = 2.5
+	coreRecoveryHours     = 1.5
+	coreDegreesPerAirC    = 0.12
+	soakedWetness         = 100.0
+	wetInsulationLoss     = 0.6
+	wetChillC             = 6.0
+	baseClothingWarmth    = 3
+	coldClimateClothing   = 6
+	rainJacketWetFactor   = 0.3
+	crossingSplashWetness = 15.0
+)
+
+// CoreTemperature is the player's core temperature in Celsius.
+func (p PlayerState) CoreTemperature() float64 {
+	if p.CoreTempC == 0 {
+		return normalCoreTempC
+	}
+	return p.CoreTempC
+}
+
+// playerAtCamp reports whether the player is at the camp site, where the shelter, fire and camp stores are.
+func (s *RunState) playerAtCamp(player *PlayerState) bool {
+	x, y, placed := s.campSite()
+	if !placed {
+		return true
+	}
+	return player != nil && x == player.Travel.PosX && y == player.Travel.PosY
+}
+
+// atCamp reports whether anyone still in the run is at camp to mind it.
+func (s *RunState) atCamp() bool {
+	x, y, placed := s.campSite()
+	return !placed || len(s.PlayersAt(x, y)) > 0
+}
+
+func (s *RunState) campShelter(player *PlayerState) (shelterMetrics, bool) {
+	if !s.playerAtCamp(player) {
+		return shelterMetrics{}, false
+	}
+	return s.currentShelterMetrics()
+}
+
+// clothingInsulation is how much the player is wearing, before wetness.
+func (s *RunState) clothingInsulation(player *PlayerState) int {
+	warmth := baseClothingWarmth
+	switch {
+	case s.Config.Mode == ModeNakedAndAfraid || s.Config.Mode == ModeNakedAndAfraidXL:
+		warmth = 0
+	case biomeIsArctic(s.Scenario.Biome):
+		// Contestants dress for the climate they are dropped into.
+		warmth += coldClimateClothing
+	}
+	for item, value := range map[KitItem]int{KitThermalLayer: 5, KitRainJacket: 1, KitWoolBlanket: 3, KitSleepingBag: 4} {
+		if s.kitUsable(player, item) {
+			warmth += value
+		}
+	}
+	for id, value := range map[string]int{"hide_jacket": 4, "woven_tunic": 2, "grass_cape": 1, "hide_moccasins": 1} {
+		if hasPersonalItem(*player, id) {
+			warmth += value
+		}
+	}
+	return warmth
+}
+
+// windChillC is how much colder the wind makes it, from the hour's wind speed when the day has a timeline.
+func (s *RunState) windChillC() float64 {
+	if len(s.Weather.Hourly) > 0 {
+		return math.Min(10, float64(s.Weather.WindKph)/6)
+	}
+	switch s.Weather.Type {
+	case WeatherWindy, WeatherStorm:
+		return 4
+	case WeatherBlizzard:
+		return 8
+	}
+	return 0
+}
+
+// rainWetting is how fast the rain or snow falling this hour soaks exposed clothes, per hour.
+func (s *RunState) rainWetting() float64 {
+	if len(s.Weather.Hourly) > 0 {
+		return s.Weather.PrecipMM * 6
+	}
+	switch s.Weather.Type {
+	case WeatherRain:
+		return 12
+	case WeatherHeavyRain:
+		return 25
+	case WeatherStorm:
+		return 30
+	case WeatherSnow:
+		return 4
+	case WeatherBlizzard:
+		return 8
+	}
+	return 0
+}
+
+// feelsLikeC is the air temperature the player's body is dealing with right now.
+func (s *RunState) feelsLikeC(player *PlayerState) float64 {
+	temp := float64(s.Weather.TemperatureC)
+	shelter, sheltered := s.campShelter(player)
+	wind := s.windChillC()
+	if sheltered {
+		wind = math.Max(0, wind-float64(shelter.WindProtection))
+		temp += float64(shelter.Insulation) * 1.5
+	}
+	temp -= wind
+	switch block := s.CurrentTimeBlock(); {
+	case block == TimeBlockNight:
+		temp -= 3
+	case block == TimeBlockDay && !sheltered && (s.Weather.Type == WeatherSunny || s.Weather.Type == WeatherHeatwave):
+		temp += 4
+	}
+	if s.Fire.Lit && s.playerAtCamp(player) {
+		temp += float64(s.Fire.HeatC) / 8
+	}
+	return temp - player.Wetness/100*wetChillC
+}
+
+// coreTargetC is where the player's core settles if conditions hold.
+func (s *RunState) coreTargetC(player *PlayerState) float64 {
+	insulation := float64(s.clothingInsulation(player)) * (1 - wetInsulationLoss*player.Wetness/100)
+	comfortLow := 18 - insulation*1.5
+	comfortHigh := 27 - insulation*0.4
+	feels := s.feelsLikeC(player)
+	switch {
+	case feels < comfortLow:
+		return normalCoreTempC - (comfortLow-feels)*coreDegreesPerAirC
+	case feels > comfortHigh:
+		target := normalCoreTempC + (feels-comfortHigh)*coreDegreesPerAirC
+		if player.Hydration < 30 {
+			target += 0.6
+		}
+		return target
+	default:
+		return normalCoreTempC
+	}
+}
+
+// updateWetness soaks clothes in rain and dries them by the fire, in the sun and in the wind.
+func (s *RunState) updateWetness(player *PlayerState, hours float64) {
+	rain := s.rainWetting()
+	shelter, sheltered := s.campShelter(player)
+	if sheltered {
+		rain *= 1 - clampFloat(float64(shelter.RainProtection)/6, 0, 0.9)
+	}
+	if s.kitUsable(player, KitRainJacket) {
+		rain *= rainJacketWetFactor
+	} else if hasPersonalItem(*player, "grass_cape") || hasPersonalItem(*player, "hide_jacket") {
+		rain *= 0.6
+	}
+	dry := 4 + math.Max(0, float64(s.Weather.TemperatureC))/5
+	if s.Fire.Lit && s.playerAtCamp(player) {
+		dry += float64(s.Fire.HeatC) / 10
+	}
+	switch s.Weather.Type {
+	case WeatherSunny, WeatherHeatwave:
+		dry += 4
+	}
+	dry += math.Min(6, float64(s.Weather.WindKph)/10)
+	if sheltered {
+		dry += float64(max(0, shelter.DrynessProtection))
+	}
+	if rain > 0 {
+		dry /= 3
+	}
+	player.Wetness = clampFloat(player.Wetness+(rain-dry)*hours, 0, soakedWetness)
+}
+
+// applyThermalSpan runs every active player's thermal model over today's clock hours from..to, an hour at a time
+// under that hour's weather, then puts the clock and weather back.
+func (s *RunState) applyThermalSpan(from, to float64) {
+	if s == nil || to <= from {
+		return
+	}
+	clock := s.ClockHours
+	for h := from; h < to; {
+		end := math.Min(math.Floor(h)+1, to)
+		s.ClockHours = h
+		s.syncWeatherHour()
+		s.applyThermalToActive(end - h)
+		h = end
+	}
+	s.ClockHours = clock
+	s.syncWeatherHour()
+}
+
+// applyThermalSteps runs every active player's thermal model over hours of the current weather, an hour at a time.
+func (s *RunState) applyThermalSteps(hours float64) {
+	for hours > 0 {
+		step := math.Min(1, hours)
+		s.applyThermalToActive(step)
+		hours -= step
+	}
+}
+
+func (s *RunState) applyThermalToActive(hours float64) {
+	for i := range s.Players {
+		if s.Players[i].Active() {
+			s.applyThermalHours(&s.Players[i], hours)
+		}
+	}
+}
+
+// applyThermalHours moves wetness and core temperature on by the given time and turns a drifting core into ailments.
+func (s *RunState) applyThermalHours(player *PlayerState, hours float64) {
+	if player == nil || hours <= 0 {
+		return
+	}
+	s.updateWetness(player, hours)
+	core := player.CoreTemperature()
+	target := clampFloat(s.coreTargetC(player), 30, 42)
+	tau := coreDriftHours
+	if math.Abs(target-normalCoreTempC) < math.Abs(core-normalCoreTempC) {
+		tau = coreRecoveryHours
+	}
+	player.CoreTempC = core + (target-core)*(1-math.Exp(-hours/tau))
+
+	kind, stage := thermalAilment(player.CoreTempC)
+	if stage == 0 {
+		return
+	}
+	for _, existing := range player.Ailments {
+		if existing.Type == kind && existing.Stage >= stage {
+			return
+		}
+	}
+	injury := newInjury(kind, stage)
+	player.applyAilment(injury)
+	s.reports = append(s.reports, fmt.Sprintf("P%d %s's core is %.1fC: %s.", player.ID, player.Name, player.CoreTempC, injury.Label()))
+}
+
+// thermalAilment maps a core temperature onto hypothermia or heat exhaustion and a stage.
+func thermalAilment(core float64) (AilmentType, int) {
+	switch {
+	case core < 33.5:
+		return AilmentHypothermia, 3
+	case core < 34.5:
+		return AilmentHypothermia, 2
+	case core < 35.5:
+		return AilmentHypothermia, 1
+	case core > 40:
+		return AilmentHeatExhaustion, 3
+	case core > 39.2:
+		return AilmentHeatExhaustion, 2
+	case core > 38.5:
+		return AilmentHeatExhaustion, 1
+	}
+	return "", 0
+}
+
+// soakClothes wets the player through, as when wading a river.
+func soakClothes(player *PlayerState, amount float64) {
+	player.Wetness = clampFloat(player.Wetness+amount, 0, soakedWetness)
+}
+
+// ThermalLabel is a short readout of core temperature and wetness for status lines.
+func ThermalLabel(p PlayerState) string {
+	label := fmt.Sprintf("%.1fC", p.CoreTemperature())
+	switch {
+	case p.Wetness >= 70:
+		label += " soaked"
+	case p.Wetness >= 30:
+		label += " wet"
+	case p.Wetness >= 5:
+		label += " damp"
+	}
+	return label
+}
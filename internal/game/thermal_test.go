@@ -0,0 +1,105 @@
+package game
+
+import "testing"
+
+func TestWetColdNightsDropCoreTemperature(t *testing.T) {
+	run := newRunForCommands(t)
+	player := &run.Players[0]
+	player.Kit = nil
+	run.Weather = WeatherState{Type: WeatherRain, TemperatureC: 4}
+	run.Fire = FireState{}
+	run.Shelter = ShelterState{}
+	run.ClockHours = 22
+
+	run.applyThermalHours(player, 6)
+	if player.Wetness < 50 {
+		t.Fatalf("expected rain to soak an unsheltered player, got wetness %.0f", player.Wetness)
+	}
+	if player.CoreTemperature() >= 35.5 {
+		t.Fatalf("expected a wet cold night to chill the core, got %.1fC", player.CoreTemperature())
+	}
+	hypothermic := false
+	for _, a := range player.Ailments {
+		hypothermic = hypothermic || a.Type == AilmentHypothermia
+	}
+	if !hypothermic {
+		t.Fatalf("expected hypothermia, got %+v", player.Ailments)
+	}
+
+	run.Weather.Type = WeatherCloudy
+	run.Fire = FireState{Lit: true, Intensity: 60, HeatC: 80}
+	run.Shelter = ShelterState{Type: ShelterLeanTo, Durability: 80}
+	cold := player.CoreTemperature()
+	run.applyThermalHours(player, 3)
+	if player.CoreTemperature() <= cold || player.Wetness >= 50 {
+		t.Fatalf("expected the fire and shelter to warm and dry the player: %.1fC wetness %.0f", player.CoreTemperature(), player.Wetness)
+	}
+}
+
+func TestRainJacketKeepsClothesDry(t *testing.T) {
+	bare := newRunForCommands(t)
+	bare.Players[0].Kit = nil
+	jacket := newRunForCommands(t)
+	jacket.Players[0].Kit = []KitItem{KitRainJacket}
+	for _, run := range []*RunState{&bare, &jacket} {
+		run.Weather = WeatherState{Type: WeatherHeavyRain, TemperatureC: 12}
+		run.Shelter = ShelterState{}
+		run.updateWetness(&run.Players[0], 2)
+	}
+	if jacket.Players[0].Wetness >= bare.Players[0].Wetness {
+		t.Fatalf("expected the rain jacket to keep clothes drier: %.0f vs %.0f", jacket.Players[0].Wetness, bare.Players[0].Wetness)
+	}
+}
+
+func TestHeatwaveCausesHeatExhaustion(t *testing.T) {
+	run := newRunForCommands(t)
+	player := &run.Players[0]
+	player.Hydration = 20
+	run.Weather = WeatherState{Type: WeatherHeatwave, TemperatureC: 41}
+	run.Shelter = ShelterState{}
+	run.ClockHours = 11
+
+	run.applyThermalHours(player, 4)
+	if len(player.Ailments) == 0 || player.Ailments[0].Type != AilmentHeatExhaustion {
+		t.Fatalf("expected heat exhaustion at %.1fC, got %+v", player.CoreTemperature(), player.Ailments)
+	}
+}
+
+func TestWetnessRaisesMovementRisk(t *testing.T) {
+	dry := PlayerState{Energy: 80, Hydration: 80}
+	wet := dry
+	wet.Wetness = 100
+	weather := WeatherState{Type: WeatherRain, TemperatureC: 10}
+	dryScore, _ := CalculateMovementRisk(&dry, weather, 12)
+	wetScore, _ := CalculateMovementRisk(&wet, weather, 12)
+	if wetScore <= dryScore {
+		t.Fatalf("expected soaked clothes to raise travel risk: %d vs %d", wetScore, dryScore)
+	}
+}
+
+func TestSkippedDayRunsTheThermalModel(t *testing.T) {
+	run := newRunForCommands(t)
+	player := &run.Players[0]
+	player.Kit = nil
+	run.Fire = FireState{}
+	run.Shelter = ShelterState{}
+	run.ClockHours = 9
+	run.EnsureWeather()
+	for h := range run.Weather.Hourly {
+		run.Weather.Hourly[h].Type = WeatherBlizzard
+		run.Weather.Hourly[h].TemperatureC = -22
+	}
+
+	// `next` skips the rest of the day through AdvanceDay.
+	run.AdvanceDay()
+	hypothermic := false
+	for _, a := range player.Ailments {
+		hypothermic = hypothermic || a.Type == AilmentHypothermia
+	}
+	if !hypothermic {
+		t.Fatalf("expected a skipped blizzard day to chill the core into hypothermia, got %.1fC and %+v", player.CoreTemperature(), player.Ailments)
+	}
+	if run.ClockHours != 9 || run.Weather.Day != run.Day {
+		t.Fatalf("expected the clock and weather back on the new day, got %.1fh day %d", run.ClockHours, run.Weather.Day)
+	}
+}
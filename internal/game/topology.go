@@ -130,14 +130,20 @@ func (s *RunState) EnsureTopology() {
 		if len(s.CellStates) != len(s.Topology.Cells) {
 			s.CellStates = make([]CellState, len(s.Topology.Cells))
 		}
+		if s.PointsOfInterest == nil {
+			s.PointsOfInterest = s.generatePointsOfInterest()
+		}
 		if len(s.FogMask) != len(s.Topology.Cells) {
 			s.FogMask = make([]bool, len(s.Topology.Cells))
-			if s.Config.Mode != ModeAlone {
-				for i := range s.FogMask {
-					s.FogMask[i] = true
-				}
-			} else {
-				s.RevealFog(s.Travel.PosX, s.Travel.PosY, 1)
+		}
+		if len(s.FogSeenHour) != len(s.Topology.Cells) {
+			s.FogSeenHour = make([]uint16, len(s.Topology.Cells))
+		}
+		for i := range s.Players {
+			player := &s.Players[i]
+			if len(player.Fog) != fogBytes(len(s.Topology.Cells)) {
+				player.Fog = make([]byte, fogBytes(len(s.Topology.Cells)))
+				s.revealFogFor(player, player.Travel.PosX, player.Travel.PosY, s.sightRadiusAt(player, player.Travel.PosX, player.Travel.PosY))
 			}
 		}
 		return
@@ -157,16 +163,16 @@ func (s *RunState) initTopology() {
 	topology := GenerateWorldTopologyWithProfileAndClimate(s.Config.Seed, s.Scenario.Biome, w, h, profile, s.Scenario.Climate)
 	s.Topology = topology
 	s.CellStates = make([]CellState, len(topology.Cells))
+	s.PointsOfInterest = s.generatePointsOfInterest()
 	s.FogMask = make([]bool, len(topology.Cells))
-	if s.Config.Mode != ModeAlone {
-		for i := range s.FogMask {
-			s.FogMask[i] = true
-		}
-	}
+	s.FogSeenHour = make([]uint16, len(topology.Cells))
 	startX, startY := pickTopologyStartCell(topology)
-	s.Travel.PosX = startX
-	s.Travel.PosY = startY
-	s.RevealFog(startX, startY, 1)
+	for i := range s.Players {
+		player := &s.Players[i]
+		player.Travel.PosX, player.Travel.PosY = startX, startY
+		player.Fog = make([]byte, fogBytes(len(topology.Cells)))
+		s.revealFogFor(player, startX, startY, s.sightRadiusAt(player, startX, startY))
+	}
 }
 
 func pickTopologyStartCell(topology WorldTopology) (int, int) {
@@ -224,20 +230,78 @@ func (s *RunState) TopologyCellAt(x, y int) (TopoCell, bool) {
 	return s.Topology.Cells[idx], true
 }
 
+// leadPlayer is the first player still in the run, or P1 once everyone is out. Team-wide views follow them.
+func (s *RunState) leadPlayer() *PlayerState {
+	if s == nil || len(s.Players) == 0 {
+		return nil
+	}
+	for i := range s.Players {
+		if s.Players[i].Active() {
+			return &s.Players[i]
+		}
+	}
+	return &s.Players[0]
+}
+
+// CurrentMapPosition is the lead player's cell, where the map and run-wide reports centre.
 func (s *RunState) CurrentMapPosition() (int, int) {
+	lead := s.leadPlayer()
+	if lead == nil {
+		return 0, 0
+	}
+	return lead.Travel.PosX, lead.Travel.PosY
+}
+
+// PlayerMapPosition is where one player stands; unknown players read as the lead's cell.
+func (s *RunState) PlayerMapPosition(playerID int) (int, int) {
 	if s == nil {
 		return 0, 0
 	}
-	return s.Travel.PosX, s.Travel.PosY
+	if player, ok := s.playerByID(playerID); ok {
+		return player.Travel.PosX, player.Travel.PosY
+	}
+	return s.CurrentMapPosition()
+}
+
+// PlayerMarker is where one player stands, for drawing.
+type PlayerMarker struct {
+	PlayerID int
+	X        int
+	Y        int
+	Lead     bool
+}
+
+// PlayerMarkers lists every player still in the run and where they are; the lead comes first.
+func (s *RunState) PlayerMarkers() []PlayerMarker {
+	lead := s.leadPlayer()
+	if lead == nil {
+		return nil
+	}
+	markers := []PlayerMarker{{PlayerID: lead.ID, X: lead.Travel.PosX, Y: lead.Travel.PosY, Lead: true}}
+	for _, player := range s.Players {
+		if player.ID != lead.ID && player.Active() {
+			markers = append(markers, PlayerMarker{PlayerID: player.ID, X: player.Travel.PosX, Y: player.Travel.PosY})
+		}
+	}
+	return markers
+}
+
+// PlayersAt lists the active players standing on a cell, in ID order.
+func (s *RunState) PlayersAt(x, y int) []int {
+	ids := []int{}
+	for _, player := range s.Players {
+		if player.Active() && player.Travel.PosX == x && player.Travel.PosY == y {
+			ids = append(ids, player.ID)
+		}
+	}
+	return ids
 }
 
+// IsRevealed reports whether anyone on the team has ever seen the cell; see FogLevelAt for how recently.
 func (s *RunState) IsRevealed(x, y int) bool {
 	if s == nil {
 		return false
 	}
-	if s.Config.Mode != ModeAlone {
-		return true
-	}
 	idx, ok := s.topoIndex(x, y)
 	if !ok {
 		return false
@@ -248,16 +312,54 @@ func (s *RunState) IsRevealed(x, y int) bool {
 	return s.FogMask[idx]
 }
 
+// PlayerHasSeen reports whether this player has seen the cell themselves, not just heard of it from the team.
+func (s *RunState) PlayerHasSeen(playerID, x, y int) bool {
+	if s == nil {
+		return false
+	}
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return false
+	}
+	idx, ok := s.topoIndex(x, y)
+	if !ok || idx/8 >= len(player.Fog) {
+		return false
+	}
+	return player.Fog[idx/8]&(1<<(idx%8)) != 0
+}
+
+// RevealFog uncovers cells on the team map and marks them as seen this hour.
 func (s *RunState) RevealFog(x, y, radius int) {
 	if s == nil {
 		return
 	}
-	if s.Config.Mode != ModeAlone {
+	if len(s.FogMask) != len(s.Topology.Cells) {
 		return
 	}
-	if len(s.FogMask) != len(s.Topology.Cells) {
+	stamp := len(s.FogSeenHour) == len(s.FogMask)
+	hour := uint16(s.fogHour())
+	s.forFogCells(x, y, radius, func(idx int) {
+		s.FogMask[idx] = true
+		if stamp {
+			s.FogSeenHour[idx] = hour
+		}
+	})
+}
+
+// revealFogFor uncovers cells for one player and for the team map they report back to.
+func (s *RunState) revealFogFor(player *PlayerState, x, y, radius int) {
+	if s == nil || player == nil {
 		return
 	}
+	if len(player.Fog) == fogBytes(len(s.Topology.Cells)) {
+		s.forFogCells(x, y, radius, func(idx int) {
+			player.Fog[idx/8] |= 1 << (idx % 8)
+		})
+	}
+	s.RevealFog(x, y, radius)
+}
+
+func (s *RunState) forFogCells(x, y, radius int, reveal func(idx int)) {
 	if radius < 0 {
 		radius = 0
 	}
@@ -270,11 +372,16 @@ func (s *RunState) RevealFog(x, y, radius int) {
 			if !ok {
 				continue
 			}
-			s.FogMask[idx] = true
+			reveal(idx)
 		}
 	}
 }
 
+// fogBytes is the size of a per-player fog bitset for a map of n cells.
+func fogBytes(cells int) int {
+	return (cells + 7) / 8
+}
+
 func (s *RunState) decayCellStates() {
 	if s == nil || len(s.CellStates) == 0 {
 		return
@@ -144,8 +144,8 @@ func TestSwampBiomeProducesMoreInsectEncountersThanDesert(t *testing.T) {
 	}
 	run.CellStates = make([]CellState, 9)
 	run.FogMask = make([]bool, 9)
-	run.Travel.PosX = 1
-	run.Travel.PosY = 1
+	run.Players[0].Travel.PosX = 1
+	run.Players[0].Travel.PosY = 1
 	run.ClockHours = 19.5 // dusk
 	run.Day = 4
 
@@ -227,7 +227,7 @@ func TestColdWeatherSuppressesMosquitoEncounters(t *testing.T) {
 	}
 	run.CellStates = make([]CellState, 1)
 	run.FogMask = []bool{true}
-	run.Travel.PosX, run.Travel.PosY = 0, 0
+	run.Players[0].Travel.PosX, run.Players[0].Travel.PosY = 0, 0
 	run.Day = 2
 	run.ClockHours = 13
 	run.Weather = WeatherState{Day: 2, Type: WeatherSnow, TemperatureC: -18}
@@ -260,7 +260,7 @@ func TestAlaskaWinterFiltersWarmBirdsFromEncounters(t *testing.T) {
 	}
 	run.CellStates = make([]CellState, 1)
 	run.FogMask = []bool{true}
-	run.Travel.PosX, run.Travel.PosY = 0, 0
+	run.Players[0].Travel.PosX, run.Players[0].Travel.PosY = 0, 0
 	run.Day = 3
 	run.ClockHours = 9
 	run.Weather = WeatherState{Day: 3, Type: WeatherSnow, TemperatureC: -14}
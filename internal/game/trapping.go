@@ -62,7 +62,7 @@ type TrapCheckResult struct {
 	CampOverflow int
 }
 
-func TrapCatalog() []TrapSpec {
+func builtinTrapCatalog() []TrapSpec {
 	base := []TrapSpec{
 		{
 			ID: "gorge_hook_line", Name: "Gorge Hook Line",
@@ -262,6 +262,9 @@ func (s *RunState) SetTrap(playerID int, trapID string) (TrapSetResult, error) {
 	if !ok {
 		return TrapSetResult{}, fmt.Errorf("trap not available in biome: %s", trapID)
 	}
+	if err := requireTechnique(*player, trap.ID); err != nil {
+		return TrapSetResult{}, err
+	}
 	effective := player.Bushcraft + player.Crafting/20 + player.Hunting/30 + player.Fishing/35 + player.Agility + positiveTraitModifier(player.Traits)/2 + negativeTraitModifier(player.Traits)/2
 	if effective < trap.MinBushcraft {
 		return TrapSetResult{}, fmt.Errorf("requires bushcraft %+d", trap.MinBushcraft)
@@ -270,6 +273,9 @@ func (s *RunState) SetTrap(playerID int, trapID string) (TrapSetResult, error) {
 		if !hasCraftedItem(s.CraftedItems, itemID) {
 			return TrapSetResult{}, fmt.Errorf("requires crafted item: %s", itemID)
 		}
+		if !s.craftedUsable(itemID) {
+			return TrapSetResult{}, fmt.Errorf("crafted item is broken: %s", itemID)
+		}
 	}
 	for _, req := range trap.RequiresResources {
 		if s.resourceQty(req.ID) < req.Qty {
@@ -280,17 +286,28 @@ func (s *RunState) SetTrap(playerID int, trapID string) (TrapSetResult, error) {
 		if !playerHasKitItem(player, s.Config.IssuedKit, kit) {
 			return TrapSetResult{}, fmt.Errorf("requires kit item: %s", kit)
 		}
+		if !s.kitUsable(player, kit) {
+			return TrapSetResult{}, fmt.Errorf("kit item is broken: %s", kit)
+		}
 	}
 	for _, req := range trap.RequiresResources {
 		_ = s.consumeResourceStock(req.ID, req.Qty)
 	}
 
 	qualityScore := float64(effective) + float64(player.MentalStrength)/2.0 + float64(player.Crafting)/25.0
-	if playerHasKitItem(player, s.Config.IssuedKit, KitSnareWire) {
+	if s.kitUsable(player, KitSnareWire) {
 		qualityScore += 0.8
+		s.wearKit(player, KitSnareWire, 3)
 	}
-	if playerHasKitItem(player, s.Config.IssuedKit, KitParacord50ft) {
+	if s.kitUsable(player, KitParacord50ft) {
 		qualityScore += 0.7
+		s.wearKit(player, KitParacord50ft, 2)
+	}
+	for _, kit := range trap.RequiresKit {
+		s.wearKit(player, kit, 5)
+	}
+	for _, itemID := range trap.RequiresCrafted {
+		s.wearCrafted(itemID, 6)
 	}
 	rng := seededRNG(seedFromLabel(s.Config.Seed, fmt.Sprintf("trapset:%s:%d:%d", trap.ID, s.Day, playerID)))
 	qualityScore += rng.Float64()*1.4 - 0.7
@@ -312,13 +329,13 @@ func (s *RunState) SetTrap(playerID int, trapID string) (TrapSetResult, error) {
 		Armed:         true,
 	})
 
-	applySkillEffort(&player.Crafting, int(math.Round(trap.BaseHours*20)), true)
+	s.trainSkill(player, SkillCrafting, int(math.Round(trap.BaseHours*20)), true)
 	if slices.Contains(trap.Targets, "fish") {
-		applySkillEffort(&player.Fishing, int(math.Round(trap.BaseHours*14)), true)
+		s.trainSkill(player, SkillFishing, int(math.Round(trap.BaseHours*14)), true)
 	} else {
-		applySkillEffort(&player.Hunting, int(math.Round(trap.BaseHours*14)), true)
+		s.trainSkill(player, SkillHunting, int(math.Round(trap.BaseHours*14)), true)
 	}
-	applySkillEffort(&player.Trapping, int(math.Round(trap.BaseHours*18)), true)
+	s.trainSkill(player, SkillTrapping, int(math.Round(trap.BaseHours*18)), true)
 	player.Energy = clamp(player.Energy-int(math.Ceil(trap.BaseHours*3.2)), 0, 100)
 	player.Hydration = clamp(player.Hydration-int(math.Ceil(trap.BaseHours*1.6)), 0, 100)
 	player.Morale = clamp(player.Morale+1, 0, 100)
@@ -442,7 +459,9 @@ func (s *RunState) CheckTraps() TrapCheckResult {
 					if effort < 4 {
 						effort = 4
 					}
-					applySkillEffort(&player.Trapping, effort, true)
+					s.trainSkill(player, SkillTrapping, effort, true)
+					s.recordEvent(player, EventTrapCatch, 1)
+					s.recordEvent(player, EventTrapKg, item.Qty)
 				}
 			}
 			trap.PendingCatchKg = 0
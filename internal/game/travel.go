@@ -8,7 +8,8 @@ import (
 )
 
 // Discovery summary:
-// - TravelMove is the single step loop used by go/move command execution.
+// - TravelParty is the single step loop used by go/move command execution; TravelMove is a party of one.
+// - Each player carries their own TravelState, so a party is just the players who walk off a cell together.
 // - Water traversal already has watercraft speed modifiers; entry checks belong here.
 // - Shoreline stopping is enforced before stepping into water without watercraft.
 
@@ -24,6 +25,7 @@ type TravelState struct {
 
 type TravelResult struct {
 	PlayerID        int
+	PartyIDs        []int
 	Direction       string
 	RequestedKm     float64
 	RequestedSteps  int
@@ -226,21 +228,50 @@ func (s *RunState) canUseWatercraftInBiome() bool {
 		strings.Contains(n, "swamp")
 }
 
+// TravelMove walks one player on their own; see TravelParty.
 func (s *RunState) TravelMove(playerID int, direction string, requestedKm float64) (TravelResult, error) {
+	return s.TravelParty([]int{playerID}, direction, requestedKm)
+}
+
+// TravelParty walks a group of players who share a cell. The first ID leads: wildlife is rolled against them
+// and the result reports their costs. The party keeps to the slowest member's pace and stops when anyone is spent.
+func (s *RunState) TravelParty(playerIDs []int, direction string, requestedKm float64) (TravelResult, error) {
 	if s == nil {
 		return TravelResult{}, fmt.Errorf("run state is nil")
 	}
+	if len(playerIDs) == 0 {
+		return TravelResult{}, fmt.Errorf("no players to move")
+	}
 	s.EnsureTopology()
-	player, ok := s.playerByID(playerID)
-	if !ok {
-		return TravelResult{}, fmt.Errorf("player %d not found", playerID)
+	party := make([]*PlayerState, 0, len(playerIDs))
+	for _, id := range playerIDs {
+		player, ok := s.playerByID(id)
+		if !ok {
+			return TravelResult{}, fmt.Errorf("player %d not found", id)
+		}
+		if !player.Active() {
+			return TravelResult{}, fmt.Errorf("P%d is out of the run", id)
+		}
+		party = append(party, player)
+	}
+	player := party[0]
+	playerID := player.ID
+	for _, member := range party[1:] {
+		if member.Travel.PosX != player.Travel.PosX || member.Travel.PosY != player.Travel.PosY {
+			return TravelResult{}, fmt.Errorf("P%d is at (%d,%d), not with P%d at (%d,%d)", member.ID, member.Travel.PosX, member.Travel.PosY, playerID, player.Travel.PosX, player.Travel.PosY)
+		}
 	}
 	direction = normalizeDirection(direction)
 	if direction == "" {
 		return TravelResult{}, fmt.Errorf("direction must be north/south/east/west")
 	}
-	if player.Energy <= 1 || player.Hydration <= 1 {
-		return TravelResult{}, fmt.Errorf("too exhausted to travel")
+	for _, member := range party {
+		if member.Energy <= 1 || member.Hydration <= 1 {
+			if len(party) == 1 {
+				return TravelResult{}, fmt.Errorf("too exhausted to travel")
+			}
+			return TravelResult{}, fmt.Errorf("P%d is too exhausted to travel", member.ID)
+		}
 	}
 	if requestedKm <= 0 {
 		requestedKm = 2.0
@@ -258,7 +289,7 @@ func (s *RunState) TravelMove(playerID int, direction string, requestedKm float6
 		return TravelResult{}, fmt.Errorf("direction must be north/south/east/west")
 	}
 	steps := max(1, int(math.Round(requestedKm/travelTileKm)))
-	posX, posY := s.CurrentMapPosition()
+	posX, posY := player.Travel.PosX, player.Travel.PosY
 	movedSteps := 0
 	totalMinutes := 0
 	energyCost := 0
@@ -269,8 +300,9 @@ func (s *RunState) TravelMove(playerID int, direction string, requestedKm float6
 	blocksCrossed := 0
 	encounterLogs := make([]string, 0, 3)
 	stopReason := ""
+	confronted, confrontStep := WildlifeEncounter{}, 0
 	for step := 0; step < steps; step++ {
-		if player.Energy <= 1 || player.Hydration <= 1 {
+		if partyExhausted(party) {
 			stopReason = "Too exhausted"
 			break
 		}
@@ -294,20 +326,18 @@ func (s *RunState) TravelMove(playerID int, direction string, requestedKm float6
 			break
 		}
 
-		stepMinutes := TravelMinutesForStep(s, posX, posY, nextX, nextY, player)
-		if toCell.Flags&(TopoFlagWater|TopoFlagRiver|TopoFlagLake) != 0 {
-			if watercraftID != "" {
-				stepMinutes = max(1, int(math.Round(float64(stepMinutes)*0.55*watercraftBoost)))
-			} else {
-				stepMinutes = max(1, int(math.Round(float64(stepMinutes)*1.35)))
+		stepMinutes := 0
+		for _, member := range party {
+			stepMinutes = max(stepMinutes, s.travelStepMinutes(member, posX, posY, nextX, nextY, toCell, watercraftID, watercraftBoost))
+			if isWaterTravelCell(toCell) {
+				if watercraftID != "" {
+					soakClothes(member, crossingSplashWetness)
+				} else {
+					// Wading across soaks the clothes through.
+					soakClothes(member, soakedWetness)
+				}
 			}
 		}
-		if slicesContainsKit(player.Kit, KitCompass) || slicesContainsKit(s.Config.IssuedKit, KitCompass) {
-			stepMinutes = max(1, int(math.Round(float64(stepMinutes)*0.96)))
-		}
-		if slicesContainsKit(player.Kit, KitMap) || slicesContainsKit(s.Config.IssuedKit, KitMap) {
-			stepMinutes = max(1, int(math.Round(float64(stepMinutes)*0.97)))
-		}
 
 		prevBlock := s.CurrentTimeBlock()
 		s.AdvanceMinutes(stepMinutes)
@@ -325,16 +355,23 @@ func (s *RunState) TravelMove(playerID int, direction string, requestedKm float6
 			stepHydration = max(1, stepHydration-1)
 			moraleDelta++
 		}
-		player.Energy = clamp(player.Energy-stepEnergy, 0, 100)
-		player.Hydration = clamp(player.Hydration-stepHydration, 0, 100)
 		energyCost += stepEnergy
 		hydrationCost += stepHydration
 		totalMinutes += stepMinutes
 
 		posX, posY = nextX, nextY
 		movedSteps++
+		for _, member := range party {
+			member.Energy = clamp(member.Energy-stepEnergy, 0, 100)
+			member.Hydration = clamp(member.Hydration-stepHydration, 0, 100)
+			if watercraftID == "" || !isWaterTravelCell(toCell) {
+				s.travelFall(member, toCell)
+			}
+			member.Travel.PosX, member.Travel.PosY = posX, posY
+			s.revealFogFor(member, posX, posY, s.sightRadiusAt(member, posX, posY))
+		}
 		s.applyCellStateAction(posX, posY, "move")
-		s.RevealFog(posX, posY, 1)
+		s.discoverPointOfInterest(playerID, posX, posY)
 		if len(encounterLogs) < 2 {
 			event, ok := s.RollWildlifeEncounter(playerID, posX, posY, "move", step)
 			if ok {
@@ -342,17 +379,34 @@ func (s *RunState) TravelMove(playerID int, direction string, requestedKm float6
 				player.Energy = clamp(player.Energy+event.EnergyDelta, 0, 100)
 				player.Hydration = clamp(player.Hydration+event.HydrationDelta, 0, 100)
 				player.Morale = clamp(player.Morale+event.MoraleDelta, 0, 100)
+				if s.predatorConfronts(player, event, "move", step) {
+					confronted, confrontStep = event, step
+					stopReason = "Predator"
+					break
+				}
 			}
 		}
-		if player.Energy <= 1 || player.Hydration <= 1 {
+		if pulled := partyPulled(party); pulled != nil {
+			stopReason = "Pulled from the run"
+			if len(party) > 1 {
+				stopReason = fmt.Sprintf("P%d pulled from the run", pulled.ID)
+			}
+			break
+		}
+		if partyExhausted(party) {
 			stopReason = "Too exhausted"
 			break
 		}
 	}
+	partyIDs := make([]int, 0, len(party))
+	for _, member := range party {
+		partyIDs = append(partyIDs, member.ID)
+	}
 	if movedSteps == 0 {
 		if stopReason != "" {
 			return TravelResult{
 				PlayerID:        playerID,
+				PartyIDs:        partyIDs,
 				Direction:       direction,
 				RequestedKm:     requestedKm,
 				RequestedSteps:  steps,
@@ -382,22 +436,34 @@ func (s *RunState) TravelMove(playerID int, direction string, requestedKm float6
 	if watercraftID != "" && movedSteps > 0 {
 		moraleDelta += 1
 	}
-	player.Morale = clamp(player.Morale+moraleDelta, 0, 100)
-	applySkillEffort(&player.Gathering, int(math.Round(hours*12)), true)
-	applySkillEffort(&player.Navigation, int(math.Round(hours*14)), true)
-	refreshEffectBars(player)
-
-	s.Travel.PosX = posX
-	s.Travel.PosY = posY
-	s.Travel.Direction = direction
-	s.Travel.TotalKm += distance
-	s.Travel.LastStepKm = distance
-	s.Travel.LastStepHours = hours
-	s.Travel.LastDay = s.Day
+	for _, member := range party {
+		member.Morale = clamp(member.Morale+moraleDelta, 0, 100)
+		s.trainSkill(member, SkillGathering, int(math.Round(hours*12)), true)
+		s.trainSkill(member, SkillNavigation, int(math.Round(hours*14)), true)
+		refreshEffectBars(member)
+
+		// Walking off leaves the shelter behind.
+		member.MicroLocation = LocationOutside
+		member.Travel.PosX = posX
+		member.Travel.PosY = posY
+		member.Travel.Direction = direction
+		member.Travel.TotalKm += distance
+		s.recordEvent(member, EventKmTravelled, distance)
+		member.Travel.LastStepKm = distance
+		member.Travel.LastStepHours = hours
+		member.Travel.LastDay = s.Day
+	}
 	_ = s.AdvanceActionClock(hours)
+	// The confrontation opens where the walk stopped, after the walking time has passed.
+	if confronted.Predator {
+		if prompt := s.startPredatorEncounter(player, confronted, "move", confrontStep); prompt != "" {
+			encounterLogs = append(encounterLogs, prompt)
+		}
+	}
 
 	return TravelResult{
 		PlayerID:        playerID,
+		PartyIDs:        partyIDs,
 		Direction:       direction,
 		RequestedKm:     requestedKm,
 		RequestedSteps:  steps,
@@ -417,6 +483,43 @@ func (s *RunState) TravelMove(playerID int, direction string, requestedKm float6
 	}, nil
 }
 
+// travelStepMinutes is how long one member takes over a tile, before the party waits for its slowest.
+func (s *RunState) travelStepMinutes(player *PlayerState, fromX, fromY, toX, toY int, toCell TopoCell, watercraftID string, watercraftBoost float64) int {
+	stepMinutes := TravelMinutesForStep(s, fromX, fromY, toX, toY, player)
+	if toCell.Flags&(TopoFlagWater|TopoFlagRiver|TopoFlagLake) != 0 {
+		if watercraftID != "" {
+			stepMinutes = max(1, int(math.Round(float64(stepMinutes)*0.55*watercraftBoost)))
+		} else {
+			stepMinutes = max(1, int(math.Round(float64(stepMinutes)*1.35)))
+		}
+	}
+	if slicesContainsKit(player.Kit, KitCompass) || slicesContainsKit(s.Config.IssuedKit, KitCompass) {
+		stepMinutes = max(1, int(math.Round(float64(stepMinutes)*0.96)))
+	}
+	if slicesContainsKit(player.Kit, KitMap) || slicesContainsKit(s.Config.IssuedKit, KitMap) {
+		stepMinutes = max(1, int(math.Round(float64(stepMinutes)*0.97)))
+	}
+	return stepMinutes
+}
+
+func partyExhausted(party []*PlayerState) bool {
+	for _, member := range party {
+		if member.Energy <= 1 || member.Hydration <= 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func partyPulled(party []*PlayerState) *PlayerState {
+	for _, member := range party {
+		if !member.Active() {
+			return member
+		}
+	}
+	return nil
+}
+
 func isWaterTravelCell(cell TopoCell) bool {
 	return cell.Flags&(TopoFlagWater|TopoFlagRiver|TopoFlagLake) != 0
 }
@@ -72,6 +72,10 @@ func TravelMinutesForStep(state *RunState, fromX, fromY, toX, toY int, player *P
 
 	terrainMultiplier = clampFloat(terrainMultiplier, 0.55, 3.4)
 	paceMultiplier = clampFloat(paceMultiplier, 0.55, 1.75)
+	if player != nil {
+		// Injuries such as a sprained ankle slow every step on top of the usual pace.
+		paceMultiplier *= 1 + ailmentSlowdown(*player, activityTravel)
+	}
 
 	minutes := int(math.Round(baseTravelMinutesPerTile * terrainMultiplier * paceMultiplier))
 	if minutes < minTravelMinutesPerStep {
@@ -17,8 +17,8 @@ func newRunForTravelTime(t *testing.T) RunState {
 	if err != nil {
 		t.Fatalf("new run state: %v", err)
 	}
-	run.Travel.PosX = 10
-	run.Travel.PosY = 10
+	run.Players[0].Travel.PosX = 10
+	run.Players[0].Travel.PosY = 10
 	return run
 }
 
@@ -109,8 +109,8 @@ func TestTravelStopsAtShoreWithoutWatercraft(t *testing.T) {
 	}
 	run.CellStates = make([]CellState, 3)
 	run.FogMask = []bool{true, true, true}
-	run.Travel.PosX = 0
-	run.Travel.PosY = 0
+	run.Players[0].Travel.PosX = 0
+	run.Players[0].Travel.PosY = 0
 	startClock := run.ClockHours
 
 	res, err := run.TravelMove(1, "east", 0.5)
@@ -141,8 +141,8 @@ func TestTravelCanEnterWaterWithWatercraft(t *testing.T) {
 	}
 	run.CellStates = make([]CellState, 3)
 	run.FogMask = []bool{true, true, true}
-	run.Travel.PosX = 0
-	run.Travel.PosY = 0
+	run.Players[0].Travel.PosX = 0
+	run.Players[0].Travel.PosY = 0
 	run.CraftedItems = append(run.CraftedItems, "brush_raft")
 
 	res, err := run.TravelMove(1, "east", 0.1)
@@ -156,3 +156,46 @@ func TestTravelCanEnterWaterWithWatercraft(t *testing.T) {
 		t.Fatalf("expected watercraft to be used")
 	}
 }
+
+func TestPartiesSplitAndTravelOnTheirOwn(t *testing.T) {
+	run, err := NewRunState(RunConfig{
+		Mode:        ModeNakedAndAfraid,
+		ScenarioID:  ScenarioVancouverIslandID,
+		PlayerCount: 3,
+		RunLength:   RunLength{Days: 21},
+		Seed:        4242,
+	})
+	if err != nil {
+		t.Fatalf("new run state: %v", err)
+	}
+	homeX, homeY := run.CurrentMapPosition()
+
+	var res RunCommandResult
+	for _, dir := range []string{"n", "e", "s", "w"} {
+		if res = run.ExecuteRunCommand("go " + dir + " 300m p2 p3"); strings.Contains(res.Message, "P1 stayed") {
+			break
+		}
+	}
+	if !strings.Contains(res.Message, "P2 and P3 traveled") || !strings.Contains(res.Message, "P1 stayed") {
+		t.Fatalf("expected P2 and P3 to head off without P1, got %q", res.Message)
+	}
+	p1, p2, p3 := run.Players[0].Travel, run.Players[1].Travel, run.Players[2].Travel
+	if p1.PosX != homeX || p1.PosY != homeY || p1.TotalKm != 0 {
+		t.Fatalf("expected P1 to stay home, got %+v", p1)
+	}
+	if p2 != p3 || (p2.PosX == homeX && p2.PosY == homeY) {
+		t.Fatalf("expected P2 and P3 to move together, got %+v and %+v", p2, p3)
+	}
+	if !run.Camp.Placed || run.Camp.X != homeX || run.Camp.Y != homeY {
+		t.Fatalf("expected camp pinned where the party split, got %+v", run.Camp)
+	}
+	if !run.PlayerHasSeen(2, p2.PosX, p2.PosY) || run.PlayerHasSeen(1, p2.PosX, p2.PosY) {
+		t.Fatalf("expected only the party to have seen where it went")
+	}
+	if _, err := run.TravelParty([]int{1, 2}, "north", 0.1); err == nil || !strings.Contains(err.Error(), "not with P1") {
+		t.Fatalf("expected a party to need a shared cell, got %v", err)
+	}
+	if x, y := run.PlayerMapPosition(2); run.PlayerBiomeQuery(2) != run.BiomeQueryAt(x, y) {
+		t.Fatalf("expected P2's biome to come from P2's cell")
+	}
+}
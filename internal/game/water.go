@@ -0,0 +1,556 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Discovery summary:
+// - Hydration previously moved only through weather, food and kit actions; there was no water item or drink handler.
+// - Water is carried as personal inventory stacks (litres) keyed by treatment state and capped by container volume.
+// - Waterborne illness reuses DiseaseRisk/AilmentTemplate so drinking shares the meal ailment path.
+type WaterQuality string
+
+const (
+	WaterRaw      WaterQuality = "raw"
+	WaterBoiled   WaterQuality = "boiled"
+	WaterFiltered WaterQuality = "filtered"
+	WaterTreated  WaterQuality = "treated"
+)
+
+const (
+	waterSourceRiver    = "river"
+	waterSourceLake     = "lake"
+	waterSourceStanding = "standing"
+	waterSourceSpring   = "spring"
+)
+
+type waterContainerSpec struct {
+	Label   string
+	Kit     KitItem
+	Crafted string
+	Litres  float64
+	CanBoil bool
+}
+
+var waterContainerCatalog = []waterContainerSpec{
+	{Label: "canteen", Kit: KitCanteen, Litres: 1.0},
+	{Label: "cooking pot", Kit: KitCookingPot, Litres: 2.0, CanBoil: true},
+	{Label: "metal cup", Kit: KitMetalCup, Litres: 0.5, CanBoil: true},
+	{Label: "clay pot", Crafted: "clay_pot", Litres: 1.5, CanBoil: true},
+	{Label: "bark container", Crafted: "bark_container", Litres: 1.0},
+	{Label: "wooden cup", Crafted: "wooden_cup", Litres: 0.3},
+}
+
+// Waterborne risks apply per litre of raw water; treatment multiplies them down.
+var waterborneDiseaseRisks = []DiseaseRisk{
+	{ID: "giardiasis", Name: "Giardiasis", BaseChance: 0.07, VomitChance: 0.18, CarrierPart: "any", Effect: AilmentTemplate{Type: AilmentGIInfection, Name: "Giardiasis", Days: 4, EnergyPenalty: 3, HydrationPenalty: 6, MoralePenalty: 3}},
+	{ID: "cryptosporidiosis", Name: "Cryptosporidiosis", BaseChance: 0.03, VomitChance: 0.22, CarrierPart: "any", Effect: AilmentTemplate{Type: AilmentGIInfection, Name: "Cryptosporidiosis", Days: 5, EnergyPenalty: 3, HydrationPenalty: 7, MoralePenalty: 3}},
+	{ID: "water_bacteria", Name: "Bacterial Gastroenteritis", BaseChance: 0.06, VomitChance: 0.45, CarrierPart: "any", Effect: AilmentTemplate{Type: AilmentFoodPoison, Name: "Bacterial Gastroenteritis", Days: 2, EnergyPenalty: 2, HydrationPenalty: 5, MoralePenalty: 3}},
+	{ID: "water_parasites", Name: "Waterborne Parasites", BaseChance: 0.02, CarrierPart: "any", Effect: AilmentTemplate{Type: AilmentParasites, Name: "Parasites", Days: 6, EnergyPenalty: 2, HydrationPenalty: 2, MoralePenalty: 2}},
+}
+
+type WaterCollectResult struct {
+	PlayerID   int
+	Source     string
+	Litres     float64
+	Melted     bool
+	HoursSpent float64
+}
+
+type WaterTreatResult struct {
+	PlayerID   int
+	Method     WaterQuality
+	Litres     float64
+	HoursSpent float64
+}
+
+type DrinkResult struct {
+	PlayerID       int
+	Quality        WaterQuality
+	Source         string
+	Litres         float64
+	FromSource     bool
+	HydrationDelta int
+	MoraleDelta    int
+	DiseaseEvents  []DiseaseEvent
+}
+
+func waterItemID(quality WaterQuality) string {
+	return "water_" + string(quality)
+}
+
+func waterItemName(quality WaterQuality) string {
+	switch quality {
+	case WaterBoiled:
+		return "Boiled Water"
+	case WaterFiltered:
+		return "Filtered Water"
+	case WaterTreated:
+		return "Treated Water"
+	default:
+		return "Raw Water"
+	}
+}
+
+func parseWaterQuality(raw string) (WaterQuality, bool) {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "raw", "water_raw", "untreated":
+		return WaterRaw, true
+	case "boiled", "water_boiled":
+		return WaterBoiled, true
+	case "filtered", "water_filtered":
+		return WaterFiltered, true
+	case "treated", "water_treated", "purified":
+		return WaterTreated, true
+	default:
+		return "", false
+	}
+}
+
+// Safest water is drunk first when the player does not name a quality.
+func waterDrinkPreference() []WaterQuality {
+	return []WaterQuality{WaterBoiled, WaterTreated, WaterFiltered, WaterRaw}
+}
+
+func waterRiskMultiplier(quality WaterQuality) float64 {
+	switch quality {
+	case WaterBoiled:
+		return 0.02
+	case WaterTreated:
+		return 0.1
+	case WaterFiltered:
+		return 0.15
+	default:
+		return 1
+	}
+}
+
+func waterSourceRiskMultiplier(source string) float64 {
+	switch source {
+	case waterSourceRiver:
+		return 1
+	case waterSourceLake:
+		return 1.2
+	case waterSourceStanding:
+		return 1.6
+	case waterSourceSpring:
+		return 0.3
+	default:
+		return 1
+	}
+}
+
+func waterSourceForCell(cell TopoCell) (string, bool) {
+	switch {
+	case cell.Flags&TopoFlagRiver != 0:
+		return waterSourceRiver, true
+	case cell.Flags&TopoFlagLake != 0:
+		return waterSourceLake, true
+	case cell.Flags&TopoFlagWater != 0:
+		return waterSourceStanding, true
+	default:
+		return "", false
+	}
+}
+
+// nearbyWaterSource checks the player's cell first, then the eight neighbours. A spring underfoot beats both.
+func (s *RunState) nearbyWaterSource(player *PlayerState) (string, bool) {
+	if s == nil || player == nil {
+		return "", false
+	}
+	if s.cellHasPOI(player, POISpring) {
+		return waterSourceSpring, true
+	}
+	x, y := player.Travel.PosX, player.Travel.PosY
+	if cell, ok := s.TopologyCellAt(x, y); ok {
+		if source, ok := waterSourceForCell(cell); ok {
+			return source, true
+		}
+	}
+	for oy := -1; oy <= 1; oy++ {
+		for ox := -1; ox <= 1; ox++ {
+			if ox == 0 && oy == 0 {
+				continue
+			}
+			cell, ok := s.TopologyCellAt(x+ox, y+oy)
+			if !ok {
+				continue
+			}
+			if source, ok := waterSourceForCell(cell); ok {
+				return source, true
+			}
+		}
+	}
+	return "", false
+}
+
+func (s *RunState) waterContainersForPlayer(player *PlayerState) []waterContainerSpec {
+	if player == nil {
+		return nil
+	}
+	out := make([]waterContainerSpec, 0, len(waterContainerCatalog))
+	for _, spec := range waterContainerCatalog {
+		if spec.Kit != "" {
+			if hasAnyKitItem(*player, s.Config.IssuedKit, spec.Kit) {
+				out = append(out, spec)
+			}
+			continue
+		}
+		if hasCraftedItem(s.CraftedItems, spec.Crafted) {
+			out = append(out, spec)
+		}
+	}
+	return out
+}
+
+func (s *RunState) waterCapacityLitres(player *PlayerState) float64 {
+	total := 0.0
+	for _, spec := range s.waterContainersForPlayer(player) {
+		total += spec.Litres
+	}
+	return total
+}
+
+func (s *RunState) boilCapacityLitres(player *PlayerState) float64 {
+	best := 0.0
+	for _, spec := range s.waterContainersForPlayer(player) {
+		if spec.CanBoil && spec.Litres > best {
+			best = spec.Litres
+		}
+	}
+	return best
+}
+
+func carriedWaterLitres(player *PlayerState) float64 {
+	if player == nil {
+		return 0
+	}
+	total := 0.0
+	for _, quality := range waterDrinkPreference() {
+		total += inventoryTotalQtyByID(player.PersonalItems, waterItemID(quality))
+	}
+	return total
+}
+
+func (s *RunState) CollectWater(playerID int, litres float64) (WaterCollectResult, error) {
+	if s == nil {
+		return WaterCollectResult{}, fmt.Errorf("run state is nil")
+	}
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return WaterCollectResult{}, fmt.Errorf("player %d not found", playerID)
+	}
+	source, ok := s.nearbyWaterSource(player)
+	if !ok {
+		return WaterCollectResult{}, fmt.Errorf("no fresh water source within reach")
+	}
+	if source == waterSourceSpring {
+		s.playerAtPOI(player, POISpring)
+	}
+	capacity := s.waterCapacityLitres(player)
+	if capacity <= 0 {
+		return WaterCollectResult{}, fmt.Errorf("no container to hold water")
+	}
+	free := math.Floor((capacity-carriedWaterLitres(player))*10+1e-6) / 10
+	if free <= 0 {
+		return WaterCollectResult{}, fmt.Errorf("containers full (%.1f/%.1fL)", carriedWaterLitres(player), capacity)
+	}
+	if litres <= 0 || litres > free {
+		litres = free
+	}
+
+	melted := false
+	hours := 0.15 + litres*0.08
+	if s.IsWaterCurrentlyFrozen() {
+		if !s.Fire.Lit {
+			return WaterCollectResult{}, fmt.Errorf("water is frozen; light a fire to melt ice")
+		}
+		boilCap := s.boilCapacityLitres(player)
+		if boilCap <= 0 {
+			return WaterCollectResult{}, fmt.Errorf("water is frozen; need a pot or cup to melt ice")
+		}
+		melted = true
+		hours = 0.4 + litres*0.35
+		if hasCraftedItem(s.CraftedItems, "snow_melt_station") {
+			hours *= 0.6
+		}
+		s.Fire.FuelKg = maxFloat64(0, s.Fire.FuelKg-litres*0.12)
+		if s.Fire.FuelKg <= 0.05 {
+			s.ExtinguishFire()
+		}
+	}
+
+	item := InventoryItem{
+		ID:       waterItemID(WaterRaw),
+		Name:     waterItemName(WaterRaw),
+		Unit:     "L",
+		Qty:      litres,
+		WeightKg: 1,
+		Category: "water",
+		Quality:  source,
+	}
+	if err := s.AddPersonalInventoryItem(playerID, item); err != nil {
+		return WaterCollectResult{}, err
+	}
+
+	hours = clampFloat(hours, 0.1, 3)
+	_ = s.AdvanceActionClock(hours)
+	player.Energy = clamp(player.Energy-int(math.Ceil(hours)), 0, 100)
+	refreshEffectBars(player)
+
+	return WaterCollectResult{
+		PlayerID:   playerID,
+		Source:     source,
+		Litres:     litres,
+		Melted:     melted,
+		HoursSpent: hours,
+	}, nil
+}
+
+// TreatWater converts carried raw water by boiling, filtering or chemical treatment.
+func (s *RunState) TreatWater(playerID int, method WaterQuality, litres float64) (WaterTreatResult, error) {
+	if s == nil {
+		return WaterTreatResult{}, fmt.Errorf("run state is nil")
+	}
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return WaterTreatResult{}, fmt.Errorf("player %d not found", playerID)
+	}
+
+	limit := 0.0
+	hours := 0.0
+	switch method {
+	case WaterBoiled:
+		if !s.Fire.Lit {
+			return WaterTreatResult{}, fmt.Errorf("requires active fire")
+		}
+		limit = s.boilCapacityLitres(player)
+		if limit <= 0 {
+			return WaterTreatResult{}, fmt.Errorf("requires a pot or cup to boil in")
+		}
+	case WaterFiltered:
+		if !hasAnyKitItem(*player, s.Config.IssuedKit, KitWaterFilter) {
+			return WaterTreatResult{}, fmt.Errorf("requires %s", KitWaterFilter)
+		}
+	case WaterTreated:
+		if !hasAnyKitItem(*player, s.Config.IssuedKit, KitPurificationTablets) {
+			return WaterTreatResult{}, fmt.Errorf("requires %s", KitPurificationTablets)
+		}
+	default:
+		return WaterTreatResult{}, fmt.Errorf("unknown water treatment: %s", method)
+	}
+
+	rawID := waterItemID(WaterRaw)
+	available := inventoryTotalQtyByID(player.PersonalItems, rawID)
+	if available <= 0 {
+		return WaterTreatResult{}, fmt.Errorf("no raw water carried")
+	}
+	if litres <= 0 || litres > available {
+		litres = available
+	}
+	if limit > 0 && litres > limit {
+		litres = limit
+	}
+
+	consumed, err := s.removePersonalInventoryItem(playerID, rawID, litres)
+	if err != nil {
+		return WaterTreatResult{}, err
+	}
+	litres = consumed.Qty
+
+	switch method {
+	case WaterBoiled:
+		hours = 0.3 + litres*0.25
+		s.Fire.FuelKg = maxFloat64(0, s.Fire.FuelKg-litres*0.1)
+		if s.Fire.FuelKg <= 0.05 {
+			s.ExtinguishFire()
+		}
+	case WaterFiltered:
+		hours = 0.1 + litres*0.1
+	case WaterTreated:
+		// Tablets need a contact period before the water is safe.
+		hours = 0.5
+	}
+
+	item := InventoryItem{
+		ID:       waterItemID(method),
+		Name:     waterItemName(method),
+		Unit:     "L",
+		Qty:      litres,
+		WeightKg: 1,
+		Category: "water",
+	}
+	if err := s.AddPersonalInventoryItem(playerID, item); err != nil {
+		_ = s.AddPersonalInventoryItem(playerID, consumed)
+		return WaterTreatResult{}, err
+	}
+
+	_ = s.AdvanceActionClock(hours)
+	if method == WaterBoiled {
+		s.trainSkill(player, SkillFirecraft, int(math.Round(hours*8)), true)
+	}
+	refreshEffectBars(player)
+
+	return WaterTreatResult{
+		PlayerID:   playerID,
+		Method:     method,
+		Litres:     litres,
+		HoursSpent: hours,
+	}, nil
+}
+
+// Drink consumes carried water, or drinks straight from an adjacent source when nothing is carried.
+func (s *RunState) Drink(playerID int, quality WaterQuality, litres float64) (DrinkResult, error) {
+	if s == nil {
+		return DrinkResult{}, fmt.Errorf("run state is nil")
+	}
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return DrinkResult{}, fmt.Errorf("player %d not found", playerID)
+	}
+	if litres <= 0 {
+		litres = 0.5
+	}
+
+	result := DrinkResult{PlayerID: playerID}
+	candidates := waterDrinkPreference()
+	if quality != "" {
+		candidates = []WaterQuality{quality}
+	}
+	for _, candidate := range candidates {
+		id := waterItemID(candidate)
+		available := inventoryTotalQtyByID(player.PersonalItems, id)
+		if available <= 0 {
+			continue
+		}
+		take := minFloat64(litres, available)
+		consumed, err := s.removePersonalInventoryItem(playerID, id, take)
+		if err != nil {
+			return DrinkResult{}, err
+		}
+		result.Quality = candidate
+		result.Source = consumed.Quality
+		result.Litres = consumed.Qty
+		break
+	}
+
+	if result.Litres <= 0 {
+		if quality != "" && quality != WaterRaw {
+			return DrinkResult{}, fmt.Errorf("no %s water carried", quality)
+		}
+		source, ok := s.nearbyWaterSource(player)
+		if !ok {
+			return DrinkResult{}, fmt.Errorf("no water carried and no source within reach")
+		}
+		if s.IsWaterCurrentlyFrozen() {
+			return DrinkResult{}, fmt.Errorf("water source is frozen; collect and melt it over a fire")
+		}
+		result.Quality = WaterRaw
+		result.Source = source
+		result.Litres = litres
+		result.FromSource = true
+	}
+
+	before := player.Hydration
+	player.Hydration = clamp(player.Hydration+clamp(int(math.Round(result.Litres*32)), 1, 45), 0, 100)
+	result.HydrationDelta = player.Hydration - before
+	if player.Hydration >= 30 && result.Quality != WaterRaw {
+		result.MoraleDelta = 1
+		player.Morale = clamp(player.Morale+1, 0, 100)
+	}
+
+	s.ProcessAttemptCount++
+	rollLabel := fmt.Sprintf("drink:%s:%d:%d:%d", result.Quality, s.Day, playerID, s.ProcessAttemptCount)
+	result.DiseaseEvents = applyWaterborneRisks(s.Config.Seed, rollLabel, player, result.Quality, result.Source, result.Litres)
+
+	clampPlayer(player)
+	refreshEffectBars(player)
+	return result, nil
+}
+
+func waterborneChance(risk DiseaseRisk, quality WaterQuality, source string, litres float64) float64 {
+	if risk.BaseChance <= 0 {
+		return 0
+	}
+	volume := clampFloat(litres, 0.1, 2)
+	chance := risk.BaseChance * waterRiskMultiplier(quality) * waterSourceRiskMultiplier(source) * (0.5 + volume*0.5)
+	return clampFloat(chance, 0, 0.95)
+}
+
+func applyWaterborneRisks(seed int64, label string, player *PlayerState, quality WaterQuality, source string, litres float64) []DiseaseEvent {
+	events := make([]DiseaseEvent, 0, 1)
+	for _, risk := range waterborneDiseaseRisks {
+		chance := waterborneChance(risk, quality, source, litres)
+		if chance <= 0 {
+			continue
+		}
+		rng := seededRNG(seedFromLabel(seed, label+":"+string(risk.ID)))
+		if rng.Float64() > chance {
+			continue
+		}
+		ailment := Ailment{
+			Type:             risk.Effect.Type,
+			Name:             risk.Effect.Name,
+			DaysRemaining:    risk.Effect.Days,
+			EnergyPenalty:    risk.Effect.EnergyPenalty,
+			HydrationPenalty: risk.Effect.HydrationPenalty,
+			MoralePenalty:    risk.Effect.MoralePenalty,
+		}
+		player.applyAilment(ailment)
+		player.Energy = clamp(player.Energy-ailment.EnergyPenalty, 0, 100)
+		player.Hydration = clamp(player.Hydration-ailment.HydrationPenalty, 0, 100)
+		player.Morale = clamp(player.Morale-ailment.MoralePenalty, 0, 100)
+		events = append(events, DiseaseEvent{DiseaseID: risk.ID, Name: risk.Name, Ailment: ailment})
+
+		if risk.VomitChance > 0 && rng.Float64() <= risk.VomitChance {
+			vomit := Ailment{Type: AilmentVomiting, Name: "Vomiting", DaysRemaining: 1, EnergyPenalty: 2, HydrationPenalty: 4, MoralePenalty: 3}
+			player.applyAilment(vomit)
+			player.Energy = clamp(player.Energy-vomit.EnergyPenalty, 0, 100)
+			player.Hydration = clamp(player.Hydration-vomit.HydrationPenalty, 0, 100)
+			player.Morale = clamp(player.Morale-vomit.MoralePenalty, 0, 100)
+			events = append(events, DiseaseEvent{DiseaseID: DiseaseID(string(risk.ID) + "_vomit"), Name: risk.Name + " (vomiting symptom)", Ailment: vomit})
+		}
+	}
+	return events
+}
+
+func (s *RunState) WaterSummary(playerID int) string {
+	if s == nil {
+		return "water unavailable"
+	}
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return fmt.Sprintf("player %d not found", playerID)
+	}
+	parts := make([]string, 0, 4)
+	for _, quality := range waterDrinkPreference() {
+		qty := inventoryTotalQtyByID(player.PersonalItems, waterItemID(quality))
+		if qty <= 0 {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s %.1fL", quality, qty))
+	}
+	carried := "none"
+	if len(parts) > 0 {
+		carried = strings.Join(parts, ", ")
+	}
+	containers := make([]string, 0, len(waterContainerCatalog))
+	for _, spec := range s.waterContainersForPlayer(player) {
+		containers = append(containers, spec.Label)
+	}
+	held := "no containers"
+	if len(containers) > 0 {
+		held = strings.Join(containers, ", ")
+	}
+	source := "none nearby"
+	if found, ok := s.nearbyWaterSource(player); ok {
+		source = found
+		if s.IsWaterCurrentlyFrozen() {
+			source += " (frozen)"
+		}
+	}
+	return fmt.Sprintf("P%d water %.1f/%.1fL (%s) | %s | source: %s | thirst %d",
+		playerID, carriedWaterLitres(player), s.waterCapacityLitres(player), held, carried, source, player.Thirst)
+}
@@ -0,0 +1,165 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+func newRunAtRiver(t *testing.T) RunState {
+	t.Helper()
+
+	run := newRunForCommands(t)
+	run.Players[0].Kit = []KitItem{KitCanteen, KitCookingPot, KitWaterFilter}
+	run.Weather.TemperatureC = 14
+	x, y := run.CurrentMapPosition()
+	idx, ok := run.topoIndex(x, y)
+	if !ok {
+		t.Fatalf("expected start cell in topology")
+	}
+	run.Topology.Cells[idx].Flags |= TopoFlagWater | TopoFlagRiver
+	return run
+}
+
+func TestCollectWaterFillsContainersWithRawWater(t *testing.T) {
+	run := newRunAtRiver(t)
+
+	res := run.ExecuteRunCommand("water collect")
+	if !res.Handled {
+		t.Fatalf("expected water command to be handled")
+	}
+	if !strings.Contains(res.Message, "river") {
+		t.Fatalf("expected river source in message, got: %s", res.Message)
+	}
+	got := inventoryTotalQtyByID(run.Players[0].PersonalItems, waterItemID(WaterRaw))
+	if got < 2.9 || got > 3.0+1e-9 {
+		t.Fatalf("expected canteen+pot to hold 3.0L raw water, got %.2f", got)
+	}
+	if _, err := run.CollectWater(1, 0); err == nil {
+		t.Fatalf("expected full containers to block more collection")
+	}
+}
+
+func TestCollectWaterKeepsTheAmountAndPlayer(t *testing.T) {
+	run := newRunAtRiver(t)
+	if res := run.ExecuteRunCommand("collect 2 water"); !strings.Contains(res.Message, "collected 2.0L") {
+		t.Fatalf("expected the amount before water to be kept, got %q", res.Message)
+	}
+	if res := run.ExecuteRunCommand("collect p2 water"); !strings.Contains(res.Message, "player 2") {
+		t.Fatalf("expected the water collect to be for P2, got %q", res.Message)
+	}
+}
+
+func TestCollectWaterFrozenRequiresFire(t *testing.T) {
+	run := newRunAtRiver(t)
+	run.Weather.TemperatureC = -8
+
+	if _, err := run.CollectWater(1, 1); err == nil || !strings.Contains(err.Error(), "frozen") {
+		t.Fatalf("expected frozen water to block collection without fire, got %v", err)
+	}
+
+	run.Fire.Lit = true
+	run.Fire.FuelKg = 3
+	result, err := run.CollectWater(1, 1)
+	if err != nil {
+		t.Fatalf("expected melt over fire to succeed: %v", err)
+	}
+	if !result.Melted {
+		t.Fatalf("expected melted collection result")
+	}
+}
+
+func TestTreatWaterFilterAndBoil(t *testing.T) {
+	run := newRunAtRiver(t)
+	if _, err := run.CollectWater(1, 3); err != nil {
+		t.Fatalf("collect water: %v", err)
+	}
+
+	if _, err := run.TreatWater(1, WaterBoiled, 1); err == nil {
+		t.Fatalf("expected boiling without fire to fail")
+	}
+	if _, err := run.TreatWater(1, WaterFiltered, 1); err != nil {
+		t.Fatalf("filter water: %v", err)
+	}
+	run.Fire.Lit = true
+	run.Fire.FuelKg = 3
+	boiled, err := run.TreatWater(1, WaterBoiled, 0)
+	if err != nil {
+		t.Fatalf("boil water: %v", err)
+	}
+	if boiled.Litres > 2.0+1e-9 {
+		t.Fatalf("expected boil batch capped by pot volume, got %.2f", boiled.Litres)
+	}
+	if inventoryTotalQtyByID(run.Players[0].PersonalItems, waterItemID(WaterFiltered)) <= 0 {
+		t.Fatalf("expected filtered water in personal inventory")
+	}
+	if inventoryTotalQtyByID(run.Players[0].PersonalItems, waterItemID(WaterBoiled)) <= 0 {
+		t.Fatalf("expected boiled water in personal inventory")
+	}
+}
+
+func TestDrinkReducesThirstPreferringSafeWater(t *testing.T) {
+	run := newRunAtRiver(t)
+	run.Players[0].PersonalItems = []InventoryItem{
+		{ID: waterItemID(WaterRaw), Unit: "L", Qty: 1, WeightKg: 1, Quality: waterSourceRiver},
+		{ID: waterItemID(WaterBoiled), Unit: "L", Qty: 1, WeightKg: 1},
+	}
+	run.Players[0].Hydration = 40
+	refreshEffectBars(&run.Players[0])
+	beforeThirst := run.Players[0].Thirst
+
+	res := run.ExecuteRunCommand("drink 500")
+	if !res.Handled {
+		t.Fatalf("expected drink to be handled")
+	}
+	if !strings.Contains(res.Message, "boiled") {
+		t.Fatalf("expected boiled water to be preferred, got: %s", res.Message)
+	}
+	if run.Players[0].Thirst >= beforeThirst {
+		t.Fatalf("expected thirst to drop, before=%d after=%d", beforeThirst, run.Players[0].Thirst)
+	}
+}
+
+func TestDrinkReadsMillilitresAndLitres(t *testing.T) {
+	for _, tc := range []struct {
+		command string
+		litres  string
+	}{
+		{"drink 300ml", "0.30L"},
+		{"drink 0.5 l", "0.50L"},
+		{"drink 2 l", "2.00L"},
+		{"drink 6l", "6.00L"},
+		{"drink 400", "0.40L"},
+		{"drink boiled ml p1 200", "0.20L"},
+		{"sip", "0.20L"}, // the 0.15L sip, to the water's 0.1L measure
+		{"drink", "0.50L"},
+	} {
+		run := newRunAtRiver(t)
+		run.Players[0].PersonalItems = []InventoryItem{{ID: waterItemID(WaterBoiled), Unit: "L", Qty: 8, WeightKg: 8}}
+		if res := run.ExecuteRunCommand(tc.command); !strings.Contains(res.Message, "drank "+tc.litres) {
+			t.Fatalf("expected %q to drink %s, got %q", tc.command, tc.litres, res.Message)
+		}
+	}
+}
+
+func TestDrinkFromSourceWithoutContainers(t *testing.T) {
+	run := newRunAtRiver(t)
+	run.Players[0].Kit = nil
+
+	result, err := run.Drink(1, "", 0.3)
+	if err != nil {
+		t.Fatalf("expected drinking from river to succeed: %v", err)
+	}
+	if !result.FromSource || result.Quality != WaterRaw {
+		t.Fatalf("expected raw water straight from source, got %+v", result)
+	}
+}
+
+func TestWaterborneChanceDropsWithTreatment(t *testing.T) {
+	risk := waterborneDiseaseRisks[0]
+	raw := waterborneChance(risk, WaterRaw, waterSourceStanding, 1)
+	filtered := waterborneChance(risk, WaterFiltered, waterSourceStanding, 1)
+	boiled := waterborneChance(risk, WaterBoiled, waterSourceStanding, 1)
+	if !(raw > filtered && filtered > boiled && boiled > 0) {
+		t.Fatalf("expected raw > filtered > boiled > 0, got %.4f %.4f %.4f", raw, filtered, boiled)
+	}
+}
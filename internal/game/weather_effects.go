@@ -15,8 +15,12 @@ func (d statDelta) add(other statDelta) statDelta {
 }
 
 func weatherImpactForDay(biome string, season SeasonID, weather WeatherType, streakDays int, tempC int) statDelta {
+	return weatherConditionsImpact(biome, season, weather, streakDays).add(temperatureStressImpact(tempC))
+}
+
+// weatherConditionsImpact is the daily weather cost without temperature stress, for players whose core temperature is simulated hourly.
+func weatherConditionsImpact(biome string, season SeasonID, weather WeatherType, streakDays int) statDelta {
 	impact := baseWeatherImpact(biome, season, weather)
-	impact = impact.add(temperatureStressImpact(tempC))
 	impact = impact.add(streakWeatherImpact(biome, weather, streakDays))
 	impact = impact.add(specialBiomeWeatherImpact(biome, season, weather))
 	return impact
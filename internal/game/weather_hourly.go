@@ -0,0 +1,312 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strings"
+)
+
+// Discovery summary:
+// - weatherStateForDay still resolves one weather type and temperature per day from Config.Seed and the ClimateProfile.
+// - Each day now also carries a 24-hour timeline built from that day weather: a diurnal curve, showers and fronts, wind and rain.
+// - RunState.Weather follows the clock hour by hour, but AdvanceDay still sees the whole day's weather for its daily effects.
+// - Forecasts read tomorrow's real timeline and blur it by the player's Navigation and Bushcraft.
+const (
+	coldestHour      = 5
+	warmestHour      = 15
+	frontEarliest    = 13
+	frontSpreadHours = 4
+)
+
+// WeatherHour is the weather for one hour of the day.
+type WeatherHour struct {
+	Hour         int         `json:"hour"`
+	Type         WeatherType `json:"type"`
+	TemperatureC int         `json:"temperature_c"`
+	WindKph      int         `json:"wind_kph"`
+	PrecipMM     float64     `json:"precip_mm,omitempty"`
+}
+
+// DayWeather is the day's overall weather type, whatever the current hour is doing.
+func (w WeatherState) DayWeather() WeatherType {
+	if w.DayType != "" {
+		return w.DayType
+	}
+	return w.Type
+}
+
+// diurnalRangeC is how far the temperature swings either side of the day's mean.
+func diurnalRangeC(biome string, weather WeatherType) float64 {
+	swing := 4.0
+	if biomeIsDesertOrDry(biome) {
+		swing = 8
+	} else if biomeIsTropicalWet(biome) {
+		swing = 3
+	}
+	switch weather {
+	case WeatherSunny, WeatherClear, WeatherHeatwave:
+		swing *= 1.25
+	case WeatherRain, WeatherHeavyRain, WeatherStorm, WeatherSnow, WeatherBlizzard, WeatherCloudy:
+		swing *= 0.55
+	}
+	return swing
+}
+
+func baseWindKph(weather WeatherType) int {
+	switch weather {
+	case WeatherSunny, WeatherHeatwave:
+		return 8
+	case WeatherClear:
+		return 5
+	case WeatherCloudy, WeatherSnow:
+		return 12
+	case WeatherRain:
+		return 15
+	case WeatherHeavyRain:
+		return 25
+	case WeatherWindy:
+		return 35
+	case WeatherStorm:
+		return 55
+	case WeatherBlizzard:
+		return 60
+	default:
+		return 10
+	}
+}
+
+// basePrecipMM is rain (or snow as water) per hour.
+func basePrecipMM(weather WeatherType) float64 {
+	switch weather {
+	case WeatherRain:
+		return 1.5
+	case WeatherHeavyRain:
+		return 6
+	case WeatherStorm:
+		return 10
+	case WeatherSnow:
+		return 1
+	case WeatherBlizzard:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// hourlyPattern lays the day's weather over 24 hours: fronts arrive in the afternoon, rain and snow come in spells,
+// and a sunny day is clear at night.
+func hourlyPattern(day WeatherType, rng *rand.Rand) [24]WeatherType {
+	var hours [24]WeatherType
+	for h := range hours {
+		hours[h] = day
+	}
+	switch day {
+	case WeatherStorm, WeatherBlizzard:
+		front := frontEarliest + rng.IntN(frontSpreadHours+1)
+		lasts := 4 + rng.IntN(6)
+		for h := range hours {
+			switch {
+			case h < front-2:
+				hours[h] = WeatherCloudy
+			case h < front:
+				hours[h] = WeatherWindy
+			case h >= front+lasts:
+				hours[h] = WeatherCloudy
+			}
+		}
+	case WeatherRain, WeatherHeavyRain, WeatherSnow:
+		start := rng.IntN(20)
+		spell := 4 + rng.IntN(8)
+		for h := range hours {
+			if h < start || h >= start+spell {
+				hours[h] = WeatherCloudy
+			}
+		}
+	case WeatherSunny:
+		for h := range hours {
+			if h < 6 || h >= 20 {
+				hours[h] = WeatherClear
+			}
+		}
+	}
+	return hours
+}
+
+// diurnalCurve runs from -1 just before dawn to +1 mid-afternoon, warming faster than it cools.
+func diurnalCurve(hour int) float64 {
+	if hour >= coldestHour && hour <= warmestHour {
+		return -math.Cos(math.Pi * float64(hour-coldestHour) / float64(warmestHour-coldestHour))
+	}
+	since := (hour - warmestHour + 24) % 24
+	return math.Cos(math.Pi * float64(since) / float64(24-(warmestHour-coldestHour)))
+}
+
+// hourlyTimeline builds a day's hours around its resolved weather and mean temperature.
+func (s *RunState) hourlyTimeline(day int, season SeasonID, dayType WeatherType, meanC int) []WeatherHour {
+	rng := seededRNG(seedFromLabel(s.Config.Seed, fmt.Sprintf("weather-hours:%s:%d", normalizeBiome(s.Scenario.Biome), day)))
+	pattern := hourlyPattern(dayType, rng)
+	swing := diurnalRangeC(s.Scenario.Biome, dayType)
+	climate := s.ActiveClimateProfile()
+	timeline := make([]WeatherHour, 24)
+	for h := range timeline {
+		kind := constrainWeatherForClimate(s.Config.Seed, day, season, pattern[h], climate)
+		temp := float64(meanC) + swing*diurnalCurve(h)
+		if kind != dayType && (dayType == WeatherStorm || dayType == WeatherBlizzard) {
+			// Ahead of and behind a front the air is milder than in it.
+			temp += float64(weatherTemperatureDelta(kind)-weatherTemperatureDelta(dayType)) / 2
+		}
+		wind := baseWindKph(kind) + rng.IntN(9) - 4
+		if h >= 12 && h <= 18 {
+			wind += wind / 5
+		}
+		precip := basePrecipMM(kind)
+		if precip > 0 {
+			precip = math.Round(precip*(0.6+rng.Float64()*0.8)*10) / 10
+		}
+		timeline[h] = WeatherHour{Hour: h, Type: kind, TemperatureC: int(math.Round(temp)), WindKph: max(0, wind), PrecipMM: precip}
+	}
+	return timeline
+}
+
+// syncWeatherHour moves the current conditions to the clock hour. States without a timeline are left alone.
+func (s *RunState) syncWeatherHour() {
+	if s == nil || len(s.Weather.Hourly) != 24 || s.Weather.Day != s.Day {
+		return
+	}
+	h := clamp(int(s.ClockHours), 0, 23)
+	hour := s.Weather.Hourly[h]
+	s.Weather.Hour = h
+	s.Weather.Type = hour.Type
+	s.Weather.TemperatureC = hour.TemperatureC
+	s.Weather.WindKph = hour.WindKph
+	s.Weather.PrecipMM = hour.PrecipMM
+}
+
+// nextWeatherChange finds the next hour today where the weather type changes.
+func (w WeatherState) nextWeatherChange(from int) (WeatherHour, bool) {
+	if len(w.Hourly) != 24 || from < 0 || from > 23 {
+		return WeatherHour{}, false
+	}
+	current := w.Hourly[from].Type
+	for h := from + 1; h < 24; h++ {
+		if w.Hourly[h].Type != current {
+			return w.Hourly[h], true
+		}
+	}
+	return WeatherHour{}, false
+}
+
+// WeatherReport describes the current hour and what is coming for the rest of the day.
+func (s *RunState) WeatherReport() string {
+	w := s.Weather
+	line := fmt.Sprintf("Day %d %s: %s %dC", s.Day, formatClockHours(s.ClockHours), WeatherLabel(w.Type), w.TemperatureC)
+	if w.WindKph > 0 {
+		line += fmt.Sprintf(", wind %d km/h", w.WindKph)
+	}
+	if w.PrecipMM > 0 {
+		line += fmt.Sprintf(", %.1fmm/h", w.PrecipMM)
+	}
+	line += "."
+	if len(w.Hourly) == 24 {
+		line += fmt.Sprintf(" Today %s, %d to %dC.", strings.ToLower(WeatherLabel(w.DayWeather())), w.LowC, w.HighC)
+		if next, ok := w.nextWeatherChange(w.Hour); ok {
+			line += fmt.Sprintf(" %s from around %s.", weatherChangeLabel(next.Type, w.DayWeather()), formatClockHours(float64(next.Hour)))
+		}
+	}
+	return line
+}
+
+func weatherChangeLabel(next, day WeatherType) string {
+	if next == day && (day == WeatherStorm || day == WeatherBlizzard) {
+		return WeatherLabel(day) + " front"
+	}
+	return WeatherLabel(next)
+}
+
+// forecastSkill is how reliably a player reads the sky, 0.3 to 0.95.
+func forecastSkill(p PlayerState) float64 {
+	return clampFloat(0.45+float64(clamp(p.Navigation, 0, 100))/200+float64(clamp(p.Bushcraft, -3, 3))*0.05, 0.3, 0.95)
+}
+
+// forecastMistake is a plausible wrong call for a misread sky.
+func forecastMistake(weather WeatherType, roll int) WeatherType {
+	options := map[WeatherType][]WeatherType{
+		WeatherSunny:     {WeatherClear, WeatherCloudy},
+		WeatherClear:     {WeatherSunny, WeatherCloudy},
+		WeatherCloudy:    {WeatherRain, WeatherClear},
+		WeatherRain:      {WeatherCloudy, WeatherHeavyRain},
+		WeatherHeavyRain: {WeatherRain, WeatherStorm},
+		WeatherStorm:     {WeatherHeavyRain, WeatherWindy},
+		WeatherSnow:      {WeatherCloudy, WeatherBlizzard},
+		WeatherBlizzard:  {WeatherSnow, WeatherWindy},
+		WeatherWindy:     {WeatherCloudy, WeatherStorm},
+		WeatherHeatwave:  {WeatherSunny, WeatherClear},
+	}[weather]
+	if len(options) == 0 {
+		return weather
+	}
+	return options[roll%len(options)]
+}
+
+// forecastCall is a player's read of a day's weather: right more often, and with a tighter temperature range,
+// the better their Navigation and Bushcraft.
+func (s *RunState) forecastCall(player *PlayerState, day WeatherState) (WeatherType, int, int) {
+	skill := forecastSkill(*player)
+	rng := seededRNG(seedFromLabel(s.Config.Seed, fmt.Sprintf("forecast:%d:%d", day.Day, player.ID)))
+	called := day.DayWeather()
+	if rng.Float64() > skill {
+		called = forecastMistake(called, rng.IntN(2))
+	}
+	spread := int(math.Round((1 - skill) * 8))
+	shift := 0
+	if spread > 0 {
+		shift = rng.IntN(spread*2+1) - spread
+	}
+	return called, day.LowC + shift - spread/2, day.HighC + shift + spread/2
+}
+
+// Forecast reports the player's prediction for tomorrow.
+func (s *RunState) Forecast(playerID int) (string, error) {
+	player, ok := s.playerByID(playerID)
+	if !ok {
+		return "", fmt.Errorf("player %d not found", playerID)
+	}
+	tomorrow := s.weatherStateForDay(s.Day + 1)
+	called, low, high := s.forecastCall(player, tomorrow)
+	skill := forecastSkill(*player)
+	confidence := "a hunch"
+	switch {
+	case skill >= 0.8:
+		confidence = "confident"
+	case skill >= 0.6:
+		confidence = "a fair guess"
+	}
+	msg := fmt.Sprintf("P%d reads the sky for day %d: %s, %d to %dC (%s).", playerID, tomorrow.Day, strings.ToLower(WeatherLabel(called)), low, high, confidence)
+	if skill >= 0.6 && called == tomorrow.DayWeather() && (called == WeatherStorm || called == WeatherBlizzard) {
+		for _, hour := range tomorrow.Hourly {
+			if hour.Type == called {
+				msg += fmt.Sprintf(" The front should arrive around %s.", formatClockHours(float64(hour.Hour)))
+				break
+			}
+		}
+	}
+	// Reading the sky is practice once a day; asking again only repeats the same call.
+	if player.ForecastDay != s.Day {
+		player.ForecastDay = s.Day
+		s.trainSkill(player, SkillNavigation, 3, true)
+	}
+	return msg, nil
+}
+
+func (s *RunState) executeWeatherCommand(verb string, args []string) RunCommandResult {
+	playerID, _ := extractPlayerID(args)
+	if verb == "forecast" {
+		msg, err := s.Forecast(playerID)
+		if err != nil {
+			return RunCommandResult{Handled: true, Message: err.Error()}
+		}
+		return RunCommandResult{Handled: true, Message: msg}
+	}
+	return RunCommandResult{Handled: true, Message: s.WeatherReport()}
+}
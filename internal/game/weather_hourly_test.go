@@ -0,0 +1,99 @@
+package game
+
+import "testing"
+
+func TestHourlyWeatherFollowsTheDayAndTheClock(t *testing.T) {
+	run := newRunForCommands(t)
+	state := run.weatherStateForDay(3)
+	if len(state.Hourly) != 24 || state.LowC > state.MeanC || state.HighC < state.MeanC {
+		t.Fatalf("expected a 24-hour timeline around the mean: %+v", state)
+	}
+	if again := run.weatherStateForDay(3); again.Hourly[14] != state.Hourly[14] {
+		t.Fatalf("expected the timeline to be deterministic")
+	}
+	if state.Hourly[coldestHour].TemperatureC > state.Hourly[warmestHour].TemperatureC {
+		t.Fatalf("expected the afternoon to be warmer than dawn: %+v", state.Hourly)
+	}
+
+	run.ClockHours = 7
+	run.AdvanceMinutes(200)
+	if run.Weather.Hour != 10 || run.Weather.Type != run.Weather.Hourly[10].Type || run.Weather.TemperatureC != run.Weather.Hourly[10].TemperatureC {
+		t.Fatalf("expected current conditions to follow the clock to 10:00, got %+v", run.Weather)
+	}
+}
+
+func TestStormFrontsArriveInTheAfternoon(t *testing.T) {
+	for day := 1; day <= 20; day++ {
+		pattern := hourlyPattern(WeatherStorm, seededRNG(int64(day)))
+		first := -1
+		for h, kind := range pattern {
+			if kind == WeatherStorm {
+				first = h
+				break
+			}
+		}
+		if first < frontEarliest || first > frontEarliest+frontSpreadHours {
+			t.Fatalf("expected the front between 13:00 and 17:00, got hour %d: %v", first, pattern)
+		}
+		if pattern[0] == WeatherStorm {
+			t.Fatalf("expected a calmer morning ahead of the front")
+		}
+	}
+}
+
+func TestHourlyWeatherRespectsTheClimate(t *testing.T) {
+	run, err := NewRunState(RunConfig{Mode: ModeNakedAndAfraid, ScenarioID: "naa_alaska", PlayerCount: 1, RunLength: RunLength{Days: 21}, Seed: 6642})
+	if err != nil {
+		t.Fatalf("new run: %v", err)
+	}
+	for day := 1; day <= 21; day++ {
+		for _, hour := range run.weatherStateForDay(day).Hourly {
+			if isRainyWeather(hour.Type) || hour.Type == WeatherHeatwave {
+				t.Fatalf("expected no rain or heatwave in an alaskan winter, got %s on day %d", hour.Type, day)
+			}
+		}
+	}
+}
+
+func TestForecastsImproveWithSkill(t *testing.T) {
+	run := newRunForCommands(t)
+	novice := PlayerState{ID: 1, Navigation: 0, Bushcraft: -2}
+	expert := PlayerState{ID: 1, Navigation: 90, Bushcraft: 3}
+	noviceRight, expertRight, noviceSpread, expertSpread := 0, 0, 0, 0
+	for day := 2; day <= 60; day++ {
+		actual := run.weatherStateForDay(day)
+		called, low, high := run.forecastCall(&novice, actual)
+		noviceSpread += high - low
+		if called == actual.DayType {
+			noviceRight++
+		}
+		called, low, high = run.forecastCall(&expert, actual)
+		expertSpread += high - low
+		if called == actual.DayType {
+			expertRight++
+		}
+	}
+	if expertRight <= noviceRight || expertSpread >= noviceSpread {
+		t.Fatalf("expected skill to sharpen forecasts: right %d vs %d, spread %d vs %d", expertRight, noviceRight, expertSpread, noviceSpread)
+	}
+}
+
+func TestForecastTrainsNavigationOnceADay(t *testing.T) {
+	run := newRunForCommands(t)
+	player := &run.Players[0]
+	if _, err := run.Forecast(1); err != nil {
+		t.Fatalf("forecast: %v", err)
+	}
+	first := player.SkillProgress[SkillNavigation]
+	for i := 0; i < 5; i++ {
+		_, _ = run.Forecast(1)
+	}
+	if again := player.SkillProgress[SkillNavigation]; again != first {
+		t.Fatalf("expected repeat forecasts on day %d to train nothing, got %+v then %+v", run.Day, first, again)
+	}
+	run.AdvanceDay()
+	_, _ = run.Forecast(1)
+	if next := player.SkillProgress[SkillNavigation]; next.XP == first.XP {
+		t.Fatalf("expected the next day's forecast to train navigation again, got %+v", next)
+	}
+}
@@ -4,6 +4,7 @@ package game
 // - Daily weather is resolved here and cached into RunState.Weather.
 // - This is the narrowest integration point to keep climate/season/temp coherent without loop refactors.
 // - Constrained weather and temperature now flow through the same deterministic day resolver.
+// - The day's hourly timeline is built here too (weather_hourly.go); the day-level Type and TemperatureC stay as before.
 
 func (s *RunState) EnsureWeather() {
 	if s == nil {
@@ -29,13 +30,22 @@ func (s *RunState) weatherStateForDay(day int) WeatherState {
 	}
 
 	weather := s.weatherTypeForDay(day, season)
-
-	return WeatherState{
+	temp := s.temperatureForDay(day, season, weather)
+	state := WeatherState{
 		Day:          day,
 		Type:         weather,
-		TemperatureC: s.temperatureForDay(day, season, weather),
+		TemperatureC: temp,
 		StreakDays:   s.weatherStreakForDay(day, weather),
+		DayType:      weather,
+		MeanC:        temp,
+		Hourly:       s.hourlyTimeline(day, season, weather, temp),
+	}
+	state.LowC, state.HighC = temp, temp
+	for _, hour := range state.Hourly {
+		state.LowC = min(state.LowC, hour.TemperatureC)
+		state.HighC = max(state.HighC, hour.TemperatureC)
 	}
+	return state
 }
 
 func (s *RunState) weatherStreakForDay(day int, weather WeatherType) int {
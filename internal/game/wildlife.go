@@ -607,21 +607,10 @@ func (s *RunState) RollWildlifeEncounter(playerID, x, y int, action string, roll
 }
 
 func wildlifeEncounterSpeciesIDs() []string {
-	biomes := []uint8{
-		TopoBiomeForest,
-		TopoBiomeGrassland,
-		TopoBiomeJungle,
-		TopoBiomeWetland,
-		TopoBiomeSwamp,
-		TopoBiomeDesert,
-		TopoBiomeMountain,
-		TopoBiomeTundra,
-		TopoBiomeBoreal,
-	}
 	channels := []string{"mammal", "bird", "fish", "insect"}
 	seen := map[string]bool{}
 	out := make([]string, 0, 128)
-	for _, biome := range biomes {
+	for _, biome := range encounterTopoBiomes {
 		for _, channel := range channels {
 			for _, sp := range biomeEncounterList(biome, channel) {
 				id := strings.TrimSpace(strings.ToLower(sp.AnimalID))
@@ -0,0 +1,167 @@
+package gui
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/appengine-ltd/survive-it/internal/ai"
+	"github.com/appengine-ltd/survive-it/internal/parser"
+)
+
+// Model calls take seconds, so the companion runs off the frame loop: requests go out on goroutines and
+// replies are picked up by pollCompanion, one request in flight at a time.
+
+type companionRequestKind int
+
+const (
+	companionNarrate companionRequestKind = iota
+	companionAdvise
+	companionInterpret
+)
+
+type companionReply struct {
+	kind companionRequestKind
+	raw  string
+	text string
+	err  error
+}
+
+type companionOpened struct {
+	companion *ai.Companion
+	err       error
+}
+
+type companionState struct {
+	companion *ai.Companion
+	starting  bool
+	busy      bool
+	openCh    chan companionOpened
+	replyCh   chan companionReply
+}
+
+// startCompanion opens the configured model in the background when AI is enabled.
+func (ui *gameUI) startCompanion() {
+	if ui.companion.companion != nil || ui.companion.starting {
+		return
+	}
+	cfg, err := ai.LoadConfig()
+	if err != nil || !cfg.AIEnabled {
+		return
+	}
+	ui.companion.starting = true
+	openCh := make(chan companionOpened, 1)
+	ui.companion.openCh = openCh
+	go func() {
+		backend, err := ai.Open(cfg)
+		openCh <- companionOpened{companion: ai.NewCompanion(backend), err: err}
+	}()
+}
+
+// stopCompanion closes the model, e.g. after AI settings change; the next run reopens it.
+func (ui *gameUI) stopCompanion() {
+	if ui.companion.starting && ui.companion.openCh != nil {
+		openCh := ui.companion.openCh
+		go func() {
+			if opened := <-openCh; opened.companion != nil {
+				_ = opened.companion.Close()
+			}
+		}()
+	}
+	if ui.companion.companion != nil {
+		_ = ui.companion.companion.Close()
+	}
+	ui.companion = companionState{}
+}
+
+func (ui *gameUI) pollCompanion() {
+	if ui.companion.openCh != nil {
+		select {
+		case opened := <-ui.companion.openCh:
+			ui.companion.starting = false
+			ui.companion.openCh = nil
+			if opened.err != nil {
+				if !errors.Is(opened.err, ai.ErrDisabled) {
+					ui.appendRunMessage("AI companion unavailable: " + opened.err.Error())
+				}
+				break
+			}
+			ui.companion.companion = opened.companion
+			ui.companion.replyCh = make(chan companionReply, 1)
+			ui.appendRunMessage("AI companion ready (" + opened.companion.Backend().Name() + "). Ask \"what should I do?\" for advice.")
+		default:
+		}
+	}
+	if ui.companion.replyCh == nil {
+		return
+	}
+	select {
+	case reply := <-ui.companion.replyCh:
+		ui.companion.busy = false
+		ui.handleCompanionReply(reply)
+	default:
+	}
+}
+
+func (ui *gameUI) handleCompanionReply(reply companionReply) {
+	switch reply.kind {
+	case companionNarrate:
+		if reply.err == nil {
+			ui.appendRunMessage("~ " + reply.text)
+		}
+	case companionAdvise:
+		if reply.err != nil {
+			ui.status = "The guide has no answer right now: " + reply.err.Error()
+			return
+		}
+		ui.appendRunMessage("Guide: " + reply.text)
+	case companionInterpret:
+		if reply.err != nil || reply.text == "" {
+			ui.status = "I didn't understand: " + reply.raw
+			return
+		}
+		intent := ui.cmdParser.Parse(ui.buildParseContext(), reply.text)
+		if intent.Kind == parser.Unknown {
+			ui.status = "I didn't understand: " + reply.raw
+			return
+		}
+		// The model's reading is offered for confirmation rather than run outright.
+		ui.setPendingIntent(parser.PendingIntent{
+			OriginalKind: intent.Kind,
+			OriginalVerb: intent.Verb,
+			FilledArgs:   append([]string(nil), intent.Args...),
+			Prompt:       "Did you mean \"" + reply.text + "\"? Choose 1 to run it.",
+			Options:      []parser.Intent{intent},
+		})
+	}
+}
+
+// askCompanion sends one request if the model is ready and idle; it reports whether the request went out.
+func (ui *gameUI) askCompanion(kind companionRequestKind, raw string) bool {
+	companion := ui.companion.companion
+	if companion == nil || ui.companion.busy || ui.run == nil {
+		return false
+	}
+	ui.companion.busy = true
+	summary := ai.SummarizeRun(ui.run)
+	verbs := ui.cmdParser.Verbs()
+	replyCh := ui.companion.replyCh
+	go func() {
+		reply := companionReply{kind: kind, raw: raw}
+		ctx := context.Background()
+		switch kind {
+		case companionNarrate:
+			reply.text, reply.err = companion.Narrate(ctx, raw, summary)
+		case companionAdvise:
+			reply.text, reply.err = companion.Advise(ctx, raw, summary)
+		case companionInterpret:
+			reply.text, reply.err = companion.InterpretCommand(ctx, raw, verbs, summary)
+		}
+		replyCh <- reply
+	}()
+	return true
+}
+
+func (ui *gameUI) narrateEvent(event string) {
+	_ = ui.askCompanion(companionNarrate, strings.TrimSpace(event))
+}
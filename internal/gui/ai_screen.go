@@ -208,6 +208,7 @@ func (ui *gameUI) adjustActiveAIModel(delta int) {
 		ui.ai.Status = "Could not save active AI model: " + err.Error()
 		return
 	}
+	ui.stopCompanion()
 	ui.ai.Status = "Active AI model updated."
 }
 
@@ -312,11 +313,13 @@ func (ui *gameUI) refreshAIModelStatus() {
 			ui.ai.ActiveModelID = downloadedChoices[0].ID
 			if ui.ai.ActiveModelID != prevActive {
 				_ = ai.SaveConfig(ai.Config{AIEnabled: true, ModelID: ui.ai.ActiveModelID})
+				ui.stopCompanion()
 			}
 		} else {
 			ui.ai.ActiveModelID = ""
 			if prevActive != "" {
 				_ = ai.SaveConfig(ai.Config{AIEnabled: false, ModelID: ""})
+				ui.stopCompanion()
 			}
 		}
 	}
@@ -1,18 +1,18 @@
 package gui
 
 import (
-	"encoding/json"
 	"fmt"
 	"math"
-	"os"
 	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/appengine-ltd/survive-it/internal/ai"
 	"github.com/appengine-ltd/survive-it/internal/game"
 	"github.com/appengine-ltd/survive-it/internal/parser"
+	"github.com/appengine-ltd/survive-it/internal/savegame"
 	"github.com/appengine-ltd/survive-it/internal/update"
 	rl "github.com/gen2brain/raylib-go/raylib"
 )
@@ -32,6 +32,9 @@ func NewApp(cfg AppConfig) *App {
 	return &App{cfg: cfg}
 }
 
+// autosaveInterval is wall-clock play time between autosaves of the active run.
+const autosaveInterval = 5 * time.Minute
+
 type screen int
 
 const (
@@ -52,6 +55,8 @@ const (
 	screenRunPlayers
 	screenRunCommandLibrary
 	screenRunInventory
+	screenRunSummary
+	screenCareer
 )
 
 type menuAction int
@@ -134,6 +139,7 @@ type profilesState struct {
 	NameBuffer string
 	Status     string
 	ReturnTo   screen
+	CareerID   string
 }
 
 type runSkillSnapshot struct {
@@ -154,18 +160,7 @@ type scenarioPickerState struct {
 type loadState struct {
 	Cursor      int
 	ReturnToRun bool
-	Entries     []saveEntry
-}
-
-type saveEntry struct {
-	Path  string
-	Saved savedRun
-}
-
-type savedRun struct {
-	FormatVersion int           `json:"format_version"`
-	SavedAt       time.Time     `json:"saved_at"`
-	Run           game.RunState `json:"run"`
+	Entries     []savegame.Entry
 }
 
 type updateResult struct {
@@ -199,9 +194,12 @@ type gameUI struct {
 	rinv            runInventoryState
 	profilesUI      profilesState
 	ai              aiSettingsState
+	companion       companionState
 	customScenarios []game.Scenario
 
 	run         *game.RunState
+	runSummary  *game.RunSummary
+	runReport   *game.RunReport
 	runMessages []string
 	runInput    string
 	status      string
@@ -225,10 +223,17 @@ type gameUI struct {
 	lastTick     time.Time
 	runPlayedFor time.Duration
 	autoDayHours int
+	runJournal   *game.RunJournal
+
+	runSlot       string
+	runPlaytime   time.Duration
+	sinceAutosave time.Duration
 
 	profiles          []playerProfile
 	selectedProfileID string
 	runProfileID      string
+	runRecorded       bool
+	runAchievements   []game.Achievement
 }
 
 func (a *App) Run() error {
@@ -304,6 +309,8 @@ func (ui *gameUI) Run() error {
 		rl.EndDrawing()
 	}
 
+	ui.saveOnExit()
+	ui.stopCompanion()
 	rl.CloseWindow()
 	return nil
 }
@@ -346,6 +353,10 @@ func (ui *gameUI) update(delta time.Duration) {
 		ui.updateRunCommandLibrary()
 	case screenRunInventory:
 		ui.updateRunInventory()
+	case screenRunSummary:
+		ui.updateRunSummary()
+	case screenCareer:
+		ui.updateCareer()
 	}
 }
 
@@ -385,6 +396,10 @@ func (ui *gameUI) draw() {
 		ui.drawRunCommandLibrary()
 	case screenRunInventory:
 		ui.drawRunInventory()
+	case screenRunSummary:
+		ui.drawRunSummary()
+	case screenCareer:
+		ui.drawCareer()
 	}
 }
 
@@ -1198,6 +1213,10 @@ func (ui *gameUI) startRunFromConfig() {
 		return
 	}
 	ui.run = &run
+	ui.runJournal = game.NewRunJournal(ui.run)
+	ui.runSlot = nextFreeSaveSlot()
+	ui.runPlaytime = 0
+	ui.sinceAutosave = 0
 	ui.runMessages = nil
 	ui.runPlayedFor = 0
 	ui.runInput = ""
@@ -1206,13 +1225,18 @@ func (ui *gameUI) startRunFromConfig() {
 	ui.skillBaselineDay = -1
 	ui.skillBaselineBlock = ""
 	ui.pendingIntent = nil
+	ui.runSummary = nil
+	ui.runReport = nil
 	ui.runProfileID = ui.selectedProfileID
+	ui.runRecorded = false
+	ui.runAchievements = nil
 	ui.status = ""
 	ui.appendRunMessage("Run started")
 	ui.appendRunMessage(fmt.Sprintf("Mode: %s | Scenario: %s | Players: %d", modeLabel(run.Config.Mode), run.Scenario.Name, len(run.Players)))
 	ui.appendRunMessage(fmt.Sprintf("Issued kit assigned: %s", kitSummary(issuedKit, 0)))
 	ui.screen = screenRun
 	ui.syncRunPlayedForToMetabolism()
+	ui.startCompanion()
 }
 
 func (ui *gameUI) updateLoad() {
@@ -1239,23 +1263,46 @@ func (ui *gameUI) updateLoad() {
 	}
 	if rl.IsKeyPressed(rl.KeyEnter) {
 		entry := ui.load.Entries[ui.load.Cursor]
-		r := entry.Saved.Run
-		r.EnsureWeather()
-		r.EnsurePlayerRuntimeStats()
-		ui.run = &r
-		ui.runPlayedFor = 0
-		ui.lastEntity = ""
-		ui.skillBaseline = nil
-		ui.skillBaselineDay = -1
-		ui.skillBaselineBlock = ""
-		ui.pendingIntent = nil
-		ui.runProfileID = ui.selectedProfileID
-		ui.status = ""
-		ui.runMessages = nil
-		ui.appendRunMessage("Loaded " + filepath.Base(entry.Path))
-		ui.screen = screenRun
-		ui.syncRunPlayedForToMetabolism()
+		if entry.Err != nil {
+			ui.status = "Cannot load " + filepath.Base(entry.Path) + ": " + entry.Err.Error()
+			return
+		}
+		ui.resumeSavedRun(entry)
+	}
+}
+
+// resumeSavedRun swaps in a loaded save. Autosaves and legacy files continue in a fresh numbered slot so a
+// later manual save never overwrites them.
+func (ui *gameUI) resumeSavedRun(entry savegame.Entry) {
+	r := entry.File.Run
+	ui.run = &r
+	ui.runJournal = savegame.LoadJournal(entry.Path, ui.run)
+	ui.runSlot = entry.File.Meta.Slot
+	if entry.Legacy || ui.runSlot == savegame.AutosaveSlot {
+		ui.runSlot = nextFreeSaveSlot()
 	}
+	ui.runPlaytime = entry.File.Meta.Playtime
+	ui.sinceAutosave = 0
+	ui.runPlayedFor = 0
+	ui.lastEntity = ""
+	ui.skillBaseline = nil
+	ui.skillBaselineDay = -1
+	ui.skillBaselineBlock = ""
+	ui.pendingIntent = nil
+	ui.runSummary = nil
+	ui.runReport = nil
+	ui.runProfileID = ui.selectedProfileID
+	ui.runRecorded = false
+	ui.runAchievements = nil
+	ui.status = ""
+	ui.runMessages = nil
+	ui.appendRunMessage(fmt.Sprintf("Loaded %s (%s)", entry.File.Meta.Slot, filepath.Base(entry.Path)))
+	for _, problem := range game.ContentPackMismatch(entry.File.Meta.ContentPacks) {
+		ui.appendRunMessage("Warning: " + problem + ".")
+	}
+	ui.screen = screenRun
+	ui.syncRunPlayedForToMetabolism()
+	ui.startCompanion()
 }
 
 func (ui *gameUI) drawLoad() {
@@ -1279,21 +1326,37 @@ func (ui *gameUI) drawLoad() {
 		if i == ui.load.Cursor {
 			drawListRowFrame(rl.NewRectangle(left.X+10, float32(y-6), left.Width-20, 32), true)
 		}
-		drawText(filepath.Base(entry.Path), int32(left.X)+20, y, 20, colorText)
+		drawText(saveEntryLabel(entry), int32(left.X)+20, y, 20, saveEntryColor(entry))
 	}
 
 	sel := ui.load.Entries[ui.load.Cursor]
-	run := sel.Saved.Run
+	if sel.Err != nil {
+		lines := []string{
+			"File: " + filepath.Base(sel.Path),
+			"",
+			"This save cannot be loaded:",
+			sel.Err.Error(),
+			"",
+			"Shift+R to refresh",
+			"Esc back",
+		}
+		drawLines(right, 48, 22, lines, colorWarn)
+		return
+	}
+	meta := sel.File.Meta
+	run := sel.File.Run
 	weather := run.Weather
 	weatherLabel := game.WeatherLabel(weather.Type)
 	lines := []string{
+		"Slot: " + meta.Slot + " (" + string(meta.Kind) + ")",
 		"File: " + filepath.Base(sel.Path),
-		"Saved: " + sel.Saved.SavedAt.Local().Format("2006-01-02 15:04:05"),
+		"Saved: " + sel.File.SavedAt.Local().Format("2006-01-02 15:04:05"),
+		"Playtime: " + savegame.FormatPlaytime(meta.Playtime),
 		"",
-		"Mode: " + modeLabel(run.Config.Mode),
-		"Scenario: " + run.Scenario.Name,
-		fmt.Sprintf("Day: %d", run.Day),
-		fmt.Sprintf("Players: %d", len(run.Players)),
+		"Mode: " + modeLabel(meta.Mode),
+		"Scenario: " + meta.ScenarioName,
+		fmt.Sprintf("Day: %d  %s", meta.Day, formatClockFromHours(meta.ClockHours)),
+		fmt.Sprintf("Players (%d): %s", len(meta.Players), strings.Join(meta.Players, ", ")),
 		fmt.Sprintf("Weather: %s", weatherLabel),
 		"Temp: " + ui.formatTemperature(weather.TemperatureC),
 		"",
@@ -1301,9 +1364,34 @@ func (ui *gameUI) drawLoad() {
 		"Shift+R to refresh",
 		"Esc back",
 	}
+	if sel.Legacy {
+		lines = append([]string{"Legacy save from the working directory"}, lines...)
+	}
 	drawLines(right, 48, 22, lines, colorText)
 }
 
+func saveEntryLabel(entry savegame.Entry) string {
+	if entry.Err != nil {
+		return filepath.Base(entry.Path) + " (unreadable)"
+	}
+	meta := entry.File.Meta
+	label := fmt.Sprintf("%s | Day %d | %s", meta.Slot, meta.Day, meta.ScenarioName)
+	if meta.Kind != savegame.KindManual {
+		label += " [" + string(meta.Kind) + "]"
+	}
+	return label
+}
+
+func saveEntryColor(entry savegame.Entry) rl.Color {
+	if entry.Err != nil {
+		return colorWarn
+	}
+	if entry.Legacy {
+		return colorDim
+	}
+	return colorText
+}
+
 func (ui *gameUI) updateRun(delta time.Duration) {
 	if ui.run == nil {
 		ui.enterMenu()
@@ -1332,36 +1420,43 @@ func (ui *gameUI) updateRun(delta time.Duration) {
 		return
 	}
 	ui.processIntentQueue()
+	ui.pollCompanion()
 	ui.runPlayedFor += delta
+	ui.runPlaytime += delta
+	ui.sinceAutosave += delta
+	if ui.sinceAutosave >= autosaveInterval {
+		if _, err := ui.saveRun(savegame.AutosaveSlot, savegame.KindAutosave); err != nil {
+			ui.status = "Autosave failed: " + err.Error()
+		}
+		ui.sinceAutosave = 0
+	}
 	dayDuration := ui.autoDayDuration()
-	ui.run.ApplyRealtimeMetabolism(ui.runPlayedFor, dayDuration)
+	ui.runJournal.ApplyRealtimeMetabolism(ui.run, ui.runPlayedFor, dayDuration)
 	for ui.runPlayedFor >= dayDuration {
 		prevDay := ui.run.Day
-		ui.run.AdvanceDay()
+		ui.runJournal.AdvanceDay(ui.run)
 		ui.runPlayedFor -= dayDuration
-		ui.run.ApplyRealtimeMetabolism(ui.runPlayedFor, dayDuration)
-		if ui.run.Day != prevDay {
-			weather := game.WeatherLabel(ui.run.Weather.Type)
-			ui.appendRunMessage(fmt.Sprintf("Day %d started | Weather: %s | Temp: %s", ui.run.Day, weather, ui.formatTemperature(ui.run.Weather.TemperatureC)))
-		}
-
-		if events := ui.run.ProcessContestantSimulation(dayDuration); len(events) > 0 {
-			for _, event := range events {
-				ui.appendRunMessage(event)
-			}
-		}
+		ui.runJournal.ApplyRealtimeMetabolism(ui.run, ui.runPlayedFor, dayDuration)
+		ui.afterDayChange(prevDay)
+	}
+	for _, report := range ui.run.DrainReports() {
+		ui.appendRunMessage(report)
+	}
+	ui.checkRunOutcome()
+	if ui.screen != screenRun {
+		return
 	}
+	ui.promptEncounter()
 
 	if rl.IsKeyPressed(rl.KeyEscape) {
 		ui.leaveRunToMenu()
 		return
 	}
 	if HotkeysEnabled(ui) && ShiftPressedKey(rl.KeyS) {
-		path := savePathForSlot(1)
-		if err := saveRunToFile(path, *ui.run); err != nil {
+		if path, err := ui.saveRun(ui.runSlot, savegame.KindManual); err != nil {
 			ui.status = "Save failed: " + err.Error()
 		} else {
-			ui.status = "Saved to " + path
+			ui.status = fmt.Sprintf("Saved %s to %s", ui.runSlot, path)
 			ui.appendRunMessage(ui.status)
 		}
 	}
@@ -1648,7 +1743,7 @@ func (ui *gameUI) drawRunPlayers() {
 		fmt.Sprintf("Height: %d ft %d in  Weight: %d kg", sel.HeightFt, sel.HeightIn, sel.WeightKg),
 		fmt.Sprintf("Base modifiers  Endurance:%+d  Bushcraft:%+d  Mental:%+d", sel.Endurance, sel.Bushcraft, sel.Mental),
 		"",
-		fmt.Sprintf("Energy:%d  Hydration:%d  Morale:%d", sel.Energy, sel.Hydration, sel.Morale),
+		fmt.Sprintf("Energy:%d  Hydration:%d  Morale:%d  Core:%s", sel.Energy, sel.Hydration, sel.Morale, game.ThermalLabel(sel)),
 		fmt.Sprintf("Food reserves: %d kcal  Protein %dg  Fat %dg  Sugar %dg", sel.CaloriesReserveKcal, sel.ProteinReserveG, sel.FatReserveG, sel.SugarReserveG),
 		fmt.Sprintf("Daily needs: %d kcal  Protein %dg  Fat %dg  Sugar %dg", needs.CaloriesKcal, needs.ProteinG, needs.FatG, needs.SugarG),
 		fmt.Sprintf("Today eaten: %d kcal  Protein %dg  Fat %dg  Sugar %dg", sel.Nutrition.CaloriesKcal, sel.Nutrition.ProteinG, sel.Nutrition.FatG, sel.Nutrition.SugarG),
@@ -1667,11 +1762,7 @@ func (ui *gameUI) drawRunPlayers() {
 	if len(sel.Ailments) > 0 {
 		parts := make([]string, 0, len(sel.Ailments))
 		for _, ail := range sel.Ailments {
-			name := ail.Name
-			if strings.TrimSpace(name) == "" {
-				name = string(ail.Type)
-			}
-			parts = append(parts, fmt.Sprintf("%s (%dd)", name, ail.DaysRemaining))
+			parts = append(parts, fmt.Sprintf("%s %dd", ail.Label(), ail.DaysRemaining))
 		}
 		ailments = strings.Join(parts, ", ")
 	}
@@ -1679,7 +1770,7 @@ func (ui *gameUI) drawRunPlayers() {
 	if len(sel.Kit) > 0 {
 		parts := make([]string, 0, len(sel.Kit))
 		for _, item := range sel.Kit {
-			parts = append(parts, string(item))
+			parts = append(parts, string(item)+game.GearConditionLabel(ui.run.KitCondition(&sel, item)))
 		}
 		personalKit = strings.Join(parts, ", ")
 	}
@@ -1687,7 +1778,7 @@ func (ui *gameUI) drawRunPlayers() {
 	if len(ui.run.Config.IssuedKit) > 0 {
 		parts := make([]string, 0, len(ui.run.Config.IssuedKit))
 		for _, item := range ui.run.Config.IssuedKit {
-			parts = append(parts, string(item))
+			parts = append(parts, string(item)+game.GearConditionLabel(ui.run.KitCondition(&sel, item)))
 		}
 		issuedKit = strings.Join(parts, ", ")
 	}
@@ -1723,8 +1814,8 @@ func (ui *gameUI) drawRunCommandLibrary() {
 		"look [left|right|front|back]",
 		"look closer at <plants|trees|insects|water>",
 		"next",
-		"save",
-		"load",
+		"save [slot#|name]",
+		"load [slot#|name]",
 		"menu",
 		"",
 		"Food and hunting:",
@@ -1745,11 +1836,16 @@ func (ui *gameUI) drawRunCommandLibrary() {
 		"cook <raw_meat> [kg] [p#]",
 		"preserve <smoke|dry|salt> <meat> [kg] [p#]",
 		"eat <food_item> [grams|kg] [p#]",
+		"drink [boiled|treated|filtered|raw] [litres] [p#]",
+		"water status|collect|boil|filter|treat [litres] [p#]",
+		"sleep|rest|nap [hours] [p#]",
 		"go <n|s|e|w> [km] [p#]",
 		"fire status|methods|prep|ember|ignite|build|tend|out",
 		"shelter list|build|status",
 		"craft list|make|inventory",
-		"ask <player> <task>",
+		"ask <p#> <task>|status|stop",
+		"tap out [p#] confirm",
+		"producer",
 	}
 	rightLines := []string{
 		"Equipment actions:",
@@ -1899,22 +1995,36 @@ func (ui *gameUI) executeIntent(intent parser.Intent) {
 
 	switch verb {
 	case "next":
-		ui.run.AdvanceDay()
+		ui.runJournal.AdvanceDay(ui.run)
 		ui.syncRunPlayedForToMetabolism()
 		ui.status = ""
+		ui.afterDayChange(prevDay)
+		return
 	case "save":
-		path := savePathForSlot(1)
-		if err := saveRunToFile(path, *ui.run); err != nil {
+		slot := ui.runSlot
+		if name := savegame.SlotArg(strings.Fields(command)); name != "" {
+			named, err := savegame.SlotName(name)
+			if err != nil {
+				ui.status = "Save failed: " + err.Error()
+				return
+			}
+			slot = named
+		}
+		path, err := ui.saveRun(slot, savegame.KindManual)
+		if err != nil {
 			ui.status = "Save failed: " + err.Error()
 			return
 		}
-		ui.status = "Saved to " + path
+		ui.runSlot = slot
+		ui.status = fmt.Sprintf("Saved %s to %s", slot, path)
 		ui.appendRunMessage(ui.status)
-		ui.updateLastEntityFromIntent(intent, true)
 		return
 	case "load":
+		if name := savegame.SlotArg(strings.Fields(command)); name != "" {
+			ui.loadSlot(name)
+			return
+		}
 		ui.openLoad(true)
-		ui.updateLastEntityFromIntent(intent, true)
 		return
 	case "menu", "back":
 		ui.leaveRunToMenu()
@@ -1955,7 +2065,7 @@ func (ui *gameUI) executeIntent(intent parser.Intent) {
 		}
 	}
 
-	res := ui.run.ExecuteRunCommand(command)
+	res := ui.runJournal.ExecuteRunCommand(ui.run, command)
 	if res.Handled {
 		ui.syncRunPlayedForToMetabolism()
 		ui.status = ""
@@ -1963,10 +2073,7 @@ func (ui *gameUI) executeIntent(intent parser.Intent) {
 		if res.HoursAdvanced > 0 {
 			ui.appendRunMessage(fmt.Sprintf("Time spent: +%.1f hours | %s -> %s", res.HoursAdvanced, formatClockFromHours(prevClock), formatClockFromHours(ui.run.ClockHours)))
 		}
-		if ui.run.Day != prevDay {
-			weather := game.WeatherLabel(ui.run.Weather.Type)
-			ui.appendRunMessage(fmt.Sprintf("Day %d started | Weather: %s | Temp: %s", ui.run.Day, weather, ui.formatTemperature(ui.run.Weather.TemperatureC)))
-		}
+		ui.afterDayChange(prevDay)
 		ui.updateLastEntityFromIntent(intent, true)
 		return
 	}
@@ -1974,6 +2081,23 @@ func (ui *gameUI) executeIntent(intent parser.Intent) {
 	ui.updateLastEntityFromIntent(intent, false)
 }
 
+// afterDayChange announces a new day and runs Alone rivals once for every day crossed since prevDay,
+// whether realtime play or a command moved the clock.
+func (ui *gameUI) afterDayChange(prevDay int) {
+	if ui.run.Day == prevDay {
+		return
+	}
+	weather := game.WeatherLabel(ui.run.Weather.Type)
+	dayLine := fmt.Sprintf("Day %d started | Weather: %s | Temp: %s", ui.run.Day, weather, ui.formatTemperature(ui.run.Weather.TemperatureC))
+	ui.appendRunMessage(dayLine)
+	ui.narrateEvent(dayLine)
+	for day := prevDay; day < ui.run.Day; day++ {
+		for _, event := range ui.runJournal.ProcessContestantSimulation(ui.run, game.ContestantDayTick) {
+			ui.appendRunMessage(event)
+		}
+	}
+}
+
 func (ui *gameUI) updateLastEntityFromIntent(intent parser.Intent, handled bool) {
 	if !handled || len(intent.Args) == 0 {
 		return
@@ -2148,6 +2272,24 @@ func (ui *gameUI) setPendingIntent(p parser.PendingIntent) {
 	ui.status = ""
 }
 
+// promptEncounter turns an open predator confrontation into numbered choices until it is answered.
+func (ui *gameUI) promptEncounter() {
+	if ui.run == nil || ui.run.Encounter == nil || ui.pendingIntent != nil {
+		return
+	}
+	options := ui.run.EncounterOptions()
+	intents := make([]parser.Intent, 0, len(options))
+	for _, option := range options {
+		intents = append(intents, parser.Intent{Kind: parser.Command, Verb: "encounter", Args: []string{string(option.Choice)}})
+	}
+	ui.setPendingIntent(parser.PendingIntent{
+		OriginalKind: parser.Command,
+		OriginalVerb: "encounter",
+		Prompt:       fmt.Sprintf("Choose how to face the %s:", strings.ToLower(ui.run.Encounter.Species)),
+		Options:      intents,
+	})
+}
+
 func (ui *gameUI) clearPendingIntent() {
 	ui.pendingIntent = nil
 }
@@ -2196,8 +2338,16 @@ func (ui *gameUI) submitRunInput() {
 		return
 	}
 
+	if ai.IsAdviceRequest(commandRaw) && ui.askCompanion(companionAdvise, commandRaw) {
+		ui.status = "Asking the guide..."
+		return
+	}
 	ctx := ui.buildParseContext()
 	intent := ui.cmdParser.Parse(ctx, commandRaw)
+	if intent.Kind == parser.Unknown && intent.Confidence < ai.FallbackConfidence && ui.askCompanion(companionInterpret, commandRaw) {
+		ui.status = "Thinking about \"" + commandRaw + "\"..."
+		return
+	}
 	if pending, ok := ui.pendingIntentFromParsedIntent(intent); ok {
 		ui.setPendingIntent(*pending)
 		return
@@ -2483,7 +2633,11 @@ func pendingMissingField(pending *parser.PendingIntent, want string) bool {
 }
 
 func (ui *gameUI) openLoad(returnToRun bool) {
-	entries, err := loadSaves()
+	dir, err := savegame.SaveDir()
+	if err != nil {
+		ui.status = "Load failed: " + err.Error()
+	}
+	entries, err := savegame.List(dir, ".")
 	if err != nil {
 		ui.status = "Load failed: " + err.Error()
 		entries = nil
@@ -2492,44 +2646,66 @@ func (ui *gameUI) openLoad(returnToRun bool) {
 	ui.screen = screenLoad
 }
 
-func loadSaves() ([]saveEntry, error) {
-	matches, err := filepath.Glob("survive-it-save-*.json")
+// loadSlot loads a named or numbered slot directly, e.g. "load 2" or "load base camp".
+func (ui *gameUI) loadSlot(name string) {
+	slot, err := savegame.SlotName(name)
 	if err != nil {
-		return nil, err
+		ui.status = "Load failed: " + err.Error()
+		return
 	}
-	sort.Strings(matches)
-	entries := make([]saveEntry, 0, len(matches))
-	for _, path := range matches {
-		data, err := os.ReadFile(path)
-		if err != nil {
-			continue
-		}
-		var payload savedRun
-		if err := json.Unmarshal(data, &payload); err != nil {
-			continue
-		}
-		entries = append(entries, saveEntry{Path: path, Saved: payload})
+	dir, err := savegame.SaveDir()
+	if err != nil {
+		ui.status = "Load failed: " + err.Error()
+		return
 	}
-	sort.Slice(entries, func(i, j int) bool {
-		return entries[i].Saved.SavedAt.After(entries[j].Saved.SavedAt)
-	})
-	return entries, nil
+	path := savegame.PathForSlot(dir, slot)
+	file, err := savegame.Load(path)
+	if err != nil {
+		ui.status = "Load failed: " + err.Error()
+		return
+	}
+	ui.resumeSavedRun(savegame.Entry{Path: path, File: file})
 }
 
-func saveRunToFile(path string, run game.RunState) error {
-	payload := savedRun{FormatVersion: 1, SavedAt: time.Now().UTC(), Run: run}
-	data, err := json.MarshalIndent(payload, "", "  ")
+// saveRun writes the active run and its journal into slot under the per-user save directory.
+func (ui *gameUI) saveRun(slot string, kind savegame.Kind) (string, error) {
+	if ui.run == nil {
+		return "", fmt.Errorf("no active run")
+	}
+	dir, err := savegame.SaveDir()
+	if err != nil {
+		return "", err
+	}
+	path, err := savegame.Save(dir, savegame.Request{
+		Slot:     slot,
+		Kind:     kind,
+		Run:      ui.run,
+		Journal:  ui.runJournal,
+		Playtime: ui.runPlaytime,
+	})
 	if err != nil {
-		return err
+		return "", err
 	}
-	return os.WriteFile(path, data, 0o600)
+	ui.sinceAutosave = 0
+	return path, nil
 }
 
-func savePathForSlot(slot int) string {
-	if slot < 1 {
-		slot = 1
+// saveOnExit keeps the active run's slot current when the player leaves the run or closes the window.
+func (ui *gameUI) saveOnExit() {
+	if ui.run == nil || ui.runSlot == "" {
+		return
+	}
+	if _, err := ui.saveRun(ui.runSlot, savegame.KindExit); err != nil {
+		ui.status = "Save on exit failed: " + err.Error()
+	}
+}
+
+func nextFreeSaveSlot() string {
+	dir, err := savegame.SaveDir()
+	if err != nil {
+		return "slot-1"
 	}
-	return fmt.Sprintf("survive-it-save-%d.json", slot)
+	return savegame.NextFreeSlot(dir)
 }
 
 func (ui *gameUI) appendRunMessage(message string) {
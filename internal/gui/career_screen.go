@@ -0,0 +1,134 @@
+package gui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/appengine-ltd/survive-it/internal/game"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Discovery summary:
+// - Profiles already had their own screen; the career screen opens from it (Shift+C) for the profile under the cursor.
+// - The career itself (runs, bests, achievements) lives in game.CareerRecord; this file only draws and exports it.
+// - Exports are plain JSON next to the profiles file so a team can drop several side by side and compare them.
+
+// careerExport is what a profile's career looks like on disk for sharing.
+type careerExport struct {
+	ProfileID         string                    `json:"profile_id"`
+	Name              string                    `json:"name"`
+	ExportedAt        time.Time                 `json:"exported_at"`
+	RunsPlayed        int                       `json:"runs_played"`
+	TotalDaysSurvived int                       `json:"total_days_survived"`
+	Skills            game.PlayerConfig         `json:"skills"`
+	Career            game.CareerRecord         `json:"career"`
+	Achievements      []careerExportAchievement `json:"achievements"`
+}
+
+type careerExportAchievement struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Unlocked    bool   `json:"unlocked"`
+}
+
+func careerExportPath(profile playerProfile) string {
+	return fmt.Sprintf("survive-it-career-%s.json", profile.ID)
+}
+
+// exportCareer writes the profile's career, with every achievement and whether it is unlocked, as JSON.
+func exportCareer(path string, profile playerProfile) error {
+	export := careerExport{
+		ProfileID:         profile.ID,
+		Name:              profile.Name,
+		ExportedAt:        time.Now().UTC(),
+		RunsPlayed:        profile.RunsPlayed,
+		TotalDaysSurvived: profile.TotalDaysSurvived,
+		Skills:            profile.Config,
+		Career:            profile.Career,
+	}
+	for _, a := range game.Achievements() {
+		export.Achievements = append(export.Achievements, careerExportAchievement{ID: a.ID, Name: a.Name, Description: a.Description, Unlocked: profile.Career.HasAchievement(a.ID)})
+	}
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+func (ui *gameUI) openCareer(profileID string) {
+	ui.profilesUI.CareerID = profileID
+	ui.profilesUI.Status = ""
+	ui.screen = screenCareer
+}
+
+func (ui *gameUI) careerProfile() (playerProfile, bool) {
+	idx := profileIndexByID(ui.profiles, ui.profilesUI.CareerID)
+	if idx < 0 {
+		return playerProfile{}, false
+	}
+	return ui.profiles[idx], true
+}
+
+func (ui *gameUI) updateCareer() {
+	if rl.IsKeyPressed(rl.KeyEscape) || rl.IsKeyPressed(rl.KeyEnter) {
+		ui.screen = screenProfiles
+		return
+	}
+	if ShiftPressedKey(rl.KeyE) {
+		profile, ok := ui.careerProfile()
+		if !ok {
+			return
+		}
+		path := careerExportPath(profile)
+		if err := exportCareer(path, profile); err != nil {
+			ui.profilesUI.Status = "Career export failed: " + err.Error()
+			return
+		}
+		ui.profilesUI.Status = "Career exported to " + path
+	}
+}
+
+func (ui *gameUI) drawCareer() {
+	DrawFrame(ui.width, ui.height)
+	left := rl.NewRectangle(20, 20, float32(ui.width)*0.55, float32(ui.height-40))
+	right := rl.NewRectangle(left.X+left.Width+20, 20, float32(ui.width)-left.Width-60, float32(ui.height-40))
+	profile, ok := ui.careerProfile()
+	if !ok {
+		drawPanel(left, "Career")
+		drawWrappedText("No profile selected.", left, 48, typeScale.Body, colorWarn)
+		return
+	}
+	drawPanel(left, "Career: "+profile.Name)
+	drawPanel(right, "Achievements")
+
+	y := int32(50)
+	for _, line := range profile.Career.Lines() {
+		drawWrappedText(line, left, y, typeScale.Small, colorText)
+		y += 34
+	}
+	if profile.Career.RunsPlayed == 0 {
+		drawWrappedText("No finished runs yet.", left, y, typeScale.Small, colorDim)
+	}
+
+	y = int32(50)
+	for _, a := range game.Achievements() {
+		clr := colorMuted
+		mark := "  "
+		if profile.Career.HasAchievement(a.ID) {
+			clr = colorAccent
+			mark = "* "
+		}
+		drawText(mark+a.Name, int32(right.X)+16, int32(right.Y)+y, typeScale.Small, clr)
+		drawText(a.Description, int32(right.X)+36, int32(right.Y)+y+20, 16, colorDim)
+		y += 44
+	}
+
+	if ui.profilesUI.Status != "" {
+		drawWrappedText(ui.profilesUI.Status, left, int32(left.Height)-76, typeScale.Small, colorAccent)
+	}
+	DrawHintText("Shift+E export JSON | Esc back", int32(left.X)+16, int32(left.Y+left.Height)-30)
+}
@@ -23,6 +23,7 @@ type playerProfile struct {
 	RunsPlayed        int               `json:"runs_played"`
 	TotalDaysSurvived int               `json:"total_days_survived"`
 	Config            game.PlayerConfig `json:"config"`
+	Career            game.CareerRecord `json:"career"`
 }
 
 type playerProfilesPayload struct {
@@ -351,6 +352,10 @@ func (ui *gameUI) updateProfiles() {
 		ui.startProfileRename(ui.profilesUI.Cursor)
 		return
 	}
+	if ShiftPressedKey(rl.KeyC) && ui.profilesUI.Cursor < len(ui.profiles) {
+		ui.openCareer(ui.profiles[ui.profilesUI.Cursor].ID)
+		return
+	}
 
 	if rl.IsKeyPressed(rl.KeyEnter) {
 		switch ui.profilesUI.Cursor {
@@ -469,7 +474,7 @@ func (ui *gameUI) drawProfiles() {
 	}
 	drawText("Back", int32(left.X)+16, addY+38, typeScale.Body, colorText)
 
-	DrawHintText("Enter select | Shift+N new | Shift+R rename | Esc back", int32(left.X)+16, int32(left.Y+left.Height)-30)
+	DrawHintText("Enter select | Shift+N new | Shift+R rename | Shift+C career | Esc back", int32(left.X)+16, int32(left.Y+left.Height)-30)
 
 	profile, ok := ui.selectedProfile()
 	if !ok {
@@ -481,6 +486,7 @@ func (ui *gameUI) drawProfiles() {
 		fmt.Sprintf("Profile ID: %s", profile.ID),
 		fmt.Sprintf("Runs Played: %d", profile.RunsPlayed),
 		fmt.Sprintf("Total Days Survived: %d", profile.TotalDaysSurvived),
+		fmt.Sprintf("Achievements: %d/%d", len(profile.Career.Achievements), len(game.Achievements())),
 	}
 	if !profile.LastPlayedAt.IsZero() {
 		lines = append(lines, "Last Played: "+profile.LastPlayedAt.Local().Format("2006-01-02 15:04"))
@@ -548,6 +554,7 @@ func playerConfigFromState(player game.PlayerState) game.PlayerConfig {
 		Sheltercraft:   player.Sheltercraft,
 		Cooking:        player.Cooking,
 		Navigation:     player.Navigation,
+		SkillXP:        player.SkillXP(),
 		CurrentTask:    player.CurrentTask,
 		Traits:         append([]game.TraitModifier(nil), player.Traits...),
 		KitLimit:       player.KitLimit,
@@ -555,9 +562,10 @@ func playerConfigFromState(player game.PlayerState) game.PlayerConfig {
 	}
 }
 
-func (ui *gameUI) persistActiveRunProfileProgress() {
+// activeRunProfile is the profile the active run is played as, or nil.
+func (ui *gameUI) activeRunProfile() *playerProfile {
 	if ui == nil || ui.run == nil || len(ui.run.Players) == 0 || len(ui.profiles) == 0 {
-		return
+		return nil
 	}
 	profileID := strings.TrimSpace(ui.runProfileID)
 	if profileID == "" {
@@ -565,9 +573,18 @@ func (ui *gameUI) persistActiveRunProfileProgress() {
 	}
 	idx := profileIndexByID(ui.profiles, profileID)
 	if idx < 0 {
+		return nil
+	}
+	return &ui.profiles[idx]
+}
+
+// persistActiveRunProfileProgress carries the run's name and skills into its profile. It runs whenever the
+// player leaves a run, finished or not; the career entry is filed separately by recordRunCareer.
+func (ui *gameUI) persistActiveRunProfileProgress() {
+	profile := ui.activeRunProfile()
+	if profile == nil {
 		return
 	}
-	profile := &ui.profiles[idx]
 	player := ui.run.Players[0]
 	cfg := sanitizeProfileConfig(playerConfigFromState(player), ui.run.Config.Mode)
 	name := strings.TrimSpace(player.Name)
@@ -580,8 +597,6 @@ func (ui *gameUI) persistActiveRunProfileProgress() {
 	profile.Name = name
 	cfg.Name = name
 	profile.Config = cfg
-	profile.RunsPlayed++
-	profile.TotalDaysSurvived += maxInt(0, ui.run.Day)
 	now := time.Now().UTC()
 	if profile.CreatedAt.IsZero() {
 		profile.CreatedAt = now
@@ -591,7 +606,24 @@ func (ui *gameUI) persistActiveRunProfileProgress() {
 	ui.saveProfilesToDisk()
 }
 
+// recordRunCareer files the finished run in the profile's career, once per run, and syncs the profile.
+func (ui *gameUI) recordRunCareer() {
+	profile := ui.activeRunProfile()
+	if profile == nil || ui.runRecorded {
+		return
+	}
+	player := ui.run.Players[0]
+	profile.RunsPlayed++
+	profile.TotalDaysSurvived += maxInt(0, ui.run.Day)
+	if run, err := ui.run.CareerRunFor(player.ID); err == nil {
+		ui.runAchievements = profile.Career.AddRun(run)
+	}
+	ui.runRecorded = true
+	ui.persistActiveRunProfileProgress()
+}
+
 func (ui *gameUI) leaveRunToMenu() {
+	ui.saveOnExit()
 	ui.persistActiveRunProfileProgress()
 	ui.runProfileID = ""
 	ui.enterMenu()
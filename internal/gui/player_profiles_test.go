@@ -111,15 +111,34 @@ func TestPersistActiveRunProfileProgress(t *testing.T) {
 	}
 	beforeRuns := ui.profiles[idx].RunsPlayed
 	ui.run.Players[0].Hunting = 61
+	ui.run.Players[0].SkillProgress = map[game.SkillID]game.SkillProgress{game.SkillHunting: {XP: 1.5, LastUsed: 10}}
 	ui.run.Day = 12
 	ui.persistActiveRunProfileProgress()
+	if ui.profiles[idx].Config.Hunting != 61 || ui.profiles[idx].RunsPlayed != beforeRuns || ui.profiles[idx].Career.RunsPlayed != 0 {
+		t.Fatalf("expected leaving mid-run to sync skills without filing the run, got %+v", ui.profiles[idx])
+	}
+	ui.recordRunCareer()
 	if ui.profiles[idx].RunsPlayed != beforeRuns+1 {
 		t.Fatalf("expected runs played %d, got %d", beforeRuns+1, ui.profiles[idx].RunsPlayed)
 	}
 	if ui.profiles[idx].Config.Hunting != 61 {
 		t.Fatalf("expected persisted hunting 61, got %d", ui.profiles[idx].Config.Hunting)
 	}
+	if ui.profiles[idx].Config.SkillXP[game.SkillHunting] != 1.5 {
+		t.Fatalf("expected persisted hunting xp 1.5, got %v", ui.profiles[idx].Config.SkillXP)
+	}
 	if ui.profiles[idx].TotalDaysSurvived < 12 {
 		t.Fatalf("expected persisted days survived to increase, got %d", ui.profiles[idx].TotalDaysSurvived)
 	}
+	if career := ui.profiles[idx].Career; career.RunsPlayed != 1 || len(career.Runs) != 1 || career.Runs[0].DaysSurvived != 11 {
+		t.Fatalf("expected the run filed in the career, got %+v", career)
+	}
+	ui.recordRunCareer()
+	ui.persistActiveRunProfileProgress()
+	if ui.profiles[idx].RunsPlayed != beforeRuns+1 || ui.profiles[idx].Career.RunsPlayed != 1 {
+		t.Fatalf("expected a run to be recorded only once")
+	}
+	if err := exportCareer("career.json", ui.profiles[idx]); err != nil {
+		t.Fatalf("export career: %v", err)
+	}
 }
@@ -65,7 +65,7 @@ func TestSubmitRunInputPendingDistanceRetriesThenCompletesTravel(t *testing.T) {
 		t.Fatalf("expected clearer retry guidance, got status: %q", ui.status)
 	}
 
-	beforeKm := ui.run.Travel.TotalKm
+	beforeKm := ui.run.Players[0].Travel.TotalKm
 
 	ui.runInput = "500m"
 	ui.submitRunInput()
@@ -73,8 +73,8 @@ func TestSubmitRunInputPendingDistanceRetriesThenCompletesTravel(t *testing.T) {
 		t.Fatalf("expected pending go intent to be cleared after distance input")
 	}
 	ui.processIntentQueue()
-	if ui.run.Travel.TotalKm <= beforeKm {
-		t.Fatalf("expected travel progress after distance input; before %.2f after %.2f", beforeKm, ui.run.Travel.TotalKm)
+	if ui.run.Players[0].Travel.TotalKm <= beforeKm {
+		t.Fatalf("expected travel progress after distance input; before %.2f after %.2f", beforeKm, ui.run.Players[0].Travel.TotalKm)
 	}
 }
 
@@ -82,15 +82,15 @@ func TestSubmitRunInputGoWithDistanceTravelsImmediately(t *testing.T) {
 	ui := newGameUI(AppConfig{NoUpdate: true})
 	ui.run = testRunState(t)
 
-	beforeKm := ui.run.Travel.TotalKm
+	beforeKm := ui.run.Players[0].Travel.TotalKm
 	ui.runInput = "go north 2km"
 	ui.submitRunInput()
 	if ui.pendingIntent != nil {
 		t.Fatalf("did not expect pending intent when distance is provided")
 	}
 	ui.processIntentQueue()
-	if ui.run.Travel.TotalKm <= beforeKm {
-		t.Fatalf("expected movement from immediate go command; before %.2f after %.2f", beforeKm, ui.run.Travel.TotalKm)
+	if ui.run.Players[0].Travel.TotalKm <= beforeKm {
+		t.Fatalf("expected movement from immediate go command; before %.2f after %.2f", beforeKm, ui.run.Players[0].Travel.TotalKm)
 	}
 }
 
@@ -167,7 +167,7 @@ func TestSubmitRunInputHighRiskMovementPromptsConfirmation(t *testing.T) {
 	// Ensure distance is provided to bypass distance prompt
 	ui.runInput = "go north 2km"
 
-	beforeKm := ui.run.Travel.TotalKm
+	beforeKm := ui.run.Players[0].Travel.TotalKm
 
 	ui.submitRunInput()
 	ui.processIntentQueue()
@@ -188,8 +188,8 @@ func TestSubmitRunInputHighRiskMovementPromptsConfirmation(t *testing.T) {
 	// processIntentQueue is not needed here because resolvePendingIntentAnswer directly enqueues
 	// wait, resolvePendingIntentAnswer returns an Intent which submitRunInput enqueues
 	ui.processIntentQueue()
-	if ui.run.Travel.TotalKm <= beforeKm {
-		t.Fatalf("expected travel progress after confirmation; before %.2f after %.2f", beforeKm, ui.run.Travel.TotalKm)
+	if ui.run.Players[0].Travel.TotalKm <= beforeKm {
+		t.Fatalf("expected travel progress after confirmation; before %.2f after %.2f", beforeKm, ui.run.Players[0].Travel.TotalKm)
 	}
 }
 
@@ -201,7 +201,7 @@ func TestSubmitRunInputHighRiskMovementCancel(t *testing.T) {
 	ui.run.ClockHours = 2.0
 	ui.runInput = "go north 2km"
 
-	beforeKm := ui.run.Travel.TotalKm
+	beforeKm := ui.run.Players[0].Travel.TotalKm
 
 	ui.submitRunInput()
 	ui.processIntentQueue()
@@ -217,10 +217,30 @@ func TestSubmitRunInputHighRiskMovementCancel(t *testing.T) {
 		t.Fatalf("expected pending intent to be cleared after cancellation")
 	}
 	ui.processIntentQueue()
-	if ui.run.Travel.TotalKm != beforeKm {
+	if ui.run.Players[0].Travel.TotalKm != beforeKm {
 		t.Fatalf("did not expect travel progress after cancellation")
 	}
 	if len(ui.runMessages) == 0 || !strings.Contains(strings.ToLower(ui.runMessages[len(ui.runMessages)-1]), "action cancelled") {
 		t.Fatalf("expected cancel feedback in message log, got: %+v", ui.runMessages)
 	}
 }
+
+func TestCommandsThatCrossMidnightRunTheRivals(t *testing.T) {
+	ui := newGameUI(AppConfig{NoUpdate: true})
+	ui.run = testRunState(t)
+	ui.runJournal = game.NewRunJournal(ui.run)
+
+	ui.runInput = "next"
+	ui.submitRunInput()
+	if ui.run.Day != 2 || ui.run.ContestantTicks != 1 {
+		t.Fatalf("expected next to run the rivals for the day, got day %d ticks %d", ui.run.Day, ui.run.ContestantTicks)
+	}
+
+	for i := 0; i < 2; i++ {
+		ui.runInput = "sleep 12"
+		ui.submitRunInput()
+	}
+	if ui.run.Day != 3 || ui.run.ContestantTicks != ui.run.Day-1 {
+		t.Fatalf("expected one rival tick per crossed day, got day %d ticks %d", ui.run.Day, ui.run.ContestantTicks)
+	}
+}
@@ -14,6 +14,7 @@ import (
 // - Minimap/full-map rendering both route through drawTopologyRegion and share cell color logic.
 // - Water visuals previously ignored runtime temperature, so freezing conditions looked like open water.
 // - A debug env flag now surfaces biome/temp/frozen coherence data without changing default UI flow.
+// - Points of interest the team has found are drawn as small diamonds under camps and players.
 
 const (
 	runLogSplitRatio = 0.66
@@ -108,6 +109,16 @@ func topoBiomeColor(b uint8) rl.Color {
 	}
 }
 
+// staleFogShade washes a cell out towards the background so remembered ground reads as out of date.
+func staleFogShade(clr rl.Color) rl.Color {
+	grey := (int(clr.R) + int(clr.G) + int(clr.B)) / 3
+	mix := func(c, bg uint8) uint8 {
+		toned := (int(c) + grey) / 2
+		return uint8(clampInt((toned*45+int(bg)*55)/100, 0, 255))
+	}
+	return rl.NewColor(mix(clr.R, colorBG.R), mix(clr.G, colorBG.G), mix(clr.B, colorBG.B), clr.A)
+}
+
 func shadeByElevation(clr rl.Color, elevation int8) rl.Color {
 	f := 1.0 + float64(elevation)/230.0
 	if f < 0.55 {
@@ -293,13 +304,17 @@ func (ui *gameUI) drawTopologyRegion(area rl.Rectangle, startX, startY, cols, ro
 					255,
 				)
 			}
-			if ui.run.Config.Mode == game.ModeAlone && !ui.run.IsRevealed(worldX, worldY) {
+			fog := ui.run.FogLevelAt(worldX, worldY)
+			if fog == game.FogUnseen {
 				clr = colorBG
 			} else if cell.Flags&game.TopoFlagWater == 0 {
 				clr = terrainReliefShade(topology, worldXf, worldYf, clr)
 			} else {
 				clr = shadeByElevation(clr, cell.Elevation)
 			}
+			if fog == game.FogRemembered {
+				clr = staleFogShade(clr)
+			}
 			x0 := int32(geo.OriginX + float32(x)*geo.CellSize)
 			y0 := int32(geo.OriginY + float32(y)*geo.CellSize)
 			x1 := int32(geo.OriginX + float32(x+1)*geo.CellSize)
@@ -316,7 +331,7 @@ func (ui *gameUI) drawTopologyRegion(area rl.Rectangle, startX, startY, cols, ro
 		for x := 0; x < cols; x++ {
 			worldX := startX + x
 			cell := topoCellClamp(topology, worldX, worldY)
-			if ui.run.Config.Mode == game.ModeAlone && !ui.run.IsRevealed(worldX, worldY) {
+			if !ui.run.IsRevealed(worldX, worldY) {
 				continue
 			}
 			thisBand := topoContourBand(cell.Elevation)
@@ -324,7 +339,7 @@ func (ui *gameUI) drawTopologyRegion(area rl.Rectangle, startX, startY, cols, ro
 			lineY := geo.OriginY + float32(y*detail)*geo.CellSize
 			if x+1 < cols {
 				rightX := worldX + 1
-				if ui.run.Config.Mode != game.ModeAlone || ui.run.IsRevealed(rightX, worldY) {
+				if ui.run.IsRevealed(rightX, worldY) {
 					rightBand := topoContourBand(topoCellClamp(topology, rightX, worldY).Elevation)
 					if rightBand != thisBand {
 						rl.DrawLineEx(
@@ -338,7 +353,7 @@ func (ui *gameUI) drawTopologyRegion(area rl.Rectangle, startX, startY, cols, ro
 			}
 			if y+1 < rows {
 				downY := worldY + 1
-				if ui.run.Config.Mode != game.ModeAlone || ui.run.IsRevealed(worldX, downY) {
+				if ui.run.IsRevealed(worldX, downY) {
 					downBand := topoContourBand(topoCellClamp(topology, worldX, downY).Elevation)
 					if downBand != thisBand {
 						hy := geo.OriginY + float32((y+1)*detail)*geo.CellSize
@@ -357,11 +372,40 @@ func (ui *gameUI) drawTopologyRegion(area rl.Rectangle, startX, startY, cols, ro
 
 	rl.DrawRectangleLinesEx(geo.DrawRect, 1.0, rl.Fade(colorBorder, 0.8))
 
-	px, py := ui.run.CurrentMapPosition()
-	if px >= startX && px < startX+cols && py >= startY && py < startY+rows {
+	cellStep := geo.CellSize * float32(detail)
+	for _, poi := range ui.run.KnownPointsOfInterest() {
+		if poi.X < startX || poi.X >= startX+cols || poi.Y < startY || poi.Y >= startY+rows {
+			continue
+		}
+		radius := max(float32(2), cellStep*0.22)
+		cx := geo.OriginX + (float32(poi.X-startX)+0.5)*cellStep
+		cy := geo.OriginY + (float32(poi.Y-startY)+0.5)*cellStep
+		rl.DrawPoly(rl.NewVector2(cx, cy), 4, radius, 0, colorWarn)
+	}
+	for _, site := range ui.run.CampSites() {
+		if site.X < startX || site.X >= startX+cols || site.Y < startY || site.Y >= startY+rows {
+			continue
+		}
+		side := max(float32(4), cellStep*0.6)
+		cx := geo.OriginX + (float32(site.X-startX)+0.5)*cellStep
+		cy := geo.OriginY + (float32(site.Y-startY)+0.5)*cellStep
+		clr := colorMuted
+		if site.Active {
+			clr = colorAccent
+		}
+		rl.DrawRectangleV(rl.NewVector2(cx-side/2, cy-side/2), rl.NewVector2(side, side), clr)
+	}
+
+	// Draw the lead last so it stays on top when the team shares a cell.
+	markers := ui.run.PlayerMarkers()
+	for i := len(markers) - 1; i >= 0; i-- {
+		marker := markers[i]
+		px, py := marker.X, marker.Y
+		if px < startX || px >= startX+cols || py < startY || py >= startY+rows {
+			continue
+		}
 		localX := px - startX
 		localY := py - startY
-		cellStep := geo.CellSize * float32(detail)
 		cx := geo.OriginX + (float32(localX)+0.5)*cellStep
 		cy := geo.OriginY + (float32(localY)+0.5)*cellStep
 		r := float32(3)
@@ -371,7 +415,11 @@ func (ui *gameUI) drawTopologyRegion(area rl.Rectangle, startX, startY, cols, ro
 		if r < 2 {
 			r = 2
 		}
-		rl.DrawCircle(int32(cx), int32(cy), r, colorDanger)
+		clr := colorDanger
+		if !marker.Lead {
+			clr = colorWarn
+		}
+		rl.DrawCircle(int32(cx), int32(cy), r, clr)
 	}
 }
 
@@ -411,6 +459,11 @@ func (ui *gameUI) drawTopologyMap(rect rl.Rectangle, withLegend bool) {
 			{Label: "Water/River", Color: rl.NewColor(84, 107, 124, 255)},
 			{Label: "Ice (frozen)", Color: rl.NewColor(143, 150, 157, 255)},
 			{Label: "Player", Color: colorDanger},
+			{Label: "Camp", Color: colorAccent},
+			{Label: "Old camp", Color: colorMuted},
+			{Label: "Point of interest", Color: colorWarn},
+			{Label: "Remembered", Color: staleFogShade(topoBiomeColor(game.TopoBiomeForest))},
+			{Label: "Unexplored", Color: colorBG},
 		}
 		for _, row := range legendRows {
 			rl.DrawRectangle(legendX, legendY+2, 14, 14, row.Color)
@@ -418,9 +471,9 @@ func (ui *gameUI) drawTopologyMap(rect rl.Rectangle, withLegend bool) {
 			drawText(row.Label, legendX+20, legendY, typeScale.Small, colorText)
 			legendY += 20
 		}
-		modeLine := "Fog: off"
-		if ui.run.Config.Mode == game.ModeAlone {
-			modeLine = "Fog: on (permanent reveal)"
+		modeLine := "Fog: on"
+		if lead := ui.leadPlayerID(); lead > 0 {
+			modeLine = fmt.Sprintf("Fog: sight %d cells", ui.run.SightRadius(lead))
 		}
 		legendY += 8
 		drawText(modeLine, legendX, legendY, typeScale.Small-1, colorMuted)
@@ -452,7 +505,10 @@ func (ui *gameUI) drawMiniMap(rect rl.Rectangle, withLegend bool) {
 		legend := fmt.Sprintf("View %dx%d around player", cols, rows)
 		drawText(legend, int32(rect.X+spaceM), int32(rect.Y+spaceS+18), typeScale.Small-1, colorMuted)
 	}
-	footer := fmt.Sprintf("Cell (%d,%d) | %.1fkm moved", posX, posY, ui.run.Travel.TotalKm)
+	footer := fmt.Sprintf("Cell (%d,%d) | %.1fkm moved", posX, posY, ui.leadTravelKm())
+	if away := len(ui.run.PlayerMarkers()) - len(ui.run.PlayersAt(posX, posY)); away > 0 {
+		footer += fmt.Sprintf(" | %d away", away)
+	}
 	drawText(footer, int32(rect.X+spaceM), int32(rect.Y+rect.Height)-18, typeScale.Small, colorMuted)
 	if strings.EqualFold(strings.TrimSpace(os.Getenv("SURVIVE_IT_DEBUG_WORLD")), "1") {
 		debugLine := ui.run.CoherenceDebugLine()
@@ -482,3 +538,24 @@ func (ui *gameUI) drawRunMap() {
 	ui.drawTopologyMap(panel, true)
 	DrawHintText("Shift+M or Esc to return", int32(panel.X+spaceM), int32(panel.Y+panel.Height)-24)
 }
+
+// leadPlayerID is the player the map centres on, or 0 before anyone is placed.
+func (ui *gameUI) leadPlayerID() int {
+	for _, marker := range ui.run.PlayerMarkers() {
+		if marker.Lead {
+			return marker.PlayerID
+		}
+	}
+	return 0
+}
+
+// leadTravelKm is how far the lead player has walked this run.
+func (ui *gameUI) leadTravelKm() float64 {
+	lead := ui.leadPlayerID()
+	for _, player := range ui.run.Players {
+		if player.ID == lead {
+			return player.Travel.TotalKm
+		}
+	}
+	return 0
+}
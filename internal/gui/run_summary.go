@@ -0,0 +1,110 @@
+package gui
+
+import (
+	"fmt"
+	"strings"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"github.com/appengine-ltd/survive-it/internal/game"
+)
+
+// checkRunOutcome moves to the summary screen once the run is completed, the party is rescued or nobody is left in it.
+func (ui *gameUI) checkRunOutcome() {
+	if ui.run == nil || ui.runSummary != nil {
+		return
+	}
+	outcome := ui.run.EvaluateRun()
+	if outcome.Status != game.RunOutcomeCompleted && outcome.Status != game.RunOutcomeEnded && outcome.Status != game.RunOutcomeRescued {
+		return
+	}
+	summary := ui.run.Summary()
+	ui.runSummary = &summary
+	report := ui.run.Report()
+	ui.runReport = &report
+	for _, line := range summary.Lines() {
+		ui.appendRunMessage(line)
+	}
+	ui.recordRunCareer()
+	for _, a := range ui.runAchievements {
+		ui.appendRunMessage(fmt.Sprintf("Achievement unlocked: %s - %s", a.Name, a.Description))
+	}
+	ui.pendingIntent = nil
+	ui.status = ""
+	ui.screen = screenRunSummary
+}
+
+func (ui *gameUI) updateRunSummary() {
+	if rl.IsKeyPressed(rl.KeyEnter) || rl.IsKeyPressed(rl.KeyEscape) {
+		ui.runSummary = nil
+		ui.runReport = nil
+		ui.leaveRunToMenu()
+		return
+	}
+	if ShiftPressedKey(rl.KeyE) && ui.runReport != nil {
+		paths, err := game.ExportRunReport(runReportPath(*ui.runReport), *ui.runReport)
+		if err != nil {
+			ui.status = "Report export failed: " + err.Error()
+			return
+		}
+		ui.status = "Report written to " + strings.Join(paths, ", ")
+	}
+}
+
+// runReportPath is the base name for a run's exported report; ExportRunReport adds the extensions.
+func runReportPath(report game.RunReport) string {
+	return fmt.Sprintf("survive-it-run-report-%d-day%d", report.Seed, report.Summary.Day)
+}
+
+func summaryPlayerColor(status game.ContestantStatus) rl.Color {
+	switch status {
+	case game.ContestantDeceased:
+		return colorDanger
+	case game.ContestantMedicallyExtracted, game.ContestantTappedOut:
+		return colorWarn
+	case game.ContestantRescued:
+		return colorText
+	default:
+		return colorAccent
+	}
+}
+
+func (ui *gameUI) drawRunSummary() {
+	DrawFrame(ui.width, ui.height)
+	panel := rl.NewRectangle(float32(ui.width)*0.2, 40, float32(ui.width)*0.6, float32(ui.height-80))
+	drawPanel(panel, "Run Summary")
+	if ui.runSummary == nil {
+		return
+	}
+	summary := *ui.runSummary
+	lines := summary.Lines()
+	y := int32(60)
+	drawWrappedText(lines[0], panel, y, 26, colorText)
+	y += 44
+	drawText(lines[1], int32(panel.X+spaceM), int32(panel.Y)+y, 20, colorDim)
+	y += 40
+	for i, player := range summary.Players {
+		drawText(lines[2+i], int32(panel.X+spaceM), int32(panel.Y)+y, 22, summaryPlayerColor(player.Status))
+		y += 34
+	}
+	for _, line := range lines[2+len(summary.Players):] {
+		drawText(line, int32(panel.X+spaceM), int32(panel.Y)+y, 20, colorDim)
+		y += 30
+	}
+	for _, a := range ui.runAchievements {
+		drawText(fmt.Sprintf("Achievement unlocked: %s - %s", a.Name, a.Description), int32(panel.X+spaceM), int32(panel.Y)+y, 20, colorAccent)
+		y += 30
+	}
+	if ui.runReport != nil {
+		y += 10
+		for _, line := range ui.runReport.Lines() {
+			drawText(line, int32(panel.X+spaceM), int32(panel.Y)+y, 20, colorText)
+			y += 28
+		}
+	}
+	if ui.status != "" {
+		drawWrappedText(ui.status, panel, int32(panel.Height)-100, 18, colorAccent)
+	}
+	drawText(fmt.Sprintf("Mode: %s", modeLabel(summary.Mode)), int32(panel.X+spaceM), int32(panel.Y+panel.Height)-70, 20, colorMuted)
+	drawText("Shift+E export report (JSON + CSV) | Enter or Esc to return to the menu", int32(panel.X+spaceM), int32(panel.Y+panel.Height)-40, 20, colorDim)
+}
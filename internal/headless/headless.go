@@ -0,0 +1,512 @@
+package headless
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/appengine-ltd/survive-it/internal/game"
+	"github.com/appengine-ltd/survive-it/internal/parser"
+	"github.com/appengine-ltd/survive-it/internal/savegame"
+)
+
+// Discovery summary:
+// - The GUI owns parsing (parser.Parser) and dispatch (RunState.ExecuteRunCommand); neither needs Raylib.
+// - This package mirrors gui.executeIntent for a line-oriented reader/writer so runs work over SSH and in CI.
+// - Saves share the GUI's per-user slots via internal/savegame; realtime metabolism stays GUI-only, so time
+//   here only moves through commands and `next`.
+
+type Config struct {
+	Mode       game.GameMode
+	ScenarioID game.ScenarioID
+	Seed       int64
+	Players    int
+	Days       int
+	Kit        []game.KitItem
+	Echo       bool
+}
+
+type Runner struct {
+	run        *game.RunState
+	journal    *game.RunJournal
+	cmdParser  *parser.Parser
+	out        io.Writer
+	echo       bool
+	lastEntity string
+	pending    *parser.PendingIntent
+	finished   bool
+	slot       string
+	started    time.Time
+	playtime   time.Duration
+}
+
+func ParseMode(raw string) (game.GameMode, error) {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "", "alone", "isolation", string(game.ModeAlone):
+		return game.ModeAlone, nil
+	case "naked", "paired", "naa", string(game.ModeNakedAndAfraid):
+		return game.ModeNakedAndAfraid, nil
+	case "xl", "expedition", "naaxl", string(game.ModeNakedAndAfraidXL):
+		return game.ModeNakedAndAfraidXL, nil
+	default:
+		return "", fmt.Errorf("unknown mode: %s", raw)
+	}
+}
+
+// ParseKit accepts a comma-separated list of kit item names, matched case-insensitively.
+func ParseKit(raw string) ([]game.KitItem, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+	all := game.AllKitItems()
+	out := make([]game.KitItem, 0, 4)
+	for _, part := range strings.Split(raw, ",") {
+		name := strings.TrimSpace(part)
+		if name == "" {
+			continue
+		}
+		found := false
+		for _, item := range all {
+			if strings.EqualFold(string(item), name) {
+				out = append(out, item)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown kit item: %s", name)
+		}
+	}
+	return out, nil
+}
+
+func NewRunner(cfg Config, out io.Writer) (*Runner, error) {
+	if cfg.Players <= 0 {
+		cfg.Players = 1
+	}
+	if cfg.ScenarioID == "" {
+		cfg.ScenarioID = game.ScenarioRandomID
+	}
+	if cfg.Seed == 0 {
+		cfg.Seed = time.Now().UnixNano()
+	}
+	length := game.RunLength{Days: cfg.Days}
+	if cfg.Days <= 0 {
+		length = game.RunLength{OpenEnded: true}
+	}
+	run, err := game.NewRunState(game.RunConfig{
+		Mode:        cfg.Mode,
+		ScenarioID:  cfg.ScenarioID,
+		PlayerCount: cfg.Players,
+		IssuedKit:   append([]game.KitItem(nil), cfg.Kit...),
+		RunLength:   length,
+		Seed:        cfg.Seed,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Runner{
+		run:       &run,
+		journal:   game.NewRunJournal(&run),
+		cmdParser: parser.New(),
+		out:       out,
+		echo:      cfg.Echo,
+		started:   time.Now(),
+	}, nil
+}
+
+func (r *Runner) State() *game.RunState {
+	return r.run
+}
+
+// Journal returns the replay journal recorded since the runner started.
+func (r *Runner) Journal() *game.RunJournal {
+	return r.journal
+}
+
+func (r *Runner) Finished() bool {
+	return r.finished
+}
+
+func (r *Runner) printf(format string, args ...any) {
+	fmt.Fprintf(r.out, format+"\n", args...)
+}
+
+func (r *Runner) Intro() {
+	r.printf("Run started | Mode: %s | Scenario: %s | Players: %d | Seed: %d",
+		r.run.Config.Mode, r.run.Scenario.Name, len(r.run.Players), r.run.Config.Seed)
+	r.printf("Type 'help' for commands, 'quit' to stop.")
+	r.printf("%s", r.StatusLine())
+}
+
+// Run feeds every line from in through Execute until EOF, quit, or the run ends.
+func (r *Runner) Run(in io.Reader) error {
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		if !r.Execute(scanner.Text()) {
+			return nil
+		}
+	}
+	return scanner.Err()
+}
+
+// Execute handles a single input line and reports whether the runner should keep reading.
+func (r *Runner) Execute(line string) bool {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return !r.finished
+	}
+	if r.echo {
+		r.printf("> %s", line)
+	}
+	switch strings.ToLower(line) {
+	case "quit", "menu":
+		r.finished = true
+		return false
+	case "status":
+		r.printf("%s", r.StatusLine())
+		return true
+	}
+
+	if r.pending != nil {
+		if intent, ok := r.resolvePending(line); ok {
+			r.executeIntent(intent)
+			return !r.finished
+		}
+	}
+
+	intent := r.cmdParser.Parse(r.parseContext(), line)
+	if intent.Clarify != nil && intent.Kind != parser.Unknown {
+		r.setPending(parser.PendingIntent{
+			OriginalKind: intent.Kind,
+			OriginalVerb: intent.Verb,
+			FilledArgs:   append([]string(nil), intent.Args...),
+			Prompt:       intent.Clarify.Prompt,
+			Options:      append([]parser.Intent(nil), intent.Clarify.Options...),
+		})
+		return true
+	}
+	if intent.Kind == parser.Unknown {
+		// Scripts often use strict command syntax the parser does not model; try it verbatim first.
+		if res := r.journal.ExecuteRunCommand(r.run, line); res.Handled {
+			r.report(res, r.run.Day, r.run.ClockHours)
+			return !r.finished
+		}
+		if intent.Clarify != nil {
+			r.printf("%s", intent.Clarify.Prompt)
+		} else {
+			r.printf("I couldn't match that command.")
+		}
+		return true
+	}
+	r.executeIntent(intent)
+	return !r.finished
+}
+
+func (r *Runner) setPending(pending parser.PendingIntent) {
+	r.pending = &pending
+	r.printf("%s", pending.Prompt)
+	for i, option := range pending.Options {
+		r.printf("  %d) %s", i+1, parser.IntentToCommandString(option))
+	}
+}
+
+func (r *Runner) resolvePending(answer string) (parser.Intent, bool) {
+	pending := r.pending
+	r.pending = nil
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	if pending.OriginalIntent != nil {
+		if answer == "y" || answer == "yes" {
+			intent := *pending.OriginalIntent
+			intent.ConfirmedRisk = true
+			return intent, true
+		}
+		r.printf("Cancelled.")
+		return parser.Intent{}, false
+	}
+	if n, err := strconv.Atoi(answer); err == nil && n >= 1 && n <= len(pending.Options) {
+		return pending.Options[n-1], true
+	}
+	if answer == "cancel" {
+		r.printf("Cancelled.")
+	}
+	return parser.Intent{}, false
+}
+
+func (r *Runner) executeIntent(intent parser.Intent) {
+	command := parser.IntentToCommandString(intent)
+	if command == "" {
+		r.printf("No action to run.")
+		return
+	}
+	verb := strings.ToLower(strings.TrimSpace(intent.Verb))
+	prevDay := r.run.Day
+	prevClock := r.run.ClockHours
+
+	switch verb {
+	case "next":
+		r.journal.AdvanceDay(r.run)
+		for _, report := range r.run.DrainReports() {
+			r.printf("%s", report)
+		}
+		r.afterTimeAdvance(prevDay)
+		r.printf("%s", r.StatusLine())
+		return
+	case "save":
+		r.save(savegame.SlotArg(strings.Fields(command)))
+		return
+	case "load":
+		r.load(savegame.SlotArg(strings.Fields(command)))
+		return
+	}
+
+	if verb == "go" && !intent.ConfirmedRisk && len(r.run.Players) > 0 {
+		party := map[int]bool{}
+		for _, arg := range intent.Args {
+			if strings.HasPrefix(arg, "p") {
+				if id, err := strconv.Atoi(strings.TrimPrefix(arg, "p")); err == nil {
+					party[id] = true
+				}
+			}
+		}
+		if len(party) == 0 {
+			party[1] = true
+		}
+		for i := range r.run.Players {
+			if !party[r.run.Players[i].ID] {
+				continue
+			}
+			_, tier := game.CalculateMovementRisk(&r.run.Players[i], r.run.Weather, r.run.ClockHours)
+			if tier >= game.RiskHigh {
+				intentCopy := intent
+				r.setPending(parser.PendingIntent{
+					OriginalKind:   intent.Kind,
+					OriginalVerb:   "go",
+					OriginalIntent: &intentCopy,
+					Prompt:         fmt.Sprintf("Warning: Travel risk is %s. Continue? (yes/no)", tier.String()),
+				})
+				return
+			}
+			break
+		}
+	}
+
+	res := r.journal.ExecuteRunCommand(r.run, command)
+	if !res.Handled {
+		r.printf("I couldn't match that command.")
+		return
+	}
+	r.report(res, prevDay, prevClock)
+	if len(intent.Args) > 0 {
+		r.lastEntity = strings.ToLower(strings.TrimSpace(intent.Args[0]))
+	}
+}
+
+func (r *Runner) save(name string) {
+	dir, err := savegame.SaveDir()
+	if err != nil {
+		r.printf("Save failed: %v", err)
+		return
+	}
+	slot := r.slot
+	if name != "" {
+		if slot, err = savegame.SlotName(name); err != nil {
+			r.printf("Save failed: %v", err)
+			return
+		}
+	}
+	if slot == "" {
+		slot = savegame.NextFreeSlot(dir)
+	}
+	path, err := savegame.Save(dir, savegame.Request{
+		Slot:     slot,
+		Run:      r.run,
+		Journal:  r.journal,
+		Playtime: r.playtime + time.Since(r.started),
+	})
+	if err != nil {
+		r.printf("Save failed: %v", err)
+		return
+	}
+	r.slot = slot
+	r.printf("Saved %s to %s", slot, path)
+}
+
+// load with no name lists the saves; otherwise it replaces the current run with the named slot.
+func (r *Runner) load(name string) {
+	dir, err := savegame.SaveDir()
+	if err != nil {
+		r.printf("Load failed: %v", err)
+		return
+	}
+	if name == "" {
+		entries, err := savegame.List(dir)
+		if err != nil {
+			r.printf("Load failed: %v", err)
+			return
+		}
+		if len(entries) == 0 {
+			r.printf("No saves in %s.", dir)
+			return
+		}
+		for _, entry := range entries {
+			if entry.Err != nil {
+				r.printf("  %s (unreadable: %v)", entry.Path, entry.Err)
+				continue
+			}
+			meta := entry.File.Meta
+			r.printf("  %s | %s | Day %d | %d players | %s | %s", meta.Slot, meta.ScenarioName, meta.Day,
+				len(meta.Players), savegame.FormatPlaytime(meta.Playtime), entry.File.SavedAt.Local().Format("2006-01-02 15:04"))
+		}
+		return
+	}
+	slot, err := savegame.SlotName(name)
+	if err != nil {
+		r.printf("Load failed: %v", err)
+		return
+	}
+	path := savegame.PathForSlot(dir, slot)
+	file, err := savegame.Load(path)
+	if err != nil {
+		r.printf("Load failed: %v", err)
+		return
+	}
+	run := file.Run
+	r.run = &run
+	r.journal = savegame.LoadJournal(path, r.run)
+	r.slot = slot
+	if slot == savegame.AutosaveSlot {
+		r.slot = ""
+	}
+	r.playtime = file.Meta.Playtime
+	r.started = time.Now()
+	r.pending = nil
+	r.lastEntity = ""
+	r.printf("Loaded %s | %s | Day %d", slot, file.Meta.ScenarioName, r.run.Day)
+	for _, problem := range game.ContentPackMismatch(file.Meta.ContentPacks) {
+		r.printf("Warning: %s.", problem)
+	}
+	r.printf("%s", r.StatusLine())
+}
+
+func (r *Runner) report(res game.RunCommandResult, prevDay int, prevClock float64) {
+	if res.Message != "" {
+		r.printf("%s", res.Message)
+	}
+	if res.HoursAdvanced > 0 {
+		r.printf("Time spent: +%.1f hours | %s -> %s", res.HoursAdvanced, formatClock(prevClock), formatClock(r.run.ClockHours))
+	}
+	r.afterTimeAdvance(prevDay)
+	r.printf("%s", r.StatusLine())
+	r.promptEncounter()
+}
+
+// promptEncounter offers the open predator confrontation as numbered choices.
+func (r *Runner) promptEncounter() {
+	if r.run.Encounter == nil || r.pending != nil || r.finished {
+		return
+	}
+	r.setPending(parser.PendingIntent{
+		OriginalKind: parser.Command,
+		OriginalVerb: "encounter",
+		Prompt:       fmt.Sprintf("Choose how to face the %s:", strings.ToLower(r.run.Encounter.Species)),
+		Options:      encounterIntents(r.run),
+	})
+}
+
+func encounterIntents(run *game.RunState) []parser.Intent {
+	options := run.EncounterOptions()
+	intents := make([]parser.Intent, 0, len(options))
+	for _, option := range options {
+		intents = append(intents, parser.Intent{Kind: parser.Command, Verb: "encounter", Args: []string{string(option.Choice)}})
+	}
+	return intents
+}
+
+func (r *Runner) afterTimeAdvance(prevDay int) {
+	if r.run.Day != prevDay {
+		r.printf("Day %d started | Weather: %s | Temp: %dC", r.run.Day, game.WeatherLabel(r.run.Weather.Type), r.run.Weather.TemperatureC)
+	}
+	for day := prevDay; day < r.run.Day; day++ {
+		for _, event := range r.journal.ProcessContestantSimulation(r.run, game.ContestantDayTick) {
+			r.printf("%s", event)
+		}
+	}
+	outcome := r.run.EvaluateRun()
+	switch outcome.Status {
+	case game.RunOutcomeCompleted, game.RunOutcomeEnded, game.RunOutcomeRescued:
+		r.printf("=== Run summary ===")
+		for _, line := range r.run.Summary().Lines() {
+			r.printf("%s", line)
+		}
+		for _, line := range r.run.Report().Lines() {
+			r.printf("%s", line)
+		}
+		r.finished = true
+	case game.RunOutcomeCritical:
+		r.printf("%s", outcome.Message)
+	}
+}
+
+// StatusLine is the one-line summary printed after every command.
+func (r *Runner) StatusLine() string {
+	parts := make([]string, 0, len(r.run.Players)+1)
+	parts = append(parts, fmt.Sprintf("Day %d %s | %s %dC",
+		r.run.Day, formatClock(r.run.ClockHours), game.WeatherLabel(r.run.Weather.Type), r.run.Weather.TemperatureC))
+	for _, p := range r.run.Players {
+		if !p.Active() {
+			parts = append(parts, fmt.Sprintf("P%d out (%s)", p.ID, game.StatusLabel(p.Status)))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("P%d E%d H2O%d M%d hun%d thi%d fat%d %s",
+			p.ID, p.Energy, p.Hydration, p.Morale, p.Hunger, p.Thirst, p.Fatigue, game.ThermalLabel(p)))
+	}
+	return "[" + strings.Join(parts, " | ") + "]"
+}
+
+func (r *Runner) parseContext() parser.ParseContext {
+	ctx := parser.ParseContext{
+		KnownDirections: []string{"north", "south", "east", "west", "n", "s", "e", "w"},
+		LastEntity:      r.lastEntity,
+	}
+	seen := map[string]bool{}
+	addInv := func(v string) {
+		v = strings.TrimSpace(strings.ToLower(v))
+		if v == "" || seen[v] {
+			return
+		}
+		seen[v] = true
+		ctx.Inventory = append(ctx.Inventory, v)
+	}
+	if len(r.run.Players) > 0 {
+		for _, item := range r.run.Players[0].PersonalItems {
+			addInv(item.ID)
+		}
+		for _, item := range r.run.Players[0].Kit {
+			addInv(string(item))
+		}
+	}
+	for _, item := range r.run.Config.IssuedKit {
+		addInv(string(item))
+	}
+	for _, item := range r.run.CampInventory {
+		addInv(item.ID)
+	}
+	for _, resource := range game.ResourcesForBiome(r.run.Scenario.Biome) {
+		ctx.Nearby = append(ctx.Nearby, resource.ID)
+	}
+	for _, tree := range game.TreesForBiome(r.run.Scenario.Biome) {
+		ctx.Nearby = append(ctx.Nearby, tree.ID)
+	}
+	return ctx
+}
+
+func formatClock(hours float64) string {
+	total := int(hours*60 + 0.5)
+	total = ((total % 1440) + 1440) % 1440
+	return fmt.Sprintf("%02d:%02d", total/60, total%60)
+}
@@ -0,0 +1,116 @@
+package headless
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/appengine-ltd/survive-it/internal/game"
+	"github.com/appengine-ltd/survive-it/internal/savegame"
+)
+
+func newTestRunner(t *testing.T, out *bytes.Buffer) *Runner {
+	t.Helper()
+	runner, err := NewRunner(Config{
+		Mode:       game.ModeNakedAndAfraid,
+		ScenarioID: game.ScenarioVancouverIslandID,
+		Seed:       4242,
+		Players:    2,
+		Days:       10,
+	}, out)
+	if err != nil {
+		t.Fatalf("new runner: %v", err)
+	}
+	return runner
+}
+
+func TestRunnerExecutesScriptAndPrintsStatus(t *testing.T) {
+	var out bytes.Buffer
+	runner := newTestRunner(t, &out)
+
+	script := "# comment lines are skipped\nforage berries\nwood gather 1\nnext\nquit\nlook\n"
+	if err := runner.Run(strings.NewReader(script)); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	text := out.String()
+	if !strings.Contains(text, "foraged") {
+		t.Fatalf("expected forage output, got:\n%s", text)
+	}
+	if !strings.Contains(text, "Day 2 started") {
+		t.Fatalf("expected next to advance the day, got:\n%s", text)
+	}
+	if strings.Count(text, "[Day ") < 3 {
+		t.Fatalf("expected a status line after each command, got:\n%s", text)
+	}
+	if strings.Contains(text, "Looking") {
+		t.Fatalf("expected quit to stop reading further commands")
+	}
+	if !runner.Finished() {
+		t.Fatalf("expected runner to be finished after quit")
+	}
+}
+
+func TestNextMovesCoreTemperatureWithTheWeather(t *testing.T) {
+	var out bytes.Buffer
+	runner := newTestRunner(t, &out)
+	if err := runner.Run(strings.NewReader("next\nnext\n")); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	for _, p := range runner.State().Players {
+		if core := p.CoreTemperature(); core > 36.9 && core < 37.1 {
+			t.Fatalf("expected two skipped days out in the weather to move P%d's core, still %.1fC", p.ID, core)
+		}
+	}
+}
+
+func TestRunnerIsDeterministicForSeed(t *testing.T) {
+	script := "forage any\nhunt land\nfire status\nsleep\nnext\n"
+	var first, second bytes.Buffer
+	if err := newTestRunner(t, &first).Run(strings.NewReader(script)); err != nil {
+		t.Fatalf("first run: %v", err)
+	}
+	if err := newTestRunner(t, &second).Run(strings.NewReader(script)); err != nil {
+		t.Fatalf("second run: %v", err)
+	}
+	if first.String() != second.String() {
+		t.Fatalf("expected identical output for identical seed and script")
+	}
+}
+
+func TestParseModeAndKit(t *testing.T) {
+	mode, err := ParseMode("xl")
+	if err != nil || mode != game.ModeNakedAndAfraidXL {
+		t.Fatalf("expected xl mode, got %q (%v)", mode, err)
+	}
+	if _, err := ParseMode("arena"); err == nil {
+		t.Fatalf("expected unknown mode error")
+	}
+	kit, err := ParseKit("canteen, Ferro Rod")
+	if err != nil || len(kit) != 2 || kit[0] != game.KitCanteen || kit[1] != game.KitFerroRod {
+		t.Fatalf("unexpected kit parse: %v (%v)", kit, err)
+	}
+}
+
+func TestRunnerSavesAndLoadsSlots(t *testing.T) {
+	t.Setenv(savegame.DataDirEnv, t.TempDir())
+	var out bytes.Buffer
+	runner := newTestRunner(t, &out)
+
+	script := "save base camp\nnext\nnext\nload base camp\nload\n"
+	if err := runner.Run(strings.NewReader(script)); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	text := out.String()
+	if !strings.Contains(text, "Saved base-camp") {
+		t.Fatalf("expected save confirmation, got:\n%s", text)
+	}
+	if runner.State().Day != 1 {
+		t.Fatalf("expected load to restore day 1, got day %d", runner.State().Day)
+	}
+	if runner.Journal() == nil {
+		t.Fatalf("expected the saved journal to resume after load")
+	}
+	if !strings.Contains(text, "base-camp | ") {
+		t.Fatalf("expected load listing to show the slot, got:\n%s", text)
+	}
+}
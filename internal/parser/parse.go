@@ -21,6 +21,16 @@ func (p *Parser) RegisterCommand(c CommandDef) {
 	p.registry.RegisterCommand(c)
 }
 
+// Verbs lists the canonical command verbs, sorted, e.g. for prompting a model fallback.
+func (p *Parser) Verbs() []string {
+	verbs := make([]string, 0, len(p.registry.commands))
+	for canonical := range p.registry.commands {
+		verbs = append(verbs, canonical)
+	}
+	sort.Strings(verbs)
+	return verbs
+}
+
 func (p *Parser) Parse(ctx ParseContext, raw string) Intent {
 	intent := Intent{
 		Raw:        raw,
@@ -260,7 +270,7 @@ func expectsEntity(verb string, argPos int) bool {
 		return false
 	}
 	switch verb {
-	case "take", "drop", "use", "inspect", "craft", "eat", "drink":
+	case "take", "drop", "use", "inspect", "craft", "eat", "drink", "sip":
 		return true
 	default:
 		return false
@@ -302,7 +312,7 @@ func resolveEntity(token string, ctx ParseContext, verb string) ([]string, float
 
 func invForVerb(verb string, inv []string) []string {
 	switch verb {
-	case "drop", "use", "eat", "drink":
+	case "drop", "use", "eat", "drink", "sip":
 		return inv
 	default:
 		return nil
@@ -331,13 +341,20 @@ func extractMovementScale(tokens []string) (*MovementScale, []string, *ClarifyQu
 
 	for i := 0; i < len(tokens); i++ {
 		// First try parseScaleSuffix which handles both ["500m"] and ["2", "hours"]
-		if dist, ok, _ := parseScaleSuffix(tokens[i:]); ok {
+		if dist, ok, consumed := parseScaleSuffix(tokens[i:]); ok {
 			// Strip preceeding "for" if there is one e.g "for 2 hours"
 			endIndex := i
 			if i > 0 && strings.ToLower(strings.TrimSpace(tokens[i-1])) == "for" {
 				endIndex = i - 1
 			}
-			return dist, tokens[:endIndex], nil
+			// Keep the party named after the distance, e.g. "go north 2km p2 p3".
+			rest := append([]string(nil), tokens[:endIndex]...)
+			for _, token := range tokens[i+consumed:] {
+				if isPlayerToken(token) {
+					rest = append(rest, token)
+				}
+			}
+			return dist, rest, nil
 		}
 	}
 
@@ -352,6 +369,16 @@ func extractMovementScale(tokens []string) (*MovementScale, []string, *ClarifyQu
 	return nil, tokens, nil
 }
 
+// isPlayerToken matches the p1, p2, ... tokens that pick which player acts.
+func isPlayerToken(token string) bool {
+	token = strings.ToLower(strings.TrimSpace(token))
+	if len(token) < 2 || token[0] != 'p' {
+		return false
+	}
+	n, err := strconv.Atoi(token[1:])
+	return err == nil && n > 0
+}
+
 func parseScaleSuffix(tokens []string) (*MovementScale, bool, int) {
 	if len(tokens) == 0 {
 		return nil, false, 0
@@ -546,7 +573,13 @@ func inferFreeTextIntent(ctx ParseContext, raw string, normalised string) *Inten
 	if containsWord(n, "drink") {
 		return makeIntent(Command, "drink", nil, 0.78)
 	}
-	if containsWord(n, "sleep") || containsWord(n, "rest") {
+	if containsWord(n, "nap") {
+		return makeIntent(Command, "nap", nil, 0.8)
+	}
+	if containsWord(n, "rest") {
+		return makeIntent(Command, "rest", nil, 0.8)
+	}
+	if containsWord(n, "sleep") {
 		return makeIntent(Command, "sleep", nil, 0.8)
 	}
 
@@ -30,6 +30,13 @@ func TestAliasInvMapsToInventory(t *testing.T) {
 	}
 }
 
+func TestSipKeepsItsOwnVerb(t *testing.T) {
+	p := New()
+	if got := IntentToCommandString(p.Parse(ParseContext{}, "sip")); got != "sip" {
+		t.Fatalf("expected sip to reach the game as sip, got %q", got)
+	}
+}
+
 func TestTypoInventryMapsToInventory(t *testing.T) {
 	p := New()
 	intent := p.Parse(ParseContext{}, "inventry")
@@ -155,6 +162,16 @@ func TestMovementScaleParsing(t *testing.T) {
 	}
 }
 
+func TestMovementKeepsTheParty(t *testing.T) {
+	intent := New().Parse(ParseContext{}, "go north 2km p2 p3")
+	if intent.Clarify != nil {
+		t.Fatalf("unexpected clarify: %s", intent.Clarify.Prompt)
+	}
+	if got := IntentToCommandString(intent); got != "go north p2 p3 2000.000000m" {
+		t.Fatalf("expected the party to survive parsing, got %q", got)
+	}
+}
+
 func TestMovementAmbiguity(t *testing.T) {
 	p := New()
 
@@ -177,3 +194,19 @@ func TestMovementAmbiguity(t *testing.T) {
 		}
 	}
 }
+
+func TestVerbsListsCanonicalCommands(t *testing.T) {
+	verbs := New().Verbs()
+	found := map[string]bool{}
+	for i, verb := range verbs {
+		if i > 0 && verbs[i-1] > verb {
+			t.Fatalf("expected sorted verbs, got %q before %q", verbs[i-1], verb)
+		}
+		found[verb] = true
+	}
+	for _, want := range []string{"ask", "forage", "inventory"} {
+		if !found[want] {
+			t.Fatalf("expected %q in verbs %v", want, verbs)
+		}
+	}
+}
@@ -192,9 +192,12 @@ func DefaultRegistry() *Registry {
 		{Canonical: "use", Aliases: []string{"apply"}, MinArgs: 1, MaxArgs: 8, HandlerKey: "use"},
 		{Canonical: "craft", Aliases: []string{"make", "build"}, MinArgs: 1, MaxArgs: 8, HandlerKey: "craft"},
 		{Canonical: "eat", Aliases: []string{"consume"}, MinArgs: 0, MaxArgs: 6, HandlerKey: "eat"},
-		{Canonical: "drink", Aliases: []string{"sip"}, MinArgs: 0, MaxArgs: 6, HandlerKey: "drink"},
-		{Canonical: "sleep", Aliases: []string{"rest", "nap"}, MinArgs: 0, MaxArgs: 3, HandlerKey: "sleep"},
-		{Canonical: "go", Aliases: []string{"walk", "move", "head", "travel"}, MinArgs: 1, MaxArgs: 3, HandlerKey: "go"},
+		{Canonical: "drink", MinArgs: 0, MaxArgs: 6, HandlerKey: "drink"},
+		{Canonical: "sip", MinArgs: 0, MaxArgs: 6, HandlerKey: "drink"},
+		{Canonical: "sleep", Aliases: []string{"go to sleep", "turn in"}, MinArgs: 0, MaxArgs: 3, HandlerKey: "sleep"},
+		{Canonical: "rest", Aliases: []string{"take a break"}, MinArgs: 0, MaxArgs: 3, HandlerKey: "sleep"},
+		{Canonical: "nap", Aliases: []string{"doze"}, MinArgs: 0, MaxArgs: 3, HandlerKey: "sleep"},
+		{Canonical: "go", Aliases: []string{"walk", "move", "head", "travel"}, MinArgs: 1, MaxArgs: 9, HandlerKey: "go"},
 		{Canonical: "inspect", Aliases: []string{"examine", "check", "chk"}, MinArgs: 1, MaxArgs: 6, HandlerKey: "inspect"},
 
 		// Existing game/run commands to preserve strict-command behavior.
@@ -210,6 +213,7 @@ func DefaultRegistry() *Registry {
 		{Canonical: "wood", MinArgs: 1, MaxArgs: 5, HandlerKey: "wood"},
 		{Canonical: "resources", MinArgs: 0, MaxArgs: 0, HandlerKey: "resources"},
 		{Canonical: "collect", MinArgs: 1, MaxArgs: 4, HandlerKey: "collect"},
+		{Canonical: "water", MinArgs: 1, MaxArgs: 4, HandlerKey: "water"},
 		{Canonical: "fire", MinArgs: 1, MaxArgs: 6, HandlerKey: "fire"},
 		{Canonical: "shelter", MinArgs: 1, MaxArgs: 4, HandlerKey: "shelter"},
 		{Canonical: "trap", MinArgs: 1, MaxArgs: 4, HandlerKey: "trap"},
@@ -220,6 +224,16 @@ func DefaultRegistry() *Registry {
 		{Canonical: "plants", MinArgs: 0, MaxArgs: 0, HandlerKey: "plants"},
 		{Canonical: "actions", MinArgs: 0, MaxArgs: 2, HandlerKey: "actions"},
 		{Canonical: "ask", MinArgs: 2, MaxArgs: 8, HandlerKey: "ask"},
+		{Canonical: "tap", Aliases: []string{"tapout", "quit the show", "go home"}, MinArgs: 0, MaxArgs: 3, HandlerKey: "tap"},
+		{Canonical: "signal", Aliases: []string{"flag down", "wave down"}, MinArgs: 0, MaxArgs: 2, HandlerKey: "signal"},
+		{Canonical: "treat", Aliases: []string{"heal", "tend wounds", "doctor"}, MinArgs: 0, MaxArgs: 4, HandlerKey: "treat"},
+		{Canonical: "camp", Aliases: []string{"campsite", "base"}, MinArgs: 0, MaxArgs: 2, HandlerKey: "camp"},
+		{Canonical: "weather", Aliases: []string{"sky", "conditions"}, MinArgs: 0, MaxArgs: 0, HandlerKey: "weather"},
+		{Canonical: "forecast", Aliases: []string{"read the sky", "predict weather"}, MinArgs: 0, MaxArgs: 1, HandlerKey: "forecast"},
+		{Canonical: "skills", Aliases: []string{"skill", "abilities"}, MinArgs: 0, MaxArgs: 1, HandlerKey: "skills"},
+		{Canonical: "teach", Aliases: []string{"train", "show how", "mentor"}, MinArgs: 2, MaxArgs: 4, HandlerKey: "teach"},
+		{Canonical: "encounter", Aliases: []string{"confront", "face"}, MinArgs: 1, MaxArgs: 3, HandlerKey: "encounter"},
+		{Canonical: "producer", Aliases: []string{"report", "producers report", "who is left"}, MinArgs: 0, MaxArgs: 0, HandlerKey: "producer"},
 	}
 	for _, cmd := range commands {
 		r.RegisterCommand(cmd)
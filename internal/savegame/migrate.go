@@ -0,0 +1,146 @@
+package savegame
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// migration upgrades a decoded save document from version From to From+1 in place.
+type migration struct {
+	From  int
+	Apply func(doc map[string]json.RawMessage) error
+}
+
+// migrations must stay ordered and contiguous; add a step here whenever FormatVersion is bumped.
+var migrations = []migration{
+	{From: 1, Apply: migrateV1ToV2},
+	{From: 2, Apply: migrateV2ToV3},
+	{From: 3, Apply: migrateV3ToV4},
+}
+
+// migrate walks doc forward to FormatVersion. Files without a version predate versioning and are treated as v1.
+func migrate(doc map[string]json.RawMessage) error {
+	version := 1
+	if raw, ok := doc["format_version"]; ok {
+		if err := json.Unmarshal(raw, &version); err != nil {
+			return fmt.Errorf("parse format_version: %w", err)
+		}
+	}
+	if version < 1 {
+		version = 1
+	}
+	if version > FormatVersion {
+		return fmt.Errorf("save format %d is newer than this build supports (%d)", version, FormatVersion)
+	}
+	for _, step := range migrations {
+		if step.From != version {
+			continue
+		}
+		if err := step.Apply(doc); err != nil {
+			return fmt.Errorf("migrate save v%d: %w", step.From, err)
+		}
+		version = step.From + 1
+	}
+	if version != FormatVersion {
+		return fmt.Errorf("no migration path from save format %d", version)
+	}
+	doc["format_version"], _ = json.Marshal(FormatVersion)
+	return nil
+}
+
+// migrateV1ToV2 adds the metadata block. v1 saves had no carry data, so players resume with zero remainders;
+// scenario/day/player fields are filled from the run by fillMetadata after decoding.
+func migrateV1ToV2(doc map[string]json.RawMessage) error {
+	if _, ok := doc["run"]; !ok {
+		return fmt.Errorf("save has no run")
+	}
+	meta, err := json.Marshal(Metadata{Kind: KindManual})
+	if err != nil {
+		return err
+	}
+	doc["meta"] = meta
+	return nil
+}
+
+// migrateV2ToV3 moves the run-wide travel state onto every player, who all stood on that one cell, and seeds
+// each player's own fog bitset from the team fog mask.
+func migrateV2ToV3(doc map[string]json.RawMessage) error {
+	var run map[string]json.RawMessage
+	if err := json.Unmarshal(doc["run"], &run); err != nil {
+		return fmt.Errorf("parse run: %w", err)
+	}
+	travel, hasTravel := run["travel"]
+	if !hasTravel {
+		return nil
+	}
+	var fog []byte
+	if raw, ok := run["fog_mask"]; ok {
+		var mask []bool
+		if err := json.Unmarshal(raw, &mask); err != nil {
+			return fmt.Errorf("parse fog_mask: %w", err)
+		}
+		fog = make([]byte, (len(mask)+7)/8)
+		for i, seen := range mask {
+			if seen {
+				fog[i/8] |= 1 << (i % 8)
+			}
+		}
+	}
+	var players []map[string]json.RawMessage
+	if raw, ok := run["Players"]; ok {
+		if err := json.Unmarshal(raw, &players); err != nil {
+			return fmt.Errorf("parse players: %w", err)
+		}
+	}
+	for _, player := range players {
+		player["travel"] = travel
+		if fog != nil {
+			player["fog"], _ = json.Marshal(fog)
+		}
+	}
+	delete(run, "travel")
+	var err error
+	if run["Players"], err = json.Marshal(players); err != nil {
+		return err
+	}
+	doc["run"], err = json.Marshal(run)
+	return err
+}
+
+// migrateV3ToV4 drops the fully revealed fog that modes without fog of war used to save, so the run reloads
+// with only what each player can see from where they stand. Fog someone actually explored is kept.
+func migrateV3ToV4(doc map[string]json.RawMessage) error {
+	var run map[string]json.RawMessage
+	if err := json.Unmarshal(doc["run"], &run); err != nil {
+		return fmt.Errorf("parse run: %w", err)
+	}
+	raw, ok := run["fog_mask"]
+	if !ok {
+		return nil
+	}
+	var mask []bool
+	if err := json.Unmarshal(raw, &mask); err != nil {
+		return fmt.Errorf("parse fog_mask: %w", err)
+	}
+	for _, seen := range mask {
+		if !seen {
+			return nil
+		}
+	}
+	var players []map[string]json.RawMessage
+	if raw, ok := run["Players"]; ok {
+		if err := json.Unmarshal(raw, &players); err != nil {
+			return fmt.Errorf("parse players: %w", err)
+		}
+	}
+	for _, player := range players {
+		delete(player, "fog")
+	}
+	delete(run, "fog_mask")
+	var err error
+	if run["Players"], err = json.Marshal(players); err != nil {
+		return err
+	}
+	doc["run"], err = json.Marshal(run)
+	return err
+}
@@ -0,0 +1,338 @@
+package savegame
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/appengine-ltd/survive-it/internal/game"
+)
+
+// Discovery summary:
+// - The GUI wrote survive-it-save-1.json into the working directory and never checked format_version on load.
+// - Saves now live in named or numbered slots under a per-user data directory, carry browser metadata,
+//   and are upgraded through a migration chain before decoding instead of being skipped.
+// - Legacy working-directory saves are still listed so existing players keep their runs.
+
+// FormatVersion 2 added the metadata block and persisted player metabolism carries;
+// 3 gave every player their own travel state and fog; 4 turned fog of war on for every mode.
+const FormatVersion = 4
+
+const (
+	// DataDirEnv overrides the per-user data directory (useful for portable installs and tests).
+	DataDirEnv   = "SURVIVE_IT_DATA_DIR"
+	AutosaveSlot = "autosave"
+	legacyGlob   = "survive-it-save-*.json"
+	maxSlotName  = 40
+)
+
+type Kind string
+
+const (
+	KindManual   Kind = "manual"
+	KindAutosave Kind = "autosave"
+	KindExit     Kind = "exit"
+)
+
+type Metadata struct {
+	Slot         string          `json:"slot"`
+	Kind         Kind            `json:"kind"`
+	Mode         game.GameMode   `json:"mode"`
+	ScenarioID   game.ScenarioID `json:"scenario_id"`
+	ScenarioName string          `json:"scenario_name"`
+	Day          int             `json:"day"`
+	ClockHours   float64         `json:"clock_hours"`
+	Players      []string        `json:"players"`
+	Playtime     time.Duration   `json:"playtime"`
+	// ContentPacks are the packs active when the run was saved; see game.ContentPackMismatch.
+	ContentPacks []game.ContentPackRef `json:"content_packs,omitempty"`
+}
+
+type File struct {
+	FormatVersion int           `json:"format_version"`
+	SavedAt       time.Time     `json:"saved_at"`
+	Meta          Metadata      `json:"meta"`
+	Run           game.RunState `json:"run"`
+}
+
+type Entry struct {
+	Path   string
+	Legacy bool
+	File   File
+	Err    error
+}
+
+type Request struct {
+	Slot     string
+	Kind     Kind
+	Run      *game.RunState
+	Journal  *game.RunJournal
+	Playtime time.Duration
+}
+
+// DataDir is the per-user directory for saves and other player data.
+func DataDir() (string, error) {
+	if dir := strings.TrimSpace(os.Getenv(DataDirEnv)); dir != "" {
+		return dir, nil
+	}
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, "SurviveIt"), nil
+}
+
+func SaveDir() (string, error) {
+	dir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "saves"), nil
+}
+
+// ModsDir is where players drop their own content packs (see game.LoadContentPacks).
+func ModsDir() (string, error) {
+	dir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "mods"), nil
+}
+
+var slotNameInvalid = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// SlotName normalises user input into a slot name: "2" becomes "slot-2", "Base Camp" becomes "base-camp".
+func SlotName(raw string) (string, error) {
+	raw = strings.ToLower(strings.TrimSpace(raw))
+	raw = strings.TrimSuffix(raw, ".json")
+	numbered := strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(raw, "slot"), "-"))
+	if n, err := strconv.Atoi(numbered); err == nil {
+		if n < 1 {
+			return "", fmt.Errorf("slot number must be 1 or more")
+		}
+		return fmt.Sprintf("slot-%d", n), nil
+	}
+	name := slotNameInvalid.ReplaceAllString(strings.Join(strings.Fields(raw), "-"), "")
+	name = strings.Trim(name, "-_")
+	if len(name) > maxSlotName {
+		name = strings.Trim(name[:maxSlotName], "-_")
+	}
+	if name == "" {
+		return "", fmt.Errorf("invalid save name: %q", raw)
+	}
+	return name, nil
+}
+
+// SlotArg pulls the slot out of a "save"/"load" command's fields, ignoring filler like "save my game".
+func SlotArg(fields []string) string {
+	if len(fields) < 2 {
+		return ""
+	}
+	kept := make([]string, 0, len(fields)-1)
+	for _, field := range fields[1:] {
+		switch strings.ToLower(field) {
+		case "my", "the", "game", "run", "progress", "from", "to", "into", "slot":
+			continue
+		}
+		kept = append(kept, field)
+	}
+	return strings.Join(kept, " ")
+}
+
+func PathForSlot(dir string, slot string) string {
+	return filepath.Join(dir, slot+".json")
+}
+
+// JournalPath keeps a save's replay journal beside it without matching the *.json listing.
+func JournalPath(path string) string {
+	return path + ".journal"
+}
+
+// NextFreeSlot returns the lowest numbered slot with no save in dir.
+func NextFreeSlot(dir string) string {
+	for n := 1; ; n++ {
+		slot := fmt.Sprintf("slot-%d", n)
+		if _, err := os.Stat(PathForSlot(dir, slot)); errors.Is(err, os.ErrNotExist) {
+			return slot
+		}
+	}
+}
+
+func metadataFor(run *game.RunState, slot string, kind Kind, playtime time.Duration) Metadata {
+	names := make([]string, 0, len(run.Players))
+	for _, p := range run.Players {
+		names = append(names, p.Name)
+	}
+	return Metadata{
+		Slot:         slot,
+		Kind:         kind,
+		Mode:         run.Config.Mode,
+		ScenarioID:   run.Scenario.ID,
+		ScenarioName: run.Scenario.Name,
+		Day:          run.Day,
+		ClockHours:   run.ClockHours,
+		Players:      names,
+		Playtime:     playtime,
+		ContentPacks: game.ActiveContentPackRefs(),
+	}
+}
+
+// Save writes the run (and its journal, when present) into a slot under dir and returns the save path.
+func Save(dir string, req Request) (string, error) {
+	if req.Run == nil {
+		return "", fmt.Errorf("no run to save")
+	}
+	slot, err := SlotName(req.Slot)
+	if err != nil {
+		return "", err
+	}
+	if req.Kind == "" {
+		req.Kind = KindManual
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", err
+	}
+	payload := File{
+		FormatVersion: FormatVersion,
+		SavedAt:       time.Now().UTC(),
+		Meta:          metadataFor(req.Run, slot, req.Kind, req.Playtime),
+		Run:           *req.Run,
+	}
+	data, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	path := PathForSlot(dir, slot)
+	// Write then rename so a crash mid-save never truncates the previous save.
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return "", err
+	}
+	if req.Journal != nil {
+		if err := game.SaveJournal(JournalPath(path), req.Journal); err != nil {
+			return path, fmt.Errorf("write journal: %w", err)
+		}
+	} else {
+		_ = os.Remove(JournalPath(path))
+	}
+	return path, nil
+}
+
+// Load reads a save, upgrading older format versions through the migration chain.
+func Load(path string) (File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return File{}, err
+	}
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return File{}, fmt.Errorf("parse save: %w", err)
+	}
+	if err := migrate(doc); err != nil {
+		return File{}, err
+	}
+	migrated, err := json.Marshal(doc)
+	if err != nil {
+		return File{}, err
+	}
+	var file File
+	if err := json.Unmarshal(migrated, &file); err != nil {
+		return File{}, fmt.Errorf("decode save: %w", err)
+	}
+	file.fillMetadata(path)
+	file.Run.EnsureWeather()
+	file.Run.EnsurePlayerRuntimeStats()
+	return file, nil
+}
+
+var legacySlotPattern = regexp.MustCompile(`^survive-it-save-(\d+)$`)
+
+// fillMetadata derives anything a migrated save could not know, such as the slot from the file name.
+func (f *File) fillMetadata(path string) {
+	base := strings.TrimSuffix(filepath.Base(path), ".json")
+	derived := metadataFor(&f.Run, base, KindManual, 0)
+	if m := legacySlotPattern.FindStringSubmatch(base); m != nil {
+		derived.Slot = "slot-" + m[1]
+	}
+	if f.Meta.Slot == "" {
+		f.Meta.Slot = derived.Slot
+	}
+	if f.Meta.Kind == "" {
+		f.Meta.Kind = derived.Kind
+	}
+	if f.Meta.ScenarioName == "" {
+		f.Meta.Mode = derived.Mode
+		f.Meta.ScenarioID = derived.ScenarioID
+		f.Meta.ScenarioName = derived.ScenarioName
+		f.Meta.Day = derived.Day
+		f.Meta.ClockHours = derived.ClockHours
+		f.Meta.Players = derived.Players
+	}
+}
+
+// LoadJournal returns the save's journal only when it ends on exactly the loaded state, so replays stay valid.
+func LoadJournal(path string, run *game.RunState) *game.RunJournal {
+	journal, err := game.LoadJournal(JournalPath(path))
+	if err != nil || journal.LastHash() != game.StateHash(run) {
+		return nil
+	}
+	return &journal
+}
+
+// List returns every save in dir plus legacy saves in legacyDirs, newest first. Unreadable saves are
+// returned with Err set so the browser can explain them rather than hide them.
+func List(dir string, legacyDirs ...string) ([]Entry, error) {
+	entries := make([]Entry, 0, 8)
+	add := func(pattern string, legacy bool) error {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return err
+		}
+		sort.Strings(matches)
+		for _, path := range matches {
+			file, err := Load(path)
+			entries = append(entries, Entry{Path: path, Legacy: legacy, File: file, Err: err})
+		}
+		return nil
+	}
+	if dir != "" {
+		if err := add(filepath.Join(dir, "*.json"), false); err != nil {
+			return nil, err
+		}
+	}
+	for _, legacyDir := range legacyDirs {
+		if err := add(filepath.Join(legacyDir, legacyGlob), true); err != nil {
+			return nil, err
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if (entries[i].Err == nil) != (entries[j].Err == nil) {
+			return entries[i].Err == nil
+		}
+		return entries[i].File.SavedAt.After(entries[j].File.SavedAt)
+	})
+	return entries, nil
+}
+
+// FormatPlaytime renders playtime as "3h 07m" for the save browser.
+func FormatPlaytime(d time.Duration) string {
+	if d < time.Minute {
+		return "<1m"
+	}
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	if hours == 0 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh %02dm", hours, minutes)
+}
@@ -0,0 +1,232 @@
+package savegame
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appengine-ltd/survive-it/internal/game"
+)
+
+func newTestRun(t *testing.T) game.RunState {
+	t.Helper()
+	run, err := game.NewRunState(game.RunConfig{
+		Mode:        game.ModeNakedAndAfraid,
+		ScenarioID:  game.ScenarioVancouverIslandID,
+		PlayerCount: 2,
+		RunLength:   game.RunLength{Days: 21},
+		Seed:        31337,
+	})
+	if err != nil {
+		t.Fatalf("new run: %v", err)
+	}
+	return run
+}
+
+func TestSlotName(t *testing.T) {
+	cases := map[string]string{
+		"2":          "slot-2",
+		"slot 3":     "slot-3",
+		"Slot-4":     "slot-4",
+		"Base Camp!": "base-camp",
+		"autosave":   "autosave",
+	}
+	for raw, want := range cases {
+		got, err := SlotName(raw)
+		if err != nil || got != want {
+			t.Fatalf("SlotName(%q) = %q, %v; want %q", raw, got, err, want)
+		}
+	}
+	if got := SlotArg(strings.Fields("save my game")); got != "" {
+		t.Fatalf("expected filler words to be ignored, got %q", got)
+	}
+	if got := SlotArg(strings.Fields("load slot 3")); got != "3" {
+		t.Fatalf("expected slot number, got %q", got)
+	}
+	for _, raw := range []string{"", "0", "!!!"} {
+		if _, err := SlotName(raw); err == nil {
+			t.Fatalf("expected SlotName(%q) to fail", raw)
+		}
+	}
+}
+
+func TestSaveLoadRoundTripKeepsStateAndMetadata(t *testing.T) {
+	dir := t.TempDir()
+	run := newTestRun(t)
+	journal := game.NewRunJournal(&run)
+	journal.ApplyRealtimeMetabolism(&run, 37*time.Minute, 2*time.Hour)
+	journal.ExecuteRunCommand(&run, "forage any")
+
+	path, err := Save(dir, Request{Slot: "Base Camp", Run: &run, Journal: journal, Playtime: 95 * time.Minute})
+	if err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	if filepath.Base(path) != "base-camp.json" {
+		t.Fatalf("unexpected save path: %s", path)
+	}
+
+	file, err := Load(path)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if game.StateHash(&file.Run) != game.StateHash(&run) {
+		t.Fatalf("expected loaded run (including metabolism carries) to hash identically")
+	}
+	meta := file.Meta
+	if meta.Slot != "base-camp" || meta.Kind != KindManual || meta.Day != run.Day || len(meta.Players) != 2 || meta.Playtime != 95*time.Minute {
+		t.Fatalf("unexpected metadata: %+v", meta)
+	}
+	if LoadJournal(path, &file.Run) == nil {
+		t.Fatalf("expected journal to resume for an unmodified save")
+	}
+	if NextFreeSlot(dir) != "slot-1" {
+		t.Fatalf("expected slot-1 to be free")
+	}
+}
+
+func TestLoadMigratesLegacyV1Save(t *testing.T) {
+	dir := t.TempDir()
+	run := newTestRun(t)
+	legacy := map[string]any{
+		"format_version": 1,
+		"saved_at":       time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		"run":            run,
+	}
+	data, err := json.Marshal(legacy)
+	if err != nil {
+		t.Fatalf("marshal legacy: %v", err)
+	}
+	path := filepath.Join(dir, "survive-it-save-3.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write legacy: %v", err)
+	}
+
+	entries, err := List(t.TempDir(), dir)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Err != nil || !entries[0].Legacy {
+		t.Fatalf("expected one readable legacy entry, got %+v", entries)
+	}
+	file := entries[0].File
+	if file.FormatVersion != FormatVersion {
+		t.Fatalf("expected migration to format %d, got %d", FormatVersion, file.FormatVersion)
+	}
+	if file.Meta.Slot != "slot-3" || file.Meta.ScenarioName != run.Scenario.Name || file.Meta.Day != run.Day {
+		t.Fatalf("expected metadata derived from legacy save, got %+v", file.Meta)
+	}
+}
+
+func TestLoadMigratesSharedTravelOntoEachPlayer(t *testing.T) {
+	run := newTestRun(t)
+	runDoc, err := json.Marshal(run)
+	if err != nil {
+		t.Fatalf("marshal run: %v", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(runDoc, &fields); err != nil {
+		t.Fatalf("decode run: %v", err)
+	}
+	fields["travel"] = json.RawMessage(`{"direction": "east", "total_km": 3.5, "pos_x": 7, "pos_y": 9}`)
+	fields["fog_mask"] = json.RawMessage(`[false, true, false, false, false, false, false, false, false, true]`)
+	data, err := json.Marshal(map[string]any{"format_version": 2, "meta": Metadata{Kind: KindManual}, "run": fields})
+	if err != nil {
+		t.Fatalf("marshal v2: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "old.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write v2: %v", err)
+	}
+
+	file, err := Load(path)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	for _, player := range file.Run.Players {
+		if player.Travel.PosX != 7 || player.Travel.PosY != 9 || player.Travel.TotalKm != 3.5 || player.Travel.Direction != "east" {
+			t.Fatalf("expected P%d to inherit the shared travel state, got %+v", player.ID, player.Travel)
+		}
+		if len(player.Fog) != 2 || player.Fog[0] != 0b10 || player.Fog[1] != 0b10 {
+			t.Fatalf("expected P%d fog seeded from the team mask, got %08b", player.ID, player.Fog)
+		}
+	}
+}
+
+func TestLoadPutsFullyRevealedOldSavesBackInFog(t *testing.T) {
+	run := newTestRun(t)
+	for i := range run.FogMask {
+		run.FogMask[i] = true
+	}
+	for i := range run.Players {
+		for b := range run.Players[i].Fog {
+			run.Players[i].Fog[b] = 0xff
+		}
+	}
+	data, err := json.Marshal(map[string]any{"format_version": 3, "meta": Metadata{Kind: KindManual}, "run": run})
+	if err != nil {
+		t.Fatalf("marshal v3: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "old.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write v3: %v", err)
+	}
+
+	file, err := Load(path)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	file.Run.EnsureTopology()
+	seen := 0
+	for _, revealed := range file.Run.FogMask {
+		if revealed {
+			seen++
+		}
+	}
+	if seen == 0 || seen == len(file.Run.FogMask) {
+		t.Fatalf("expected only the ground around the team revealed, got %d of %d cells", seen, len(file.Run.FogMask))
+	}
+	x, y := file.Run.CurrentMapPosition()
+	if !file.Run.PlayerHasSeen(2, x, y) || file.Run.PlayerHasSeen(2, 0, 0) {
+		t.Fatalf("expected P2's own fog rebuilt around the start cell")
+	}
+}
+
+func TestListReportsUnreadableAndNewerSaves(t *testing.T) {
+	dir := t.TempDir()
+	run := newTestRun(t)
+	if _, err := Save(dir, Request{Slot: "1", Run: &run}); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "future.json"), []byte(`{"format_version": 99, "run": {}}`), 0o600); err != nil {
+		t.Fatalf("write future: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "broken.json"), []byte(`{not json`), 0o600); err != nil {
+		t.Fatalf("write broken: %v", err)
+	}
+
+	entries, err := List(dir)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("expected all three saves listed, got %d", len(entries))
+	}
+	if entries[0].Err != nil || entries[0].File.Meta.Slot != "slot-1" {
+		t.Fatalf("expected readable save first, got %+v", entries[0])
+	}
+	foundNewer := false
+	for _, entry := range entries[1:] {
+		if entry.Err == nil {
+			t.Fatalf("expected %s to carry an error", entry.Path)
+		}
+		if strings.Contains(entry.Err.Error(), "newer") {
+			foundNewer = true
+		}
+	}
+	if !foundNewer {
+		t.Fatalf("expected a newer-format error to be reported")
+	}
+}